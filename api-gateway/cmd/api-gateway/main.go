@@ -2,17 +2,30 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/baccala1010/e-commerce/api-gateway/internal/handler"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/baccala1010/e-commerce/api-gateway/internal/adapter/grpc/client/inventory"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/adapter/grpc/client/order"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/adapter/kafka"
 	"github.com/baccala1010/e-commerce/api-gateway/internal/app"
 	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
-	"github.com/baccala1010/e-commerce/api-gateway/internal/middleware"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/database"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/graphql"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/handler"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/repository"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/usecase"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/eventhub"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/grpcconn"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/health"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/httpcache"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/postgre"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
@@ -41,8 +54,98 @@ func main() {
 	// Set up logging
 	app.SetupLogging(cfg)
 
+	// Set up tracing
+	shutdownTracing, err := tracing.Init(ctx, tracing.Options{
+		ServiceName: cfg.Server.Name,
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.GetEndpoint(),
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.GetSampleRatio(),
+	})
+	if err != nil {
+		logrus.Fatalf("Failed to initialize tracing: %v", err)
+	}
+
+	// Initialize the response cache and purge it on catalog changes
+	responseCache := httpcache.New(cfg.Cache.GetMaxEntries())
+
+	cacheInvalidator, err := kafka.NewCacheInvalidator(cfg, responseCache)
+	if err == nil {
+		err = cacheInvalidator.Start(ctx)
+	}
+	if err != nil {
+		logrus.Warnf("Failed to start cache invalidator, cached responses expire by TTL only: %v", err)
+	}
+
+	// Relay order status events to the streams of their users
+	orderEventHub := eventhub.New(cfg.OrderEvents.GetRetention(), cfg.OrderEvents.GetMaxBuffered())
+	orderEventHub.StartPruning(ctx, time.Minute)
+
+	orderEventRelay, err := kafka.NewOrderEventRelay(cfg, orderEventHub)
+	if err == nil {
+		err = orderEventRelay.Start(ctx)
+	}
+	if err != nil {
+		logrus.Warnf("Failed to start order event relay, order event streams stay idle: %v", err)
+	}
+
 	// Initialize service proxy
-	proxy := handler.NewServiceProxy(cfg)
+	proxy := handler.NewServiceProxy(ctx, cfg, responseCache)
+
+	// Connect to the gRPC services
+	connManager := grpcconn.NewConnectionManager()
+	defer connManager.Close()
+
+	inventoryClient, err := inventory.NewClient(ctx, connManager, cfg)
+	if err != nil {
+		logrus.Fatalf("Failed to create inventory client: %v", err)
+	}
+
+	orderClient, err := order.NewClient(ctx, connManager, cfg)
+	if err != nil {
+		logrus.Fatalf("Failed to create order client: %v", err)
+	}
+
+	// Initialize composition handlers
+	orderDetailUseCase := usecase.NewOrderDetailUseCase(orderClient, inventoryClient, cfg)
+	orderDetailHandler := handler.NewOrderDetailHandler(orderDetailUseCase)
+
+	storefrontSchema, err := graphql.NewSchema(inventoryClient, orderClient, cfg)
+	if err != nil {
+		logrus.Fatalf("Failed to create GraphQL schema: %v", err)
+	}
+	graphQLHandler := handler.NewGraphQLHandler(storefrontSchema)
+	orderEventsHandler := handler.NewOrderEventsHandler(orderEventHub, cfg.OrderEvents.GetHeartbeat())
+
+	// Partner API keys live in the gateway's own database; without it only
+	// bearer credentials are accepted
+	var apiKeyHandler *handler.APIKeyHandler
+	db, err := database.InitDB(cfg)
+	if err != nil {
+		logrus.Warnf("Failed to initialize database, partner API keys are disabled: %v", err)
+	} else {
+		apiKeyRepo := repository.NewAPIKeyRepository(db)
+		apiKeyUseCase := usecase.NewAPIKeyUseCase(apiKeyRepo)
+		apiKeyHandler = handler.NewAPIKeyHandler(apiKeyUseCase)
+	}
+
+	// Set up health checks. The gateway keeps serving what it can while a
+	// dependency is down, so none of them takes it out of rotation.
+	healthChecker := health.NewChecker(cfg.Server.Name, cfg.Health.GetCheckTimeout())
+	for _, name := range cfg.Services.Names() {
+		name := name
+		healthChecker.RegisterOptional(name+"_grpc", func(ctx context.Context) error {
+			return connManager.Ping(ctx, name)
+		})
+	}
+	healthChecker.RegisterOptional("database", func(ctx context.Context) error {
+		if db == nil {
+			return errors.New("database is not connected")
+		}
+		return postgre.Ping(ctx, db)
+	})
+	healthChecker.RegisterOptional("kafka_cache_invalidator", cacheInvalidator.Ping)
+	healthChecker.RegisterOptional("kafka_order_events", orderEventRelay.Ping)
 
 	// Set up Gin router
 	gin.SetMode(gin.ReleaseMode)
@@ -50,62 +153,25 @@ func main() {
 		gin.SetMode(gin.DebugMode)
 	}
 
-	router := gin.New()
-	router.Use(gin.Recovery())
-	router.Use(middleware.Logger())
-	router.Use(middleware.CORS())
+	// Build routes from the route table
+	router, err := handler.NewRouter(cfg, proxy, orderDetailHandler, orderEventsHandler, graphQLHandler, apiKeyHandler, healthChecker)
+	if err != nil {
+		logrus.Fatalf("Failed to load route table: %v", err)
+	}
 
-	// Health check route
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{
-			"status":  "up",
-			"service": "api-gateway",
-		})
-	})
+	// Reload routes when the route table changes or on SIGHUP
+	router.WatchRouteTable()
 
-	// Register inventory routes
-	router.GET("/products", proxy.ProxyInventory())
-	router.GET("/products/:id", proxy.ProxyInventory())
-	router.POST("/products", proxy.ProxyInventory())
-	router.PATCH("/products/:id", proxy.ProxyInventory())
-	router.DELETE("/products/:id", proxy.ProxyInventory())
-	router.GET("/products/promotions", proxy.ProxyInventory())
-
-	router.GET("/categories", proxy.ProxyInventory())
-	router.GET("/categories/:id", proxy.ProxyInventory())
-	router.POST("/categories", proxy.ProxyInventory())
-	router.PATCH("/categories/:id", proxy.ProxyInventory())
-	router.DELETE("/categories/:id", proxy.ProxyInventory())
-
-	// Register discount routes
-	router.GET("/discounts/:id", proxy.ProxyInventory())
-	router.POST("/discounts", proxy.ProxyInventory())
-	router.PATCH("/discounts/:id", proxy.ProxyInventory())
-	router.DELETE("/discounts/:id", proxy.ProxyInventory())
-	router.GET("/discounts/:id/products", proxy.ProxyInventory())
-
-	// Register order routes
-	router.GET("/orders", proxy.ProxyOrder())
-	router.GET("/orders/:id", proxy.ProxyOrder())
-	router.POST("/orders", proxy.ProxyOrder())
-	router.PATCH("/orders/:id", proxy.ProxyOrder())
-	router.POST("/orders/:id/payment", proxy.ProxyOrder())
-	router.GET("/orders/:orderId/reviews", proxy.ProxyOrder())
-
-	router.GET("/payments/:id", proxy.ProxyOrder())
-	router.PATCH("/payments/:id", proxy.ProxyOrder())
-
-	// Register review routes
-	router.POST("/reviews", proxy.ProxyOrder())
-	router.GET("/reviews/:id", proxy.ProxyOrder())
-	router.DELETE("/reviews/:id", proxy.ProxyOrder())
-
-	// Register legacy proxy routes for backward compatibility
-	inventoryGroup := router.Group("/inventory")
-	inventoryGroup.Any("/*path", proxy.ProxyInventory())
-
-	orderGroup := router.Group("/order")
-	orderGroup.Any("/*path", proxy.ProxyOrder())
+	hupCh := make(chan os.Signal, 1)
+	signal.Notify(hupCh, syscall.SIGHUP)
+	go func() {
+		for range hupCh {
+			logrus.Info("Received SIGHUP, reloading route table")
+			if err := router.Reload(); err != nil {
+				logrus.Errorf("Failed to reload route table, keeping previous routes: %v", err)
+			}
+		}
+	}()
 
 	// Start the HTTP server
 	serverAddr := fmt.Sprintf(":%d", cfg.Server.Port)
@@ -116,6 +182,9 @@ func main() {
 		Handler: router,
 	}
 
+	// Event streams never end on their own, so they are closed when shutdown starts
+	server.RegisterOnShutdown(orderEventsHandler.Close)
+
 	// Start the server in a goroutine
 	go func() {
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -127,13 +196,38 @@ func main() {
 	<-ctx.Done()
 	logrus.Info("Shutting down server...")
 
+	// Report not ready and give load balancers time to stop sending requests
+	healthChecker.Shutdown()
+	time.Sleep(cfg.Health.GetShutdownDelay())
+
 	// Create a deadline for server shutdown
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer shutdownCancel()
 
-	// Attempt graceful shutdown
+	// Attempt graceful shutdown, then close the connections still open
 	if err := server.Shutdown(shutdownCtx); err != nil {
-		logrus.Fatalf("Server forced to shutdown: %v", err)
+		logrus.Errorf("Server forced to shutdown: %v", err)
+		server.Close()
+	}
+
+	// The Kafka consumers stop with the main context; let them leave their groups
+	consumersCtx, consumersCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer consumersCancel()
+
+	for _, done := range []<-chan struct{}{cacheInvalidator.Done(), orderEventRelay.Done()} {
+		select {
+		case <-done:
+		case <-consumersCtx.Done():
+			logrus.Warn("Timed out waiting for the Kafka consumers to close")
+		}
+	}
+
+	// Flush the remaining spans with a deadline of their own
+	tracingCtx, tracingCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer tracingCancel()
+
+	if err := shutdownTracing(tracingCtx); err != nil {
+		logrus.Errorf("Tracing shutdown error: %v", err)
 	}
 
 	logrus.Info("Server exiting")
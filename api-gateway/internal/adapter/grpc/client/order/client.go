@@ -13,7 +13,7 @@ import (
 
 // Client is a gRPC client for the order service
 type Client struct {
-	conn   *grpc.ClientConn
+	conn   grpc.ClientConnInterface
 	client pb.OrderServiceClient
 }
 
@@ -33,42 +33,48 @@ func NewClient(ctx context.Context, connManager *grpcconn.ConnectionManager, cfg
 
 // CreateOrder creates a new order
 func (c *Client) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.OrderResponse, error) {
-	logrus.Infof("Calling order service CreateOrder: %+v", req)
+	logrus.WithContext(ctx).Infof("Calling order service CreateOrder: %+v", req)
 	return c.client.CreateOrder(ctx, req)
 }
 
 // GetOrderByID gets an order by ID
 func (c *Client) GetOrderByID(ctx context.Context, req *pb.GetOrderRequest) (*pb.OrderResponse, error) {
-	logrus.Infof("Calling order service GetOrderByID: %+v", req)
+	logrus.WithContext(ctx).Infof("Calling order service GetOrderByID: %+v", req)
 	return c.client.GetOrderByID(ctx, req)
 }
 
 // UpdateOrderStatus updates an order status
 func (c *Client) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.OrderResponse, error) {
-	logrus.Infof("Calling order service UpdateOrderStatus: %+v", req)
+	logrus.WithContext(ctx).Infof("Calling order service UpdateOrderStatus: %+v", req)
 	return c.client.UpdateOrderStatus(ctx, req)
 }
 
 // ListUserOrders lists orders for a user
 func (c *Client) ListUserOrders(ctx context.Context, req *pb.ListUserOrdersRequest) (*pb.ListOrdersResponse, error) {
-	logrus.Infof("Calling order service ListUserOrders: %+v", req)
+	logrus.WithContext(ctx).Infof("Calling order service ListUserOrders: %+v", req)
 	return c.client.ListUserOrders(ctx, req)
 }
 
 // ProcessPayment processes a payment for an order
 func (c *Client) ProcessPayment(ctx context.Context, req *pb.ProcessPaymentRequest) (*pb.PaymentResponse, error) {
-	logrus.Infof("Calling order service ProcessPayment: %+v", req)
+	logrus.WithContext(ctx).Infof("Calling order service ProcessPayment: %+v", req)
 	return c.client.ProcessPayment(ctx, req)
 }
 
 // GetPaymentByID gets a payment by ID
 func (c *Client) GetPaymentByID(ctx context.Context, req *pb.GetPaymentRequest) (*pb.PaymentResponse, error) {
-	logrus.Infof("Calling order service GetPaymentByID: %+v", req)
+	logrus.WithContext(ctx).Infof("Calling order service GetPaymentByID: %+v", req)
 	return c.client.GetPaymentByID(ctx, req)
 }
 
 // UpdatePaymentStatus updates a payment status
 func (c *Client) UpdatePaymentStatus(ctx context.Context, req *pb.UpdatePaymentStatusRequest) (*pb.PaymentResponse, error) {
-	logrus.Infof("Calling order service UpdatePaymentStatus: %+v", req)
+	logrus.WithContext(ctx).Infof("Calling order service UpdatePaymentStatus: %+v", req)
 	return c.client.UpdatePaymentStatus(ctx, req)
 }
+
+// GetOrderReviews gets the reviews of an order
+func (c *Client) GetOrderReviews(ctx context.Context, req *pb.GetOrderReviewsRequest) (*pb.GetOrderReviewsResponse, error) {
+	logrus.WithContext(ctx).Infof("Calling order service GetOrderReviews: %+v", req)
+	return c.client.GetOrderReviews(ctx, req)
+}
@@ -0,0 +1,103 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/httpcache"
+	pkgkafka "github.com/baccala1010/e-commerce/api-gateway/pkg/kafka"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/sirupsen/logrus"
+)
+
+// catalogUpstream is the service whose responses catalog events invalidate
+const catalogUpstream = "inventory"
+
+// closed is the Done channel of consumers that never started
+var closed = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+// CacheInvalidator purges cached catalog responses when the inventory service
+// publishes a change
+type CacheInvalidator struct {
+	consumer *pkgkafka.Consumer
+	cache    *httpcache.Store
+	purge    map[string][]string
+}
+
+// NewCacheInvalidator creates a consumer of the catalog events topic
+func NewCacheInvalidator(cfg *config.Config, cache *httpcache.Store) (*CacheInvalidator, error) {
+	if cfg.Kafka.BootstrapServers == "" || cfg.Kafka.Topics.CatalogEvents == "" {
+		return nil, errors.New("kafka catalog events topic is not configured")
+	}
+
+	// Every gateway instance holds its own cache, so each needs its own consumer group
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+
+	consumer, err := pkgkafka.NewConsumer(pkgkafka.ConsumerConfig{
+		BootstrapServers: cfg.Kafka.BootstrapServers,
+		GroupID:          cfg.Kafka.GetGroupID() + "-" + hostname,
+		AutoOffsetReset:  "latest",
+	}, []string{cfg.Kafka.Topics.CatalogEvents})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CacheInvalidator{
+		consumer: consumer,
+		cache:    cache,
+		purge:    cfg.Cache.Purge,
+	}, nil
+}
+
+// Start consumes catalog events until the context is canceled
+func (i *CacheInvalidator) Start(ctx context.Context) error {
+	return i.consumer.Start(ctx, i.handleMessage)
+}
+
+// Ping checks that the Kafka brokers the cache invalidator consumes from answer
+func (i *CacheInvalidator) Ping(ctx context.Context) error {
+	if i == nil {
+		return errors.New("cache invalidator is not running")
+	}
+	return i.consumer.Ping(ctx)
+}
+
+// Done is closed once the invalidator has stopped consuming
+func (i *CacheInvalidator) Done() <-chan struct{} {
+	if i == nil {
+		return closed
+	}
+	return i.consumer.Done()
+}
+
+func (i *CacheInvalidator) handleMessage(ctx context.Context, msg *kafka.Message) {
+	var event events.CatalogEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to decode catalog event: %v", err)
+		return
+	}
+
+	prefixes, ok := i.purge[event.Entity]
+	if !ok {
+		logrus.WithContext(ctx).Debugf("No cache purge configured for %s events", event.Entity)
+		return
+	}
+
+	purged := 0
+	for _, prefix := range prefixes {
+		purged += i.cache.PurgePrefix(catalogUpstream, prefix)
+	}
+	logrus.WithContext(ctx).Debugf("Purged %d cached responses after %s %s %s", purged, event.Entity, event.Action, event.ID)
+}
@@ -0,0 +1,95 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/eventhub"
+	pkgkafka "github.com/baccala1010/e-commerce/api-gateway/pkg/kafka"
+	"github.com/baccala1010/e-commerce/order/pkg/events"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/sirupsen/logrus"
+)
+
+// OrderEventRelay passes the order status events published by the order
+// service to the event streams of their users
+type OrderEventRelay struct {
+	consumer *pkgkafka.Consumer
+	hub      *eventhub.Hub
+}
+
+// NewOrderEventRelay creates a consumer of the order status events topic
+func NewOrderEventRelay(cfg *config.Config, hub *eventhub.Hub) (*OrderEventRelay, error) {
+	if cfg.Kafka.BootstrapServers == "" || cfg.Kafka.Topics.OrderStatusEvents == "" {
+		return nil, errors.New("kafka order status events topic is not configured")
+	}
+
+	// Every gateway instance streams to its own clients, so each needs its own
+	// consumer group. Rewinding refills the resume buffer after a restart.
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+
+	consumer, err := pkgkafka.NewConsumer(pkgkafka.ConsumerConfig{
+		BootstrapServers: cfg.Kafka.BootstrapServers,
+		GroupID:          cfg.Kafka.GetGroupID() + "-events-" + hostname,
+		AutoOffsetReset:  "latest",
+		Rewind:           cfg.OrderEvents.GetRetention(),
+	}, []string{cfg.Kafka.Topics.OrderStatusEvents})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderEventRelay{
+		consumer: consumer,
+		hub:      hub,
+	}, nil
+}
+
+// Start consumes order status events until the context is canceled
+func (r *OrderEventRelay) Start(ctx context.Context) error {
+	return r.consumer.Start(ctx, r.handleMessage)
+}
+
+// Ping checks that the Kafka brokers the order event relay consumes from answer
+func (r *OrderEventRelay) Ping(ctx context.Context) error {
+	if r == nil {
+		return errors.New("order event relay is not running")
+	}
+	return r.consumer.Ping(ctx)
+}
+
+// Done is closed once the relay has stopped consuming
+func (r *OrderEventRelay) Done() <-chan struct{} {
+	if r == nil {
+		return closed
+	}
+	return r.consumer.Done()
+}
+
+func (r *OrderEventRelay) handleMessage(ctx context.Context, msg *kafka.Message) {
+	var event events.OrderStatusEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to decode order status event: %v", err)
+		return
+	}
+
+	if event.UserID == "" {
+		logrus.WithContext(ctx).Warnf("Dropping order status event of order %s without a user", event.OrderID)
+		return
+	}
+
+	// Events of a user share a partition, so the partition and offset identify
+	// an event the same way on every gateway instance
+	r.hub.Publish(event.UserID, eventhub.Event{
+		ID:   fmt.Sprintf("%d-%d", msg.TopicPartition.Partition, msg.TopicPartition.Offset),
+		Type: event.Type,
+		Data: msg.Value,
+		Time: event.Timestamp,
+	})
+}
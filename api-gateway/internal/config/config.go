@@ -2,14 +2,27 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Services ServicesConfig
-	Logging  LoggingConfig
+	Server      ServerConfig
+	Services    ServicesConfig
+	Routes      RoutesConfig
+	Cache       CacheConfig
+	Kafka       KafkaConfig
+	Database    DatabaseConfig
+	Auth        AuthConfig
+	Admin       AdminConfig
+	GraphQL     GraphQLConfig
+	OrderEvents OrderEventsConfig `mapstructure:"order_events"`
+	Tracing     TracingConfig
+	Health      HealthConfig
+	Logging     LoggingConfig
 }
 
 type ServerConfig struct {
@@ -22,12 +35,165 @@ type ServicesConfig struct {
 	Order     ServiceConfig
 }
 
+// Names returns the names of the upstream services
+func (s ServicesConfig) Names() []string {
+	return []string{"inventory", "order"}
+}
+
+// Get returns the configuration of the named upstream service
+func (s ServicesConfig) Get(name string) (ServiceConfig, bool) {
+	switch name {
+	case "inventory":
+		return s.Inventory, true
+	case "order":
+		return s.Order, true
+	default:
+		return ServiceConfig{}, false
+	}
+}
+
 type ServiceConfig struct {
+	BaseURL        string               `mapstructure:"base_url"`
+	GRPCHost       string               `mapstructure:"grpc_host"`
+	GRPCPort       int                  `mapstructure:"grpc_port"`
+	Endpoints      []EndpointConfig     `mapstructure:"endpoints"`
+	LoadBalancing  string               `mapstructure:"load_balancing"`
+	HealthCheck    HealthCheckConfig    `mapstructure:"health_check"`
+	Timeout        string               `mapstructure:"timeout"`
+	Retry          RetryConfig          `mapstructure:"retry"`
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+}
+
+// EndpointConfig is a single instance of an upstream service
+type EndpointConfig struct {
 	BaseURL  string `mapstructure:"base_url"`
 	GRPCHost string `mapstructure:"grpc_host"`
 	GRPCPort int    `mapstructure:"grpc_port"`
 }
 
+type HealthCheckConfig struct {
+	Path               string `mapstructure:"path"`
+	Interval           string `mapstructure:"interval"`
+	Timeout            string `mapstructure:"timeout"`
+	UnhealthyThreshold int    `mapstructure:"unhealthy_threshold"`
+	HealthyThreshold   int    `mapstructure:"healthy_threshold"`
+}
+
+type RetryConfig struct {
+	MaxAttempts int    `mapstructure:"max_attempts"`
+	BaseDelay   string `mapstructure:"base_delay"`
+	MaxDelay    string `mapstructure:"max_delay"`
+}
+
+type CircuitBreakerConfig struct {
+	FailureThreshold int    `mapstructure:"failure_threshold"`
+	OpenTimeout      string `mapstructure:"open_timeout"`
+}
+
+type RoutesConfig struct {
+	File string
+}
+
+type CacheConfig struct {
+	MaxEntries int `mapstructure:"max_entries"`
+	// Upstream path prefixes to purge when a catalog entity changes, keyed by entity
+	Purge map[string][]string `mapstructure:"purge"`
+}
+
+type KafkaConfig struct {
+	BootstrapServers string `mapstructure:"bootstrap_servers"`
+	GroupID          string `mapstructure:"group_id"`
+	Topics           KafkaTopics
+}
+
+type KafkaTopics struct {
+	CatalogEvents     string `mapstructure:"catalog_events"`
+	OrderStatusEvents string `mapstructure:"order_status_events"`
+}
+
+type DatabaseConfig struct {
+	Host                  string
+	Port                  int
+	Name                  string
+	Username              string
+	Password              string
+	SSLMode               string `mapstructure:"sslmode"`
+	MaxIdleConnections    int    `mapstructure:"max_idle_connections"`
+	MaxOpenConnections    int    `mapstructure:"max_open_connections"`
+	ConnectionMaxLifetime string `mapstructure:"connection_max_lifetime"`
+}
+
+// AuthConfig verifies the bearer tokens of users. Tokens are HS256 JSON Web
+// Tokens whose sub claim is the user ID; without a secret only API keys
+// authenticate. Set the secret through GATEWAY_AUTH_JWT_SECRET.
+type AuthConfig struct {
+	JWTSecret string `mapstructure:"jwt_secret"`
+	// Expected iss claim of tokens; not checked when empty
+	Issuer string
+}
+
+type AdminConfig struct {
+	// Bearer token required by the admin endpoints; they are disabled when empty
+	Token string
+}
+
+type GraphQLConfig struct {
+	// How long product lookups are collected before they are fetched in one call
+	BatchWait      string `mapstructure:"batch_wait"`
+	MaxBatch       int    `mapstructure:"max_batch"`
+	MaxDepth       int    `mapstructure:"max_depth"`
+	MaxParallelism int    `mapstructure:"max_parallelism"`
+}
+
+type OrderEventsConfig struct {
+	// How long events are kept for clients resuming a stream
+	Retention   string
+	MaxBuffered int `mapstructure:"max_buffered"`
+	// Interval of the keep-alive comments sent on idle streams
+	Heartbeat string
+}
+
+// TracingConfig selects where spans are exported: "otlp", "stdout" or "none"
+type TracingConfig struct {
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// GetEndpoint returns the OTLP collector address, localhost:4317 by default
+func (tc *TracingConfig) GetEndpoint() string {
+	if tc.Endpoint == "" {
+		return "localhost:4317"
+	}
+	return tc.Endpoint
+}
+
+// GetSampleRatio returns the share of traces started here that are sampled, all by default
+func (tc *TracingConfig) GetSampleRatio() float64 {
+	if tc.SampleRatio <= 0 || tc.SampleRatio > 1 {
+		return 1
+	}
+	return tc.SampleRatio
+}
+
+// HealthConfig tunes the readiness probe and the graceful shutdown
+type HealthConfig struct {
+	CheckTimeout  string `mapstructure:"check_timeout"`
+	ShutdownDelay string `mapstructure:"shutdown_delay"`
+}
+
+// GetCheckTimeout returns the time each dependency check may take, defaulting to 2 seconds
+func (hc *HealthConfig) GetCheckTimeout() time.Duration {
+	return parseDuration(hc.CheckTimeout, 2*time.Second)
+}
+
+// GetShutdownDelay returns how long the gateway keeps serving after it stopped
+// reporting ready, so load balancers can take it out first. No delay by default.
+func (hc *HealthConfig) GetShutdownDelay() time.Duration {
+	return parseDuration(hc.ShutdownDelay, 0)
+}
+
 type LoggingConfig struct {
 	Level string
 }
@@ -36,6 +202,11 @@ func LoadConfig(path string) (*Config, error) {
 	viper.SetConfigFile(path)
 	viper.SetConfigType("yaml")
 
+	// Secrets can be set from the environment, e.g. GATEWAY_AUTH_JWT_SECRET for auth.jwt_secret
+	viper.SetEnvPrefix("GATEWAY")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
+
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
@@ -47,3 +218,187 @@ func LoadConfig(path string) (*Config, error) {
 
 	return &config, nil
 }
+
+// GetTimeout returns the timeout of a single call to the service, defaulting to
+// 10 seconds. Proxied requests are bounded by it until the response headers
+// arrive, unless their route sets a timeout of its own.
+func (sc *ServiceConfig) GetTimeout() time.Duration {
+	return parseDuration(sc.Timeout, 10*time.Second)
+}
+
+// GetEndpoints returns the instances of the service. A service configured with a
+// single base_url/grpc_host/grpc_port is treated as one endpoint.
+func (sc *ServiceConfig) GetEndpoints() []EndpointConfig {
+	if len(sc.Endpoints) > 0 {
+		return sc.Endpoints
+	}
+	return []EndpointConfig{{
+		BaseURL:  sc.BaseURL,
+		GRPCHost: sc.GRPCHost,
+		GRPCPort: sc.GRPCPort,
+	}}
+}
+
+// GetLoadBalancing returns the balancing strategy, defaulting to round_robin
+func (sc *ServiceConfig) GetLoadBalancing() string {
+	if sc.LoadBalancing == "" {
+		return "round_robin"
+	}
+	return sc.LoadBalancing
+}
+
+// GetGRPCAddress returns the host:port of the endpoint's gRPC server
+func (ec *EndpointConfig) GetGRPCAddress() string {
+	return fmt.Sprintf("%s:%d", ec.GRPCHost, ec.GRPCPort)
+}
+
+// GetPath returns the HTTP health check path, defaulting to /health
+func (hc *HealthCheckConfig) GetPath() string {
+	if hc.Path == "" {
+		return "/health"
+	}
+	return hc.Path
+}
+
+// GetInterval returns the time between health probes, defaulting to 10 seconds
+func (hc *HealthCheckConfig) GetInterval() time.Duration {
+	return parseDuration(hc.Interval, 10*time.Second)
+}
+
+// GetTimeout returns the timeout of a single health probe, defaulting to 2 seconds
+func (hc *HealthCheckConfig) GetTimeout() time.Duration {
+	return parseDuration(hc.Timeout, 2*time.Second)
+}
+
+// GetUnhealthyThreshold returns the failed probes that take an instance out of rotation, defaulting to 3
+func (hc *HealthCheckConfig) GetUnhealthyThreshold() int {
+	if hc.UnhealthyThreshold < 1 {
+		return 3
+	}
+	return hc.UnhealthyThreshold
+}
+
+// GetHealthyThreshold returns the successful probes that put an instance back, defaulting to 2
+func (hc *HealthCheckConfig) GetHealthyThreshold() int {
+	if hc.HealthyThreshold < 1 {
+		return 2
+	}
+	return hc.HealthyThreshold
+}
+
+// GetMaxAttempts returns how many times an idempotent request is tried, defaulting to 1
+func (rc *RetryConfig) GetMaxAttempts() int {
+	if rc.MaxAttempts < 1 {
+		return 1
+	}
+	return rc.MaxAttempts
+}
+
+// GetBaseDelay returns the initial retry backoff, defaulting to 100 milliseconds
+func (rc *RetryConfig) GetBaseDelay() time.Duration {
+	return parseDuration(rc.BaseDelay, 100*time.Millisecond)
+}
+
+// GetMaxDelay returns the upper bound of the retry backoff, defaulting to 2 seconds
+func (rc *RetryConfig) GetMaxDelay() time.Duration {
+	return parseDuration(rc.MaxDelay, 2*time.Second)
+}
+
+// GetFailureThreshold returns the consecutive failures that open the breaker, defaulting to 5
+func (cc *CircuitBreakerConfig) GetFailureThreshold() int {
+	if cc.FailureThreshold < 1 {
+		return 5
+	}
+	return cc.FailureThreshold
+}
+
+// GetOpenTimeout returns how long the breaker stays open, defaulting to 30 seconds
+func (cc *CircuitBreakerConfig) GetOpenTimeout() time.Duration {
+	return parseDuration(cc.OpenTimeout, 30*time.Second)
+}
+
+// GetFile returns the route table path, defaulting to config/routes.yaml
+func (rc *RoutesConfig) GetFile() string {
+	if rc.File == "" {
+		return filepath.Join("config", "routes.yaml")
+	}
+	return rc.File
+}
+
+// GetMaxEntries returns the maximum number of cached responses, defaulting to 10000
+func (cc *CacheConfig) GetMaxEntries() int {
+	if cc.MaxEntries < 1 {
+		return 10000
+	}
+	return cc.MaxEntries
+}
+
+// GetGroupID returns the Kafka consumer group, defaulting to api-gateway
+func (kc *KafkaConfig) GetGroupID() string {
+	if kc.GroupID == "" {
+		return "api-gateway"
+	}
+	return kc.GroupID
+}
+
+// GetConnectionMaxLifetime returns the maximum lifetime of a database connection, defaulting to 1h
+func (dc *DatabaseConfig) GetConnectionMaxLifetime() time.Duration {
+	return parseDuration(dc.ConnectionMaxLifetime, time.Hour)
+}
+
+// GetBatchWait returns how long lookups are collected into a batch, defaulting to 2 milliseconds
+func (gc *GraphQLConfig) GetBatchWait() time.Duration {
+	return parseDuration(gc.BatchWait, 2*time.Millisecond)
+}
+
+// GetMaxBatch returns the most keys fetched in one batch, defaulting to 100
+func (gc *GraphQLConfig) GetMaxBatch() int {
+	if gc.MaxBatch < 1 {
+		return 100
+	}
+	return gc.MaxBatch
+}
+
+// GetMaxDepth returns the deepest selection a query may have, defaulting to 10
+func (gc *GraphQLConfig) GetMaxDepth() int {
+	if gc.MaxDepth < 1 {
+		return 10
+	}
+	return gc.MaxDepth
+}
+
+// GetMaxParallelism returns how many resolvers of a query run at once, defaulting to 100.
+// Lookups only end up in the same batch when their resolvers run concurrently.
+func (gc *GraphQLConfig) GetMaxParallelism() int {
+	if gc.MaxParallelism < 1 {
+		return 100
+	}
+	return gc.MaxParallelism
+}
+
+// GetRetention returns how long events are kept for resuming, defaulting to 15 minutes
+func (oc *OrderEventsConfig) GetRetention() time.Duration {
+	return parseDuration(oc.Retention, 15*time.Minute)
+}
+
+// GetMaxBuffered returns how many events are kept per user, defaulting to 100
+func (oc *OrderEventsConfig) GetMaxBuffered() int {
+	if oc.MaxBuffered < 1 {
+		return 100
+	}
+	return oc.MaxBuffered
+}
+
+// GetHeartbeat returns the keep-alive interval of idle streams, defaulting to 15 seconds
+func (oc *OrderEventsConfig) GetHeartbeat() time.Duration {
+	return parseDuration(oc.Heartbeat, 15*time.Second)
+}
+
+// parseDuration parses a duration string with a fallback value
+func parseDuration(value string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
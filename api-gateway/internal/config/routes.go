@@ -0,0 +1,271 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// MethodAny matches every HTTP method in the route table
+const MethodAny = "ANY"
+
+type RouteTable struct {
+	DefaultVersion string `mapstructure:"default_version"`
+	Versions       []APIVersion
+	Routes         []Route
+}
+
+// APIVersion groups the routes published under a versioned prefix such as /v1.
+// A version may extend another one and only list the routes that differ;
+// inherited routes are dropped by listing them in Remove as "METHOD /path".
+type APIVersion struct {
+	Name       string
+	Extends    string
+	Deprecated bool
+	Remove     []string
+	Routes     []Route
+}
+
+type Route struct {
+	Method   string
+	Path     string
+	Upstream string
+	Rewrite  RouteRewrite
+	Auth     bool
+	Timeout  string
+	Cache    RouteCache
+	// Route group API key scopes refer to
+	Group string
+
+	// Set when the route is resolved from a versioned group
+	Version    string `mapstructure:"-"`
+	Deprecated bool   `mapstructure:"-"`
+}
+
+// RouteRewrite describes how a public request is mapped onto the upstream API
+type RouteRewrite struct {
+	Path   string
+	Method string
+}
+
+// RouteCache enables response caching for a GET route
+type RouteCache struct {
+	TTL string
+}
+
+// LoadRouteTable reads the route table from a YAML file
+func LoadRouteTable(path string) (*RouteTable, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read route table: %w", err)
+	}
+
+	var table RouteTable
+	if err := v.Unmarshal(&table); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal route table: %w", err)
+	}
+
+	return &table, nil
+}
+
+// Resolve expands versioned groups into public routes and validates the result.
+// Routes of the default version are also published without a prefix so that
+// existing clients keep working.
+func (t *RouteTable) Resolve(services ServicesConfig) ([]Route, error) {
+	versions := make(map[string]*APIVersion, len(t.Versions))
+	for i := range t.Versions {
+		version := &t.Versions[i]
+		if version.Name == "" || strings.Contains(version.Name, "/") {
+			return nil, fmt.Errorf("invalid version name %q", version.Name)
+		}
+		if _, exists := versions[version.Name]; exists {
+			return nil, fmt.Errorf("duplicate version %s", version.Name)
+		}
+		versions[version.Name] = version
+	}
+
+	if t.DefaultVersion != "" && versions[t.DefaultVersion] == nil {
+		return nil, fmt.Errorf("default version %s is not defined", t.DefaultVersion)
+	}
+
+	var routes []Route
+	for _, version := range t.Versions {
+		versionRoutes, err := collectVersionRoutes(versions, version.Name, map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, route := range versionRoutes {
+			route.Version = version.Name
+			route.Deprecated = version.Deprecated
+
+			if route.Rewrite.Path == "" {
+				return nil, fmt.Errorf("route %s %s in %s: rewrite path is required", route.Method, route.Path, version.Name)
+			}
+
+			if version.Name == t.DefaultVersion {
+				routes = append(routes, route)
+			}
+
+			route.Path = "/" + version.Name + route.Path
+			routes = append(routes, route)
+		}
+	}
+
+	routes = append(routes, t.Routes...)
+
+	if len(routes) == 0 {
+		return nil, fmt.Errorf("route table is empty")
+	}
+
+	seen := make(map[string]bool, len(routes))
+	for i := range routes {
+		route := &routes[i]
+		route.Method = strings.ToUpper(route.Method)
+		route.Rewrite.Method = strings.ToUpper(route.Rewrite.Method)
+
+		if err := route.validate(services); err != nil {
+			return nil, err
+		}
+
+		key := route.Method + " " + route.Path
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate route %s", key)
+		}
+		seen[key] = true
+	}
+
+	return routes, nil
+}
+
+// GetTimeout returns the upstream timeout for the route, or zero when none is set
+func (r *Route) GetTimeout() time.Duration {
+	d, err := time.ParseDuration(r.Timeout)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// GetCacheTTL returns how long responses of the route are cached, or zero when caching is off
+func (r *Route) GetCacheTTL() time.Duration {
+	d, err := time.ParseDuration(r.Cache.TTL)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// validate checks that the route is well formed and points to a configured service
+func (r *Route) validate(services ServicesConfig) error {
+	if !isRouteMethod(r.Method) {
+		return fmt.Errorf("route %s: unsupported method %q", r.Path, r.Method)
+	}
+
+	if !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("route %s %s: path must start with /", r.Method, r.Path)
+	}
+
+	if _, ok := services.Get(r.Upstream); !ok {
+		return fmt.Errorf("route %s %s: unknown upstream %q", r.Method, r.Path, r.Upstream)
+	}
+
+	if r.Timeout != "" {
+		if _, err := time.ParseDuration(r.Timeout); err != nil {
+			return fmt.Errorf("route %s %s: invalid timeout %q", r.Method, r.Path, r.Timeout)
+		}
+	}
+
+	if r.Cache.TTL != "" {
+		if r.Method != http.MethodGet || (r.Rewrite.Method != "" && r.Rewrite.Method != http.MethodGet) {
+			return fmt.Errorf("route %s %s: only GET routes can be cached", r.Method, r.Path)
+		}
+		if d, err := time.ParseDuration(r.Cache.TTL); err != nil || d <= 0 {
+			return fmt.Errorf("route %s %s: invalid cache ttl %q", r.Method, r.Path, r.Cache.TTL)
+		}
+	}
+
+	if r.Rewrite.Path != "" && !strings.HasPrefix(r.Rewrite.Path, "/") {
+		return fmt.Errorf("route %s %s: rewrite path must start with /", r.Method, r.Path)
+	}
+
+	if r.Rewrite.Method != "" && (r.Rewrite.Method == MethodAny || !isRouteMethod(r.Rewrite.Method)) {
+		return fmt.Errorf("route %s %s: unsupported rewrite method %q", r.Method, r.Path, r.Rewrite.Method)
+	}
+
+	// Every parameter used in the rewrite must be captured by the path
+	params := pathParams(r.Path)
+	for name := range pathParams(r.Rewrite.Path) {
+		if !params[name] {
+			return fmt.Errorf("route %s %s: rewrite uses unknown parameter %q", r.Method, r.Path, name)
+		}
+	}
+
+	return nil
+}
+
+// collectVersionRoutes returns the routes of a version including the ones it inherits.
+// Routes declared by the version override inherited routes with the same method and path.
+func collectVersionRoutes(versions map[string]*APIVersion, name string, visiting map[string]bool) ([]Route, error) {
+	version, ok := versions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown version %s", name)
+	}
+
+	if visiting[name] {
+		return nil, fmt.Errorf("version %s extends itself", name)
+	}
+	visiting[name] = true
+
+	var routes []Route
+	if version.Extends != "" {
+		inherited, err := collectVersionRoutes(versions, version.Extends, visiting)
+		if err != nil {
+			return nil, err
+		}
+
+		overridden := make(map[string]bool, len(version.Routes)+len(version.Remove))
+		for _, key := range version.Remove {
+			method, path, _ := strings.Cut(strings.TrimSpace(key), " ")
+			overridden[strings.ToUpper(method)+" "+strings.TrimSpace(path)] = true
+		}
+		for _, route := range version.Routes {
+			overridden[strings.ToUpper(route.Method)+" "+route.Path] = true
+		}
+
+		for _, route := range inherited {
+			if !overridden[strings.ToUpper(route.Method)+" "+route.Path] {
+				routes = append(routes, route)
+			}
+		}
+	}
+
+	return append(routes, version.Routes...), nil
+}
+
+// isRouteMethod reports whether the method can be used in the route table
+func isRouteMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, MethodAny:
+		return true
+	default:
+		return false
+	}
+}
+
+// pathParams returns the names of the :param and *param segments in a path pattern
+func pathParams(path string) map[string]bool {
+	params := make(map[string]bool)
+	for _, segment := range strings.Split(path, "/") {
+		if len(segment) > 1 && (segment[0] == ':' || segment[0] == '*') {
+			params[segment[1:]] = true
+		}
+	}
+	return params
+}
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/postgre"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// InitDB connects to the gateway's own database and migrates its models
+func InitDB(cfg *config.Config) (*gorm.DB, error) {
+	logLevel := logger.Silent
+	if cfg.Logging.Level == "debug" {
+		logLevel = logger.Info
+	}
+
+	// Create DB options
+	dbOptions := postgre.NewDBOptions().
+		WithHost(cfg.Database.Host).
+		WithPort(cfg.Database.Port).
+		WithDatabase(cfg.Database.Name).
+		WithUsername(cfg.Database.Username).
+		WithPassword(cfg.Database.Password).
+		WithSSLMode(cfg.Database.SSLMode).
+		WithMaxIdleConnections(cfg.Database.MaxIdleConnections).
+		WithMaxOpenConnections(cfg.Database.MaxOpenConnections).
+		WithConnectionMaxLifetime(cfg.Database.GetConnectionMaxLifetime())
+
+	// Connect to database
+	db, err := postgre.Connect(dbOptions, logLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Auto-migrate the models
+	if err := db.AutoMigrate(
+		&model.APIKey{},
+		&model.APIKeyUsage{},
+	); err != nil {
+		return nil, err
+	}
+
+	return db.GetConnection(), nil
+}
@@ -0,0 +1,336 @@
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/adapter/grpc/client/inventory"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/adapter/grpc/client/order"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/dataloader"
+	inventorypb "github.com/baccala1010/e-commerce/inventory/pkg/pb"
+	orderpb "github.com/baccala1010/e-commerce/order/pkg/pb"
+	gql "github.com/graph-gophers/graphql-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Schema executes storefront queries against the inventory and order services
+type Schema struct {
+	schema   *gql.Schema
+	resolver *Resolver
+}
+
+// Resolver is the root resolver of the storefront schema
+type Resolver struct {
+	inventoryClient  *inventory.Client
+	orderClient      *order.Client
+	inventoryTimeout time.Duration
+	orderTimeout     time.Duration
+	batchWait        time.Duration
+	maxBatch         int
+}
+
+// NewSchema parses the storefront schema and binds it to the service clients
+func NewSchema(inventoryClient *inventory.Client, orderClient *order.Client, cfg *config.Config) (*Schema, error) {
+	resolver := &Resolver{
+		inventoryClient:  inventoryClient,
+		orderClient:      orderClient,
+		inventoryTimeout: cfg.Services.Inventory.GetTimeout(),
+		orderTimeout:     cfg.Services.Order.GetTimeout(),
+		batchWait:        cfg.GraphQL.GetBatchWait(),
+		maxBatch:         cfg.GraphQL.GetMaxBatch(),
+	}
+
+	schema, err := gql.ParseSchema(schemaSDL, resolver,
+		gql.MaxDepth(cfg.GraphQL.GetMaxDepth()),
+		gql.MaxParallelism(cfg.GraphQL.GetMaxParallelism()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GraphQL schema: %w", err)
+	}
+
+	return &Schema{schema: schema, resolver: resolver}, nil
+}
+
+// Exec runs a query. Orders and reviews are only resolved for authenticated
+// callers whose API key, if any, is scoped to orders, and only those of the
+// users the caller may access.
+func (s *Schema) Exec(ctx context.Context, query, operationName string, variables map[string]interface{}, caller model.Caller) *gql.Response {
+	ctx = context.WithValue(ctx, requestStateKey{}, s.resolver.newRequestState(ctx, caller))
+	return s.schema.Exec(ctx, query, operationName, variables)
+}
+
+// requestStateKey is the context key of the per-request state
+type requestStateKey struct{}
+
+// requestState holds the loaders of a single query, so every product
+// looked up while resolving it is fetched at most once
+type requestState struct {
+	caller    model.Caller
+	products  *dataloader.Loader[string, *inventorypb.Product]
+	discounts *dataloader.Loader[string, []*inventorypb.Discount]
+}
+
+func (r *Resolver) newRequestState(ctx context.Context, caller model.Caller) *requestState {
+	return &requestState{
+		caller:    caller,
+		products:  dataloader.New(ctx, r.fetchProducts, r.batchWait, r.maxBatch),
+		discounts: dataloader.New(ctx, r.fetchDiscounts, r.batchWait, r.maxBatch),
+	}
+}
+
+func stateFrom(ctx context.Context) *requestState {
+	return ctx.Value(requestStateKey{}).(*requestState)
+}
+
+// fetchProducts looks up a batch of products with a single inventory call
+func (r *Resolver) fetchProducts(ctx context.Context, ids []string) (map[string]*inventorypb.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.inventoryTimeout)
+	defer cancel()
+
+	resp, err := r.inventoryClient.GetProductsByIDs(ctx, &inventorypb.GetProductsByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	products := make(map[string]*inventorypb.Product, len(resp.GetProducts()))
+	for _, product := range resp.GetProducts() {
+		products[product.GetId()] = product
+	}
+	return products, nil
+}
+
+// fetchDiscounts looks up the active discounts of a batch of products with a single inventory call
+func (r *Resolver) fetchDiscounts(ctx context.Context, productIDs []string) (map[string][]*inventorypb.Discount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.inventoryTimeout)
+	defer cancel()
+
+	resp, err := r.inventoryClient.GetActiveDiscountsForProducts(ctx, &inventorypb.GetActiveDiscountsForProductsRequest{
+		ProductIds: productIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	discounts := make(map[string][]*inventorypb.Discount, len(resp.GetProductDiscounts()))
+	for _, productDiscounts := range resp.GetProductDiscounts() {
+		discounts[productDiscounts.GetProductId()] = productDiscounts.GetDiscounts()
+	}
+	return discounts, nil
+}
+
+type idArgs struct {
+	ID gql.ID
+}
+
+type pageArgs struct {
+	Page  int32
+	Limit int32
+}
+
+func (r *Resolver) Product(ctx context.Context, args idArgs) (*productResolver, error) {
+	product, found, err := stateFrom(ctx).products.Load(ctx, string(args.ID))
+	if err != nil || !found {
+		return nil, resolverError(err)
+	}
+	return &productResolver{product: product}, nil
+}
+
+func (r *Resolver) Products(ctx context.Context, args struct {
+	pageArgs
+	CategoryID *gql.ID
+	Search     *string
+	SKU        *string
+	Options    *[]struct {
+		Name  string
+		Value string
+	}
+	InStock     *bool
+	OnPromotion *bool
+	Sort        *string
+}) (*productListResolver, error) {
+	req := &inventorypb.ListProductsRequest{Page: args.Page, Limit: args.Limit}
+	if args.CategoryID != nil {
+		req.CategoryId = string(*args.CategoryID)
+	}
+	if args.Search != nil {
+		req.Search = *args.Search
+	}
+	if args.SKU != nil {
+		req.Sku = *args.SKU
+	}
+	if args.Options != nil {
+		req.Options = make(map[string]string, len(*args.Options))
+		for _, option := range *args.Options {
+			req.Options[option.Name] = option.Value
+		}
+	}
+	if args.InStock != nil {
+		req.InStock = *args.InStock
+	}
+	if args.OnPromotion != nil {
+		req.OnPromotion = *args.OnPromotion
+	}
+	if args.Sort != nil {
+		req.Sort = *args.Sort
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.inventoryTimeout)
+	defer cancel()
+
+	resp, err := r.inventoryClient.ListProducts(ctx, req)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return newProductListResolver(resp), nil
+}
+
+func (r *Resolver) ProductsWithPromotion(ctx context.Context, args pageArgs) (*productListResolver, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.inventoryTimeout)
+	defer cancel()
+
+	resp, err := r.inventoryClient.GetAllProductsWithPromotion(ctx, &inventorypb.GetProductsWithPromotionRequest{
+		Page:  args.Page,
+		Limit: args.Limit,
+	})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return newProductListResolver(resp), nil
+}
+
+func (r *Resolver) Category(ctx context.Context, args idArgs) (*categoryResolver, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.inventoryTimeout)
+	defer cancel()
+
+	resp, err := r.inventoryClient.GetCategoryByID(ctx, &inventorypb.GetCategoryRequest{Id: string(args.ID)})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &categoryResolver{category: resp.GetCategory()}, nil
+}
+
+func (r *Resolver) Categories(ctx context.Context, args pageArgs) (*categoryListResolver, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.inventoryTimeout)
+	defer cancel()
+
+	resp, err := r.inventoryClient.ListCategories(ctx, &inventorypb.ListCategoriesRequest{
+		Page:  args.Page,
+		Limit: args.Limit,
+	})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &categoryListResolver{list: resp}, nil
+}
+
+func (r *Resolver) Discount(ctx context.Context, args idArgs) (*discountResolver, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.inventoryTimeout)
+	defer cancel()
+
+	resp, err := r.inventoryClient.GetDiscountByID(ctx, &inventorypb.GetDiscountRequest{Id: string(args.ID)})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &discountResolver{discount: resp.GetDiscount()}, nil
+}
+
+// authorizeOrders checks that the caller may resolve order and review fields.
+// The graphql scope of an API key does not extend to orders.
+func authorizeOrders(caller model.Caller) error {
+	if !caller.Authenticated() {
+		return errors.New(model.ErrAuthorizationRequired)
+	}
+	if !caller.Allows(model.ScopeOrders) {
+		return errors.New(model.ErrOrdersScopeDenied)
+	}
+	return nil
+}
+
+// Order resolves an order of a user the caller may access. Orders of other
+// users resolve to null, as if they did not exist.
+func (r *Resolver) Order(ctx context.Context, args idArgs) (*orderResolver, error) {
+	caller := stateFrom(ctx).caller
+	if err := authorizeOrders(caller); err != nil {
+		return nil, err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, r.orderTimeout)
+	defer cancel()
+
+	resp, err := r.orderClient.GetOrderByID(callCtx, &orderpb.GetOrderRequest{Id: string(args.ID)})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	if !caller.CanAccessUser(resp.GetOrder().GetUserId()) {
+		return nil, nil
+	}
+	return &orderResolver{root: r, order: resp.GetOrder()}, nil
+}
+
+// Orders lists the orders of the calling user. Partner API keys name the user
+// in userId; a user may only name themselves.
+func (r *Resolver) Orders(ctx context.Context, args struct {
+	pageArgs
+	UserID *gql.ID
+}) (*orderListResolver, error) {
+	caller := stateFrom(ctx).caller
+	if err := authorizeOrders(caller); err != nil {
+		return nil, err
+	}
+
+	userID := caller.UserID
+	if args.UserID != nil {
+		userID = string(*args.UserID)
+	}
+	if userID == "" {
+		return nil, errors.New(model.ErrUserIDRequired)
+	}
+	if !caller.CanAccessUser(userID) {
+		return nil, errors.New(model.ErrForbiddenUser)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, r.orderTimeout)
+	defer cancel()
+
+	resp, err := r.orderClient.ListUserOrders(callCtx, &orderpb.ListUserOrdersRequest{
+		UserId: userID,
+		Page:   args.Page,
+		Limit:  args.Limit,
+	})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &orderListResolver{root: r, list: resp}, nil
+}
+
+// resolverError turns a failed backend call into a GraphQL error. Missing
+// entities resolve to null rather than to an error.
+func resolverError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return nil
+	case codes.DeadlineExceeded:
+		return errors.New("upstream timeout")
+	case codes.Unavailable:
+		return errors.New("upstream unavailable")
+	}
+	return errors.New(st.Message())
+}
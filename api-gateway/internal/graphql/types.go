@@ -0,0 +1,390 @@
+package graphql
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	inventorypb "github.com/baccala1010/e-commerce/inventory/pkg/pb"
+	orderpb "github.com/baccala1010/e-commerce/order/pkg/pb"
+	gql "github.com/graph-gophers/graphql-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type productResolver struct {
+	product *inventorypb.Product
+}
+
+func (r *productResolver) ID() gql.ID             { return gql.ID(r.product.GetId()) }
+func (r *productResolver) Name() string           { return r.product.GetName() }
+func (r *productResolver) Description() string    { return r.product.GetDescription() }
+func (r *productResolver) Price() float64         { return r.product.GetPrice() }
+func (r *productResolver) StockLevel() int32      { return r.product.GetStockLevel() }
+func (r *productResolver) AvailableToSell() int32 { return r.product.GetAvailableToSell() }
+func (r *productResolver) CreatedAt() *string     { return formatTime(r.product.GetCreatedAt()) }
+func (r *productResolver) UpdatedAt() *string     { return formatTime(r.product.GetUpdatedAt()) }
+
+func (r *productResolver) Category() *categoryResolver {
+	if r.product.GetCategory() == nil {
+		return nil
+	}
+	return &categoryResolver{category: r.product.GetCategory()}
+}
+
+// Discounts of all products in the query are fetched together
+func (r *productResolver) Discounts(ctx context.Context) ([]*discountResolver, error) {
+	discounts, _, err := stateFrom(ctx).discounts.Load(ctx, r.product.GetId())
+	if err != nil {
+		return nil, resolverError(err)
+	}
+
+	resolvers := make([]*discountResolver, 0, len(discounts))
+	for _, discount := range discounts {
+		resolvers = append(resolvers, &discountResolver{discount: discount})
+	}
+	return resolvers, nil
+}
+
+func (r *productResolver) Variants() []*variantResolver {
+	resolvers := make([]*variantResolver, 0, len(r.product.GetVariants()))
+	for _, variant := range r.product.GetVariants() {
+		resolvers = append(resolvers, &variantResolver{variant: variant})
+	}
+	return resolvers
+}
+
+func (r *productResolver) Media() []*mediaResolver {
+	resolvers := make([]*mediaResolver, 0, len(r.product.GetMedia()))
+	for _, media := range r.product.GetMedia() {
+		resolvers = append(resolvers, &mediaResolver{media: media})
+	}
+	return resolvers
+}
+
+type mediaResolver struct {
+	media *inventorypb.ProductMedia
+}
+
+func (r *mediaResolver) ID() gql.ID          { return gql.ID(r.media.GetId()) }
+func (r *mediaResolver) Position() int32     { return r.media.GetPosition() }
+func (r *mediaResolver) Kind() string        { return r.media.GetKind() }
+func (r *mediaResolver) FileName() string    { return r.media.GetFileName() }
+func (r *mediaResolver) ContentType() string { return r.media.GetContentType() }
+func (r *mediaResolver) Size() float64       { return float64(r.media.GetSize()) }
+func (r *mediaResolver) Width() *int32       { return r.media.Width }
+func (r *mediaResolver) Height() *int32      { return r.media.Height }
+func (r *mediaResolver) CreatedAt() *string  { return formatTime(r.media.GetCreatedAt()) }
+
+// URL is the public gateway route serving the content
+func (r *mediaResolver) URL() string {
+	return "/products/" + r.media.GetProductId() + "/media/" + r.media.GetId()
+}
+
+func (r *mediaResolver) ThumbnailURL() *string {
+	if !r.media.GetHasThumbnail() {
+		return nil
+	}
+	url := r.URL() + "/thumbnail"
+	return &url
+}
+
+type variantResolver struct {
+	variant *inventorypb.ProductVariant
+}
+
+func (r *variantResolver) ID() gql.ID             { return gql.ID(r.variant.GetId()) }
+func (r *variantResolver) ProductID() gql.ID      { return gql.ID(r.variant.GetProductId()) }
+func (r *variantResolver) SKU() string            { return r.variant.GetSku() }
+func (r *variantResolver) Price() *float64        { return r.variant.Price }
+func (r *variantResolver) StockLevel() int32      { return r.variant.GetStockLevel() }
+func (r *variantResolver) AvailableToSell() int32 { return r.variant.GetAvailableToSell() }
+func (r *variantResolver) CreatedAt() *string     { return formatTime(r.variant.GetCreatedAt()) }
+func (r *variantResolver) UpdatedAt() *string     { return formatTime(r.variant.GetUpdatedAt()) }
+
+// Options are sorted by name since the option map has no order
+func (r *variantResolver) Options() []*variantOptionResolver {
+	names := make([]string, 0, len(r.variant.GetOptions()))
+	for name := range r.variant.GetOptions() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resolvers := make([]*variantOptionResolver, 0, len(names))
+	for _, name := range names {
+		resolvers = append(resolvers, &variantOptionResolver{name: name, value: r.variant.GetOptions()[name]})
+	}
+	return resolvers
+}
+
+type variantOptionResolver struct {
+	name  string
+	value string
+}
+
+func (r *variantOptionResolver) Name() string  { return r.name }
+func (r *variantOptionResolver) Value() string { return r.value }
+
+type productListResolver struct {
+	list *inventorypb.ListProductsResponse
+}
+
+func newProductListResolver(list *inventorypb.ListProductsResponse) *productListResolver {
+	return &productListResolver{list: list}
+}
+
+func (r *productListResolver) Total() int32 { return r.list.GetTotal() }
+
+func (r *productListResolver) Products() []*productResolver {
+	resolvers := make([]*productResolver, 0, len(r.list.GetProducts()))
+	for _, product := range r.list.GetProducts() {
+		resolvers = append(resolvers, &productResolver{product: product})
+	}
+	return resolvers
+}
+
+func (r *productListResolver) Facets() *productFacetsResolver {
+	if r.list.GetFacets() == nil {
+		return nil
+	}
+	return &productFacetsResolver{facets: r.list.GetFacets()}
+}
+
+type productFacetsResolver struct {
+	facets *inventorypb.ProductFacets
+}
+
+func (r *productFacetsResolver) Categories() []*categoryFacetResolver {
+	resolvers := make([]*categoryFacetResolver, 0, len(r.facets.GetCategories()))
+	for _, facet := range r.facets.GetCategories() {
+		resolvers = append(resolvers, &categoryFacetResolver{facet: facet})
+	}
+	return resolvers
+}
+
+func (r *productFacetsResolver) PriceRanges() []*priceRangeFacetResolver {
+	resolvers := make([]*priceRangeFacetResolver, 0, len(r.facets.GetPriceRanges()))
+	for _, facet := range r.facets.GetPriceRanges() {
+		resolvers = append(resolvers, &priceRangeFacetResolver{facet: facet})
+	}
+	return resolvers
+}
+
+type categoryFacetResolver struct {
+	facet *inventorypb.CategoryFacet
+}
+
+func (r *categoryFacetResolver) CategoryID() gql.ID { return gql.ID(r.facet.GetCategoryId()) }
+func (r *categoryFacetResolver) Name() string       { return r.facet.GetName() }
+func (r *categoryFacetResolver) Count() int32       { return r.facet.GetCount() }
+
+type priceRangeFacetResolver struct {
+	facet *inventorypb.PriceRangeFacet
+}
+
+func (r *priceRangeFacetResolver) Min() float64  { return r.facet.GetMin() }
+func (r *priceRangeFacetResolver) Max() *float64 { return r.facet.Max }
+func (r *priceRangeFacetResolver) Count() int32  { return r.facet.GetCount() }
+
+type categoryResolver struct {
+	category *inventorypb.Category
+}
+
+func (r *categoryResolver) ID() gql.ID          { return gql.ID(r.category.GetId()) }
+func (r *categoryResolver) Name() string        { return r.category.GetName() }
+func (r *categoryResolver) Description() string { return r.category.GetDescription() }
+func (r *categoryResolver) CreatedAt() *string  { return formatTime(r.category.GetCreatedAt()) }
+func (r *categoryResolver) UpdatedAt() *string  { return formatTime(r.category.GetUpdatedAt()) }
+
+func (r *categoryResolver) ParentID() *gql.ID {
+	if r.category.GetParentId() == "" {
+		return nil
+	}
+	id := gql.ID(r.category.GetParentId())
+	return &id
+}
+
+type categoryListResolver struct {
+	list *inventorypb.ListCategoriesResponse
+}
+
+func (r *categoryListResolver) Total() int32 { return r.list.GetTotal() }
+
+func (r *categoryListResolver) Categories() []*categoryResolver {
+	resolvers := make([]*categoryResolver, 0, len(r.list.GetCategories()))
+	for _, category := range r.list.GetCategories() {
+		resolvers = append(resolvers, &categoryResolver{category: category})
+	}
+	return resolvers
+}
+
+type discountResolver struct {
+	discount *inventorypb.Discount
+}
+
+func (r *discountResolver) ID() gql.ID                  { return gql.ID(r.discount.GetId()) }
+func (r *discountResolver) Name() string                { return r.discount.GetName() }
+func (r *discountResolver) Description() string         { return r.discount.GetDescription() }
+func (r *discountResolver) DiscountPercentage() float64 { return r.discount.GetDiscountPercentage() }
+func (r *discountResolver) StartDate() *string          { return formatTime(r.discount.GetStartDate()) }
+func (r *discountResolver) EndDate() *string            { return formatTime(r.discount.GetEndDate()) }
+func (r *discountResolver) IsActive() bool              { return r.discount.GetIsActive() }
+func (r *discountResolver) CreatedAt() *string          { return formatTime(r.discount.GetCreatedAt()) }
+func (r *discountResolver) UpdatedAt() *string          { return formatTime(r.discount.GetUpdatedAt()) }
+
+// Products the discount applies to are fetched with the other products of the query
+func (r *discountResolver) Products(ctx context.Context) ([]*productResolver, error) {
+	products, err := stateFrom(ctx).products.LoadMany(ctx, r.discount.GetApplicableProducts())
+	if err != nil {
+		return nil, resolverError(err)
+	}
+
+	resolvers := make([]*productResolver, 0, len(products))
+	for _, product := range products {
+		resolvers = append(resolvers, &productResolver{product: product})
+	}
+	return resolvers, nil
+}
+
+type orderResolver struct {
+	root  *Resolver
+	order *orderpb.Order
+}
+
+func (r *orderResolver) ID() gql.ID              { return gql.ID(r.order.GetId()) }
+func (r *orderResolver) UserID() gql.ID          { return gql.ID(r.order.GetUserId()) }
+func (r *orderResolver) Status() string          { return r.order.GetStatus().String() }
+func (r *orderResolver) TotalAmount() float64    { return r.order.GetTotalAmount() }
+func (r *orderResolver) ShippingName() string    { return r.order.GetShippingName() }
+func (r *orderResolver) ShippingEmail() string   { return r.order.GetShippingEmail() }
+func (r *orderResolver) ShippingPhone() string   { return r.order.GetShippingPhone() }
+func (r *orderResolver) ShippingAddress() string { return r.order.GetShippingAddress() }
+func (r *orderResolver) CreatedAt() *string      { return formatTime(r.order.GetCreatedAt()) }
+func (r *orderResolver) UpdatedAt() *string      { return formatTime(r.order.GetUpdatedAt()) }
+
+func (r *orderResolver) Payment() *paymentResolver {
+	if r.order.GetPayment() == nil {
+		return nil
+	}
+	return &paymentResolver{payment: r.order.GetPayment()}
+}
+
+func (r *orderResolver) Items() []*orderItemResolver {
+	resolvers := make([]*orderItemResolver, 0, len(r.order.GetItems()))
+	for _, item := range r.order.GetItems() {
+		resolvers = append(resolvers, &orderItemResolver{item: item})
+	}
+	return resolvers
+}
+
+func (r *orderResolver) Reviews(ctx context.Context) ([]*reviewResolver, error) {
+	if err := authorizeOrders(stateFrom(ctx).caller); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.root.orderTimeout)
+	defer cancel()
+
+	resp, err := r.root.orderClient.GetOrderReviews(ctx, &orderpb.GetOrderReviewsRequest{OrderId: r.order.GetId()})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+
+	resolvers := make([]*reviewResolver, 0, len(resp.GetReviews()))
+	for _, review := range resp.GetReviews() {
+		resolvers = append(resolvers, &reviewResolver{review: review})
+	}
+	return resolvers, nil
+}
+
+type orderListResolver struct {
+	root *Resolver
+	list *orderpb.ListOrdersResponse
+}
+
+func (r *orderListResolver) Total() int32 { return r.list.GetTotal() }
+
+func (r *orderListResolver) Orders() []*orderResolver {
+	resolvers := make([]*orderResolver, 0, len(r.list.GetOrders()))
+	for _, order := range r.list.GetOrders() {
+		resolvers = append(resolvers, &orderResolver{root: r.root, order: order})
+	}
+	return resolvers
+}
+
+type orderItemResolver struct {
+	item *orderpb.OrderItem
+}
+
+func (r *orderItemResolver) ID() gql.ID         { return gql.ID(r.item.GetId()) }
+func (r *orderItemResolver) ProductID() gql.ID  { return gql.ID(r.item.GetProductId()) }
+func (r *orderItemResolver) Quantity() int32    { return r.item.GetQuantity() }
+func (r *orderItemResolver) UnitPrice() float64 { return r.item.GetUnitPrice() }
+
+func (r *orderItemResolver) VariantID() *gql.ID {
+	if r.item.GetVariantId() == "" {
+		return nil
+	}
+	id := gql.ID(r.item.GetVariantId())
+	return &id
+}
+
+// Product lookups of all order lines in the query are batched into one inventory call
+func (r *orderItemResolver) Product(ctx context.Context) (*productResolver, error) {
+	product, found, err := stateFrom(ctx).products.Load(ctx, r.item.GetProductId())
+	if err != nil || !found {
+		return nil, resolverError(err)
+	}
+	return &productResolver{product: product}, nil
+}
+
+// The variant is looked up among the variants of the batched product
+func (r *orderItemResolver) Variant(ctx context.Context) (*variantResolver, error) {
+	if r.item.GetVariantId() == "" {
+		return nil, nil
+	}
+
+	product, found, err := stateFrom(ctx).products.Load(ctx, r.item.GetProductId())
+	if err != nil || !found {
+		return nil, resolverError(err)
+	}
+
+	for _, variant := range product.GetVariants() {
+		if variant.GetId() == r.item.GetVariantId() {
+			return &variantResolver{variant: variant}, nil
+		}
+	}
+	return nil, nil
+}
+
+type paymentResolver struct {
+	payment *orderpb.Payment
+}
+
+func (r *paymentResolver) ID() gql.ID            { return gql.ID(r.payment.GetId()) }
+func (r *paymentResolver) Amount() float64       { return r.payment.GetAmount() }
+func (r *paymentResolver) Method() string        { return r.payment.GetMethod().String() }
+func (r *paymentResolver) Status() string        { return r.payment.GetStatus().String() }
+func (r *paymentResolver) TransactionID() string { return r.payment.GetTransactionId() }
+func (r *paymentResolver) PaymentDate() *string  { return formatTime(r.payment.GetPaymentDate()) }
+func (r *paymentResolver) CreatedAt() *string    { return formatTime(r.payment.GetCreatedAt()) }
+func (r *paymentResolver) UpdatedAt() *string    { return formatTime(r.payment.GetUpdatedAt()) }
+
+type reviewResolver struct {
+	review *orderpb.Review
+}
+
+func (r *reviewResolver) ID() gql.ID          { return gql.ID(r.review.GetId()) }
+func (r *reviewResolver) OrderID() gql.ID     { return gql.ID(r.review.GetOrderId()) }
+func (r *reviewResolver) UserID() gql.ID      { return gql.ID(r.review.GetUserId()) }
+func (r *reviewResolver) Rating() int32       { return int32(r.review.GetRating()) }
+func (r *reviewResolver) Description() string { return r.review.GetDescription() }
+func (r *reviewResolver) CreatedAt() *string  { return formatTime(r.review.GetCreateAt()) }
+
+// formatTime renders a timestamp as RFC 3339, or null when it is not set
+func formatTime(ts *timestamppb.Timestamp) *string {
+	if ts == nil {
+		return nil
+	}
+	s := ts.AsTime().Format(time.RFC3339)
+	return &s
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// defaultUsageMonths is how many months of usage are reported by default
+const defaultUsageMonths = 12
+
+// APIKeyHandler serves the admin endpoints for partner API keys
+type APIKeyHandler struct {
+	apiKeyUseCase usecase.APIKeyUseCase
+}
+
+// NewAPIKeyHandler creates a new API key handler
+func NewAPIKeyHandler(apiKeyUseCase usecase.APIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUseCase: apiKeyUseCase,
+	}
+}
+
+// CreateAPIKey issues a new key; the secret is only part of this response
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var request model.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	key, err := h.apiKeyUseCase.CreateAPIKey(c.Request.Context(), request)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), model.ErrInvalidAPIKeyScope) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, key)
+}
+
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	keys, err := h.apiKeyUseCase.ListAPIKeys(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, keys)
+}
+
+func (h *APIKeyHandler) GetAPIKey(c *gin.Context) {
+	id, ok := parseKeyID(c)
+	if !ok {
+		return
+	}
+
+	key, err := h.apiKeyUseCase.GetAPIKey(c.Request.Context(), id)
+	if err != nil {
+		writeAPIKeyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, key)
+}
+
+// RevokeAPIKey disables a key permanently
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	id, ok := parseKeyID(c)
+	if !ok {
+		return
+	}
+
+	key, err := h.apiKeyUseCase.RevokeAPIKey(c.Request.Context(), id)
+	if err != nil {
+		writeAPIKeyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, key)
+}
+
+// RotateAPIKey replaces the secret of a key, keeping its scopes, quota and usage
+func (h *APIKeyHandler) RotateAPIKey(c *gin.Context) {
+	id, ok := parseKeyID(c)
+	if !ok {
+		return
+	}
+
+	key, err := h.apiKeyUseCase.RotateAPIKey(c.Request.Context(), id)
+	if err != nil {
+		writeAPIKeyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, key)
+}
+
+// GetAPIKeyUsage reports the request counts of a key per month
+func (h *APIKeyHandler) GetAPIKeyUsage(c *gin.Context) {
+	id, ok := parseKeyID(c)
+	if !ok {
+		return
+	}
+
+	months, err := strconv.Atoi(c.DefaultQuery("months", strconv.Itoa(defaultUsageMonths)))
+	if err != nil || months < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid months"})
+		return
+	}
+
+	report, err := h.apiKeyUseCase.GetAPIKeyUsage(c.Request.Context(), id, months)
+	if err != nil {
+		writeAPIKeyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// RegisterAPIKeyRoutes registers the admin endpoints for API keys
+func RegisterAPIKeyRoutes(router gin.IRouter, apiKeyHandler *APIKeyHandler) {
+	router.POST("/api-keys", apiKeyHandler.CreateAPIKey)
+	router.GET("/api-keys", apiKeyHandler.ListAPIKeys)
+	router.GET("/api-keys/:id", apiKeyHandler.GetAPIKey)
+	router.POST("/api-keys/:id/revoke", apiKeyHandler.RevokeAPIKey)
+	router.POST("/api-keys/:id/rotate", apiKeyHandler.RotateAPIKey)
+	router.GET("/api-keys/:id/usage", apiKeyHandler.GetAPIKeyUsage)
+}
+
+// parseKeyID reads the key ID from the path, answering 400 when it is malformed
+func parseKeyID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func writeAPIKeyError(c *gin.Context, err error) {
+	switch err.Error() {
+	case model.ErrAPIKeyNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case model.ErrAPIKeyRevoked:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/httpcache"
+	"github.com/gin-gonic/gin"
+)
+
+// Values of the X-Cache response header
+const (
+	cacheHit    = "HIT"
+	cacheMiss   = "MISS"
+	cacheBypass = "BYPASS"
+)
+
+// handleCached serves a cacheable route from the response store, fetching and
+// storing the upstream response on a miss
+func (p *ServiceProxy) handleCached(c *gin.Context, route config.Route, path string, ttl time.Duration) {
+	directives := parseCacheControl(c.GetHeader("Cache-Control"))
+	if directives.noStore {
+		c.Header("X-Cache", cacheBypass)
+		p.handleProxy(c, c.Writer, route.Upstream, route.Rewrite.Method, path)
+		return
+	}
+
+	key := cacheKey(c.Request, route.Upstream, path)
+	ifNoneMatch := c.GetHeader("If-None-Match")
+
+	// no-cache asks for a fresh response, which still refreshes the store
+	if !directives.noCache {
+		if entry, ok := p.cache.Get(key); ok {
+			writeCachedResponse(c, entry, cacheHit, ifNoneMatch)
+			return
+		}
+	}
+
+	// Conditional headers are answered by the gateway, the upstream must send the full body
+	c.Request.Header.Del("If-None-Match")
+	c.Request.Header.Del("If-Modified-Since")
+
+	rec := newResponseRecorder()
+	p.handleProxy(c, rec, route.Upstream, route.Rewrite.Method, path)
+
+	ttl, cacheable := responseTTL(rec, ttl)
+	if !cacheable {
+		c.Header("X-Cache", cacheBypass)
+		rec.writeTo(c.Writer)
+		return
+	}
+
+	now := time.Now()
+	entry := &httpcache.Entry{
+		Status:   rec.status,
+		Header:   rec.header.Clone(),
+		Body:     rec.body.Bytes(),
+		ETag:     rec.header.Get("ETag"),
+		Upstream: route.Upstream,
+		Path:     path,
+		Stored:   now,
+		Expires:  now.Add(ttl),
+		Private:  c.GetHeader("Authorization") != "",
+	}
+	if entry.ETag == "" {
+		entry.ETag = computeETag(entry.Body)
+	}
+
+	p.cache.Set(key, entry)
+	writeCachedResponse(c, entry, cacheMiss, ifNoneMatch)
+}
+
+// writeCachedResponse writes a stored response, or 304 when the client's
+// If-None-Match shows it already has it
+func writeCachedResponse(c *gin.Context, entry *httpcache.Entry, state, ifNoneMatch string) {
+	header := c.Writer.Header()
+	for name, values := range entry.Header {
+		header[name] = values
+	}
+
+	scope := "public"
+	if entry.Private {
+		scope = "private"
+	}
+	maxAge := int(entry.TTL().Seconds())
+	if maxAge < 0 {
+		maxAge = 0
+	}
+
+	header.Set("ETag", entry.ETag)
+	header.Set("Cache-Control", scope+", max-age="+strconv.Itoa(maxAge))
+	header.Set("Age", strconv.Itoa(int(entry.Age().Seconds())))
+	header.Set("X-Cache", state)
+
+	if etagMatches(ifNoneMatch, entry.ETag) {
+		header.Del("Content-Length")
+		header.Del("Content-Type")
+		c.Status(http.StatusNotModified)
+		c.Writer.WriteHeaderNow()
+		return
+	}
+
+	c.Status(entry.Status)
+	_, _ = c.Writer.Write(entry.Body)
+}
+
+// cacheKey identifies a response by the caller's auth scope, the upstream, the
+// upstream path and the normalized query string
+func cacheKey(req *http.Request, upstream, path string) string {
+	scope := "anonymous"
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		sum := sha256.Sum256([]byte(auth))
+		scope = hex.EncodeToString(sum[:8])
+	}
+
+	// Encode sorts the parameters so their order doesn't split the cache
+	return scope + " " + upstream + " " + path + "?" + req.URL.Query().Encode()
+}
+
+// responseTTL decides whether an upstream response may be stored and for how long
+func responseTTL(rec *responseRecorder, ttl time.Duration) (time.Duration, bool) {
+	if rec.status != http.StatusOK || rec.header.Get("Set-Cookie") != "" {
+		return 0, false
+	}
+
+	directives := parseCacheControl(rec.header.Get("Cache-Control"))
+	if directives.noStore || directives.noCache || directives.private {
+		return 0, false
+	}
+
+	// The upstream may shorten the route's TTL but not extend it
+	if directives.maxAge >= 0 {
+		upstreamTTL := time.Duration(directives.maxAge) * time.Second
+		if upstreamTTL <= 0 {
+			return 0, false
+		}
+		if upstreamTTL < ttl {
+			ttl = upstreamTTL
+		}
+	}
+
+	return ttl, true
+}
+
+// cacheControl holds the Cache-Control directives the gateway acts on
+type cacheControl struct {
+	noStore bool
+	noCache bool
+	private bool
+	maxAge  int
+}
+
+// parseCacheControl parses a Cache-Control header; maxAge is -1 when absent
+func parseCacheControl(value string) cacheControl {
+	directives := cacheControl{maxAge: -1}
+	for _, directive := range strings.Split(value, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store":
+			directives.noStore = true
+		case "no-cache":
+			directives.noCache = true
+		case "private":
+			directives.private = true
+		case "max-age":
+			if seconds, err := strconv.Atoi(strings.Trim(arg, `"`)); err == nil {
+				directives.maxAge = seconds
+			}
+		}
+	}
+	return directives
+}
+
+// computeETag derives a strong validator from the response body
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header matches the entity tag
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// responseRecorder buffers an upstream response so it can be stored before it is sent
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{header: make(http.Header), status: http.StatusOK}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+// writeTo copies the buffered response to the client unchanged
+func (r *responseRecorder) writeTo(w gin.ResponseWriter) {
+	header := w.Header()
+	for name, values := range r.header {
+		header[name] = values
+	}
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body.Bytes())
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/graphql"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// GraphQLHandler serves storefront queries over the inventory and order services
+type GraphQLHandler struct {
+	schema *graphql.Schema
+}
+
+// NewGraphQLHandler creates a new GraphQL handler
+func NewGraphQLHandler(schema *graphql.Schema) *GraphQLHandler {
+	return &GraphQLHandler{schema: schema}
+}
+
+// graphQLRequest is a query sent as a JSON body or as query parameters
+type graphQLRequest struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Query executes a GraphQL query. Errors of individual fields are reported in
+// "errors" next to the data that could be resolved, as the GraphQL spec asks.
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var request graphQLRequest
+	if c.Request.Method == http.MethodGet {
+		request.Query = c.Query("query")
+		request.OperationName = c.Query("operationName")
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid variables: " + err.Error()})
+				return
+			}
+		}
+		if request.Query == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "query is required"})
+			return
+		}
+	} else if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := h.schema.Exec(c.Request.Context(), request.Query, request.OperationName, request.Variables, middleware.CallerFrom(c))
+	c.JSON(http.StatusOK, response)
+}
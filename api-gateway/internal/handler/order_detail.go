@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/middleware"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// OrderDetailHandler serves the aggregated order page
+type OrderDetailHandler struct {
+	useCase usecase.OrderDetailUseCase
+}
+
+// NewOrderDetailHandler creates a new order detail handler
+func NewOrderDetailHandler(useCase usecase.OrderDetailUseCase) *OrderDetailHandler {
+	return &OrderDetailHandler{useCase: useCase}
+}
+
+// GetOrderDetail returns the order with its payment, reviews, products and discounts.
+// Users only get their own orders. Parts that could not be fetched are reported in
+// "errors"; the response is 502 only when the order itself is unavailable.
+func (h *OrderDetailHandler) GetOrderDetail(c *gin.Context) {
+	detail, err := h.useCase.GetOrderDetail(c.Request.Context(), c.Param("id"), middleware.CallerFrom(c))
+	if err != nil {
+		switch err.Error() {
+		case model.ErrOrderNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case model.ErrInvalidOrderID:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	if _, failed := detail.Errors[model.PartOrder]; failed {
+		c.JSON(http.StatusBadGateway, detail)
+		return
+	}
+
+	c.JSON(http.StatusOK, detail)
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/middleware"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/eventhub"
+	"github.com/gin-gonic/gin"
+)
+
+// reconnectDelay is how long clients wait before reconnecting a dropped stream
+const reconnectDelay = 3 * time.Second
+
+// OrderEventsHandler streams order status changes to customers
+type OrderEventsHandler struct {
+	hub       *eventhub.Hub
+	heartbeat time.Duration
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewOrderEventsHandler creates a new order events handler
+func NewOrderEventsHandler(hub *eventhub.Hub, heartbeat time.Duration) *OrderEventsHandler {
+	return &OrderEventsHandler{hub: hub, heartbeat: heartbeat, closing: make(chan struct{})}
+}
+
+// Close ends every open stream, so the server can shut down gracefully while
+// clients are connected. The clients reconnect and resume on another instance.
+func (h *OrderEventsHandler) Close() {
+	h.closeOnce.Do(func() { close(h.closing) })
+}
+
+// Stream sends the status changes of a user's orders and payments as
+// Server-Sent Events. A client reconnecting with Last-Event-ID (or the
+// last_event_id query parameter) first receives the events it missed.
+// Users receive their own events; partner API keys name the user in user_id.
+func (h *OrderEventsHandler) Stream(c *gin.Context) {
+	caller := middleware.CallerFrom(c)
+	userID := c.DefaultQuery("user_id", caller.UserID)
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user_id is required"})
+		return
+	}
+	if !caller.CanAccessUser(userID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": model.ErrForbiddenUser})
+		return
+	}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+
+	replay, sub := h.hub.Subscribe(userID, lastEventID)
+	defer sub.Cancel()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", reconnectDelay.Milliseconds())
+	for _, event := range replay {
+		writeEvent(c.Writer, event)
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-h.closing:
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				// Dropped for falling behind; the client reconnects and resumes
+				return
+			}
+			writeEvent(c.Writer, event)
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
+
+// writeEvent writes an event in the text/event-stream format.
+// Event data is single-line JSON.
+func writeEvent(w gin.ResponseWriter, event eventhub.Event) {
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Data)
+}
@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strings"
 
 	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/httpcache"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
@@ -15,112 +17,99 @@ import (
 type ServiceProxy struct {
 	services map[string]*httputil.ReverseProxy
 	cfg      *config.Config
+	cache    *httpcache.Store
 }
 
-// NewServiceProxy creates a new service proxy
-func NewServiceProxy(cfg *config.Config) *ServiceProxy {
+// NewServiceProxy creates a new service proxy. Health checks of the upstream
+// instances run until the context is canceled. Routes with a cache TTL are
+// served from the response store.
+func NewServiceProxy(ctx context.Context, cfg *config.Config, cache *httpcache.Store) *ServiceProxy {
 	// Create reverse proxies for each service
-	inventory, err := url.Parse(cfg.Services.Inventory.BaseURL)
-	if err != nil {
-		logrus.Fatalf("Invalid inventory service URL: %v", err)
-	}
-
-	order, err := url.Parse(cfg.Services.Order.BaseURL)
-	if err != nil {
-		logrus.Fatalf("Invalid order service URL: %v", err)
-	}
-
-	proxies := map[string]*httputil.ReverseProxy{
-		"inventory": httputil.NewSingleHostReverseProxy(inventory),
-		"order":     httputil.NewSingleHostReverseProxy(order),
+	proxies := make(map[string]*httputil.ReverseProxy)
+	for _, name := range cfg.Services.Names() {
+		serviceCfg, _ := cfg.Services.Get(name)
+		proxy, err := newUpstreamProxy(ctx, name, serviceCfg)
+		if err != nil {
+			logrus.Fatalf("Failed to create %s service proxy: %v", name, err)
+		}
+		proxies[name] = proxy
 	}
 
 	return &ServiceProxy{
 		services: proxies,
 		cfg:      cfg,
+		cache:    cache,
 	}
 }
 
-// ProxyInventory proxies requests to the inventory service
-func (p *ServiceProxy) ProxyInventory() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		logrus.Infof("Proxying request to inventory service: %s", c.Request.URL.Path)
-		p.handleProxy(c, "inventory", p.cfg.Services.Inventory.BaseURL)
-	}
-}
+// Proxy returns a handler that forwards requests matched by the route to its upstream
+func (p *ServiceProxy) Proxy(route config.Route) gin.HandlerFunc {
+	timeout := route.GetTimeout()
+	cacheTTL := route.GetCacheTTL()
 
-// ProxyOrder proxies requests to the order service
-func (p *ServiceProxy) ProxyOrder() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		logrus.Infof("Proxying request to order service: %s", c.Request.URL.Path)
-		p.handleProxy(c, "order", p.cfg.Services.Order.BaseURL)
-	}
-}
+		logrus.WithContext(c.Request.Context()).Infof("Proxying request to %s service: %s", route.Upstream, c.Request.URL.Path)
 
-// handleProxy handles the actual proxying logic
-func (p *ServiceProxy) handleProxy(c *gin.Context, service, baseURL string) {
-	proxy := p.services[service]
+		// Rewrite the path when the upstream serves the resource elsewhere
+		path := c.Request.URL.Path
+		if route.Rewrite.Path != "" {
+			path = expandPath(route.Rewrite.Path, c.Params)
+		}
 
-	// Update the request URL path to match the target service
-	// We need to remove the service prefix from the path
-	path := c.Request.URL.Path
-	prefix := "/" + service
+		// The route timeout covers the whole exchange, including streamed bodies,
+		// and replaces the service timeout of each upstream attempt
+		if timeout > 0 {
+			ctx, cancel := context.WithTimeout(withAttemptTimeout(c.Request.Context(), timeout), timeout)
+			defer cancel()
+			c.Request = c.Request.WithContext(ctx)
+		}
 
-	if strings.HasPrefix(path, prefix) {
-		path = strings.TrimPrefix(path, prefix)
-		if path == "" {
-			path = "/"
+		if cacheTTL > 0 && p.cache != nil {
+			p.handleCached(c, route, path, cacheTTL)
+			return
 		}
+
+		p.handleProxy(c, c.Writer, route.Upstream, route.Rewrite.Method, path)
 	}
+}
+
+// handleProxy handles the actual proxying logic
+func (p *ServiceProxy) handleProxy(c *gin.Context, w http.ResponseWriter, service, method, path string) {
+	proxy := p.services[service]
 
-	// Clone the request since we're modifying the URL
+	// Clone the request since we're modifying the URL; the instance is chosen by the transport
 	outReq := new(http.Request)
 	*outReq = *c.Request
-	outReq.URL, _ = url.Parse(baseURL + path)
-	if c.Request.URL.RawQuery != "" {
-		outReq.URL.RawQuery = c.Request.URL.RawQuery
+	outReq.URL = &url.URL{
+		Path:     path,
+		RawQuery: c.Request.URL.RawQuery,
+	}
+	if method != "" {
+		outReq.Method = method
 	}
 
 	// Force the next handler to use our modified request
 	c.Request = outReq
 
 	// Let the reverse proxy do its job
-	proxy.ServeHTTP(c.Writer, c.Request)
+	proxy.ServeHTTP(w, c.Request)
 }
 
-// RegisterRoutes registers the proxy routes
-func RegisterProxyRoutes(router *gin.Engine, proxy *ServiceProxy) {
-	// Health check route
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{
-			"status":  "up",
-			"service": "api-gateway",
-		})
-	})
-
-	// Inventory service routes
-	inventoryGroup := router.Group("/inventory")
-	inventoryGroup.Any("/*path", proxy.ProxyInventory())
-
-	// Order service routes
-	orderGroup := router.Group("/order")
-	orderGroup.Any("/*path", proxy.ProxyOrder())
-
-	// Alternatively, we can also directly proxy requests to the root paths
-	router.GET("/products", proxy.ProxyInventory())
-	router.GET("/products/:id", proxy.ProxyInventory())
-	router.POST("/products", proxy.ProxyInventory())
-	router.PATCH("/products/:id", proxy.ProxyInventory())
-	router.DELETE("/products/:id", proxy.ProxyInventory())
-
-	router.GET("/categories", proxy.ProxyInventory())
-	router.GET("/categories/:id", proxy.ProxyInventory())
-	router.POST("/categories", proxy.ProxyInventory())
-	router.PATCH("/categories/:id", proxy.ProxyInventory())
-	router.DELETE("/categories/:id", proxy.ProxyInventory())
-
-	router.GET("/orders", proxy.ProxyOrder())
-	router.GET("/orders/:id", proxy.ProxyOrder())
-	router.POST("/orders", proxy.ProxyOrder())
-	router.PATCH("/orders/:id", proxy.ProxyOrder())
+// expandPath substitutes :param and *param segments of a path template with matched values
+func expandPath(template string, params gin.Params) string {
+	segments := strings.Split(template, "/")
+	for i, segment := range segments {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+
+		value := params.ByName(segment[1:])
+		if segment[0] == '*' {
+			// Catch-all values already start with a slash
+			value = strings.TrimPrefix(value, "/")
+		}
+		segments[i] = value
+	}
+
+	return strings.Join(segments, "/")
 }
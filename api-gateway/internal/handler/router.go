@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/baccala1010/e-commerce/api-gateway/api"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/middleware"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/health"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/metrics"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/token"
+	"github.com/fsnotify/fsnotify"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// Router serves requests through a Gin engine built from the route table.
+// Reloading builds a new engine and swaps it in atomically, so requests
+// already being served finish on the engine they started on.
+type Router struct {
+	cfg         *config.Config
+	proxy       *ServiceProxy
+	orderDetail *OrderDetailHandler
+	orderEvents *OrderEventsHandler
+	graphQL     *GraphQLHandler
+	apiKeys     *APIKeyHandler
+	health      *health.Checker
+	verifier    *token.Verifier
+	spec        *openapi3.T
+	specJSON    []byte
+	engine      atomic.Pointer[gin.Engine]
+	mu          sync.Mutex
+}
+
+// NewRouter creates a router from the route table referenced by the configuration.
+// Partner API keys are only accepted when an API key handler is given.
+func NewRouter(cfg *config.Config, proxy *ServiceProxy, orderDetail *OrderDetailHandler, orderEvents *OrderEventsHandler, graphQL *GraphQLHandler, apiKeys *APIKeyHandler, healthChecker *health.Checker) (*Router, error) {
+	r := &Router{
+		cfg:         cfg,
+		proxy:       proxy,
+		orderDetail: orderDetail,
+		orderEvents: orderEvents,
+		graphQL:     graphQL,
+		apiKeys:     apiKeys,
+		health:      healthChecker,
+	}
+
+	if cfg.Auth.JWTSecret != "" {
+		r.verifier = token.NewVerifier(cfg.Auth.JWTSecret, cfg.Auth.Issuer)
+	} else {
+		logrus.Warn("No auth.jwt_secret configured; bearer tokens are not accepted, only API keys")
+	}
+
+	spec, err := api.LoadSpec()
+	if err != nil {
+		return nil, err
+	}
+	r.spec = spec
+	if r.specJSON, err = spec.MarshalJSON(); err != nil {
+		return nil, fmt.Errorf("failed to encode OpenAPI document: %w", err)
+	}
+
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ServeHTTP dispatches the request to the current engine
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.engine.Load().ServeHTTP(w, req)
+}
+
+// Reload reads the route table again and swaps in a new engine.
+// On error the previous engine keeps serving.
+func (r *Router) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	path := r.cfg.Routes.GetFile()
+	table, err := config.LoadRouteTable(path)
+	if err != nil {
+		return err
+	}
+
+	routes, err := table.Resolve(r.cfg.Services)
+	if err != nil {
+		return fmt.Errorf("invalid route table: %w", err)
+	}
+
+	engine, err := r.buildEngine(routes)
+	if err != nil {
+		return err
+	}
+
+	r.engine.Store(engine)
+	if r.apiKeys != nil {
+		r.apiKeys.apiKeyUseCase.SetRouteGroups(routeGroups(routes))
+	}
+	logrus.Infof("Loaded %d routes from %s", len(routes), path)
+	return nil
+}
+
+// WatchRouteTable reloads the route table whenever its file changes
+func (r *Router) WatchRouteTable() {
+	v := viper.New()
+	v.SetConfigFile(r.cfg.Routes.GetFile())
+	v.SetConfigType("yaml")
+
+	if err := v.ReadInConfig(); err != nil {
+		logrus.Warnf("Not watching route table: %v", err)
+		return
+	}
+
+	v.OnConfigChange(func(e fsnotify.Event) {
+		logrus.Infof("Route table changed: %s", e.Name)
+		if err := r.Reload(); err != nil {
+			logrus.Errorf("Failed to reload route table, keeping previous routes: %v", err)
+		}
+	})
+	v.WatchConfig()
+}
+
+// Route groups of the endpoints served by the gateway itself
+const (
+	ordersGroup  = model.ScopeOrders
+	graphQLGroup = "graphql"
+)
+
+// withIdentity prepends API key authentication for the route group, when API
+// keys are enabled, and bearer token verification
+func (r *Router) withIdentity(group string, handlers ...gin.HandlerFunc) []gin.HandlerFunc {
+	identity := []gin.HandlerFunc{middleware.Identify(r.verifier)}
+	if r.apiKeys != nil {
+		identity = append([]gin.HandlerFunc{middleware.APIKey(r.apiKeys.apiKeyUseCase, group)}, identity...)
+	}
+	return append(identity, handlers...)
+}
+
+// routeGroups returns the groups API keys can be scoped to
+func routeGroups(routes []config.Route) []string {
+	groups := []string{ordersGroup, graphQLGroup}
+	for _, route := range routes {
+		if route.Group != "" {
+			groups = append(groups, route.Group)
+		}
+	}
+	return groups
+}
+
+// buildEngine creates a Gin engine serving the given routes
+func (r *Router) buildEngine(routes []config.Route) (engine *gin.Engine, err error) {
+	// Gin panics on conflicting routes; report them as an invalid table instead
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("invalid route table: %v", rec)
+		}
+	}()
+
+	engine = gin.New()
+	engine.Use(middleware.RequestID())
+	engine.Use(gin.Recovery())
+	engine.Use(middleware.Tracing(r.cfg.Server.Name))
+	engine.Use(middleware.Logger())
+	engine.Use(middleware.Metrics())
+	engine.Use(middleware.CORS())
+
+	// Liveness and readiness probes; /health reports readiness for existing checks
+	engine.GET("/health", gin.WrapH(r.health.ReadyHandler()))
+	engine.GET("/health/live", gin.WrapH(r.health.LiveHandler()))
+	engine.GET("/health/ready", gin.WrapH(r.health.ReadyHandler()))
+
+	// Prometheus metrics of the gateway
+	engine.GET("/metrics", gin.WrapH(metrics.Handler()))
+
+	// The OpenAPI document of the public API
+	engine.GET("/openapi.json", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", r.specJSON)
+	})
+
+	// Composition endpoints are served by the gateway itself, unprefixed
+	// and under every API version of the route table
+	versions := map[string]bool{"": false}
+	for _, route := range routes {
+		if route.Version != "" {
+			versions[route.Version] = route.Deprecated
+		}
+	}
+	// Requests are validated against the document after authentication, so
+	// unauthenticated callers learn nothing about the expected input
+	versionNames := make([]string, 0, len(versions))
+	for version := range versions {
+		if version != "" {
+			versionNames = append(versionNames, version)
+		}
+	}
+	validate := middleware.ValidateRequest(r.spec, versionNames)
+
+	for version, deprecated := range versions {
+		group := engine.Group("/")
+		if version != "" {
+			group = engine.Group("/"+version, middleware.APIVersion(version, deprecated))
+		}
+		group.GET("/orders/:id/detail", r.withIdentity(ordersGroup, middleware.RequireAuth(), validate, r.orderDetail.GetOrderDetail)...)
+		group.GET("/orders/events", r.withIdentity(ordersGroup, middleware.RequireAuth(), validate, r.orderEvents.Stream)...)
+	}
+
+	// GraphQL is versioned by its schema, so it is only served unprefixed.
+	// Authentication is optional; order fields check it themselves.
+	graphQL := r.withIdentity(graphQLGroup, validate, r.graphQL.Query)
+	engine.GET("/graphql", graphQL...)
+	engine.POST("/graphql", graphQL...)
+
+	// Admin endpoints are only served when an admin token is configured
+	if r.apiKeys != nil && r.cfg.Admin.Token != "" {
+		admin := engine.Group("/admin", middleware.RequireAdmin(r.cfg.Admin.Token), validate)
+		RegisterAPIKeyRoutes(admin, r.apiKeys)
+	}
+
+	for _, route := range routes {
+		handlers := make([]gin.HandlerFunc, 0, 6)
+		if route.Version != "" {
+			handlers = append(handlers, middleware.APIVersion(route.Version, route.Deprecated))
+		}
+		if route.Auth {
+			handlers = append(handlers, r.withIdentity(route.Group, middleware.RequireAuth())...)
+		} else {
+			handlers = append(handlers, r.withIdentity(route.Group)...)
+		}
+		handlers = append(handlers, validate, r.proxy.Proxy(route))
+
+		if route.Method == config.MethodAny {
+			engine.Any(route.Path, handlers...)
+		} else {
+			engine.Handle(route.Method, route.Path, handlers...)
+		}
+	}
+
+	return engine, nil
+}
@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/circuitbreaker"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/loadbalancer"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// ErrCircuitOpen is returned when the upstream's circuit breaker rejects a request
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// upstreamError is the JSON body returned when an upstream cannot serve a request
+type upstreamError struct {
+	Error    string `json:"error"`
+	Code     string `json:"code"`
+	Upstream string `json:"upstream"`
+}
+
+// newUpstreamProxy creates a reverse proxy for a service that balances requests over its
+// healthy instances with timeouts, retries and a circuit breaker
+func newUpstreamProxy(ctx context.Context, name string, cfg config.ServiceConfig) (*httputil.ReverseProxy, error) {
+	endpoints := cfg.GetEndpoints()
+	targets := make(map[string]*url.URL, len(endpoints))
+	addresses := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		target, err := url.Parse(endpoint.BaseURL)
+		if err != nil || target.Host == "" {
+			return nil, fmt.Errorf("invalid %s service URL %q", name, endpoint.BaseURL)
+		}
+		targets[endpoint.BaseURL] = target
+		addresses = append(addresses, endpoint.BaseURL)
+	}
+
+	balancer, err := loadbalancer.New(name, cfg.GetLoadBalancing(), addresses)
+	if err != nil {
+		return nil, err
+	}
+	balancer.StartHealthChecks(ctx, cfg.HealthCheck, httpProbe(cfg.HealthCheck.GetPath()))
+
+	transport := &upstreamTransport{
+		name:     name,
+		next:     otelhttp.NewTransport(http.DefaultTransport),
+		balancer: balancer,
+		targets:  targets,
+		breaker:  circuitbreaker.New(name, cfg.CircuitBreaker.GetFailureThreshold(), cfg.CircuitBreaker.GetOpenTimeout()),
+		timeout:  cfg.GetTimeout(),
+		retry:    cfg.Retry,
+	}
+
+	return &httputil.ReverseProxy{
+		// The transport picks the instance for each attempt
+		Director: func(req *http.Request) {
+			if _, ok := req.Header["User-Agent"]; !ok {
+				// Don't let the Go client add its own User-Agent
+				req.Header.Set("User-Agent", "")
+			}
+		},
+		Transport:    transport,
+		ErrorHandler: transport.handleError,
+	}, nil
+}
+
+// httpProbe returns a health probe requesting the given path on an instance
+func httpProbe(path string) loadbalancer.Probe {
+	return func(ctx context.Context, endpoint *loadbalancer.Endpoint) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(endpoint.Address, "/")+path, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		io.Copy(io.Discard, resp.Body)
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("health check returned %s", resp.Status)
+		}
+		return nil
+	}
+}
+
+// upstreamTransport sends requests to a healthy instance of an upstream service and
+// applies per-attempt timeouts, bounded retries for idempotent methods and a circuit breaker
+type upstreamTransport struct {
+	name     string
+	next     http.RoundTripper
+	balancer *loadbalancer.Balancer
+	targets  map[string]*url.URL
+	breaker  *circuitbreaker.Breaker
+	timeout  time.Duration
+	retry    config.RetryConfig
+}
+
+// RoundTrip sends the request upstream, retrying idempotent requests on failure
+func (t *upstreamTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempts := 1
+	if isIdempotent(req.Method) {
+		attempts = t.retry.GetMaxAttempts()
+	}
+
+	// Buffer the body so that it can be replayed on retry
+	var body []byte
+	if attempts > 1 && req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			if err := t.backoff(req.Context(), attempt-1); err != nil {
+				return nil, err
+			}
+		}
+
+		if !t.breaker.Allow() {
+			return nil, ErrCircuitOpen
+		}
+
+		endpoint, err := t.balancer.Pick()
+		if err != nil {
+			t.breaker.Release()
+			return nil, err
+		}
+
+		// Each attempt may go to a different instance
+		attemptReq := req.Clone(req.Context())
+		target := t.targets[endpoint.Address]
+		attemptReq.URL.Scheme = target.Scheme
+		attemptReq.URL.Host = target.Host
+		attemptReq.URL.Path = strings.TrimSuffix(target.Path, "/") + req.URL.Path
+		attemptReq.URL.RawPath = ""
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.roundTripOnce(attemptReq, endpoint)
+		switch {
+		case err != nil && req.Context().Err() != nil:
+			// The client went away or the route deadline passed; the upstream is not to blame
+			t.breaker.Release()
+			return nil, err
+		case err != nil:
+			t.breaker.Failure()
+			lastErr = err
+		case isRetryableStatus(resp.StatusCode):
+			t.breaker.Failure()
+			if attempt == attempts {
+				return resp, nil
+			}
+			resp.Body.Close()
+			lastErr = fmt.Errorf("upstream returned %s", resp.Status)
+		default:
+			t.breaker.Success()
+			return resp, nil
+		}
+
+		logrus.WithContext(req.Context()).Warnf("Attempt %d/%d to %s service at %s failed: %v", attempt, attempts, t.name, endpoint.Address, lastErr)
+	}
+
+	return nil, lastErr
+}
+
+// attemptTimeoutKey is the context key holding the timeout of the route for
+// each upstream attempt
+type attemptTimeoutKey struct{}
+
+// withAttemptTimeout makes every upstream attempt of the request wait up to d
+// for the response headers instead of the service timeout
+func withAttemptTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, attemptTimeoutKey{}, d)
+}
+
+// roundTripOnce sends a single attempt to an endpoint. The route timeout, or the
+// service timeout for routes without one, bounds sending the request and waiting
+// for the response headers; the response body is then streamed until the
+// request itself ends, so long downloads are only bounded by the route deadline.
+func (t *upstreamTransport) roundTripOnce(req *http.Request, endpoint *loadbalancer.Endpoint) (*http.Response, error) {
+	timeout := t.timeout
+	if d, ok := req.Context().Value(attemptTimeoutKey{}).(time.Duration); ok && d > 0 {
+		timeout = d
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	timer := time.AfterFunc(timeout, cancel)
+	done := func() {
+		cancel()
+		t.balancer.Done(endpoint)
+	}
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if !timer.Stop() {
+		// The headers did not arrive in time and the attempt has been canceled
+		if err == nil {
+			resp.Body.Close()
+		}
+		done()
+		return nil, fmt.Errorf("no response from %s within %s: %w", endpoint.Address, timeout, context.DeadlineExceeded)
+	}
+	if err != nil {
+		done()
+		return nil, err
+	}
+
+	// Keep the attempt open until the response body has been consumed
+	resp.Body = &closeNotifier{ReadCloser: resp.Body, onClose: done}
+	return resp, nil
+}
+
+// backoff waits before the given retry using exponential backoff with full jitter
+func (t *upstreamTransport) backoff(ctx context.Context, retry int) error {
+	delay := t.retry.GetBaseDelay() << (retry - 1)
+	if maxDelay := t.retry.GetMaxDelay(); delay <= 0 || delay > maxDelay {
+		delay = maxDelay
+	}
+	delay = rand.N(delay) + 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// handleError writes a structured JSON error when the upstream cannot serve the request
+func (t *upstreamTransport) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusBadGateway
+	body := upstreamError{
+		Error:    fmt.Sprintf("%s service is unavailable", t.name),
+		Code:     "upstream_error",
+		Upstream: t.name,
+	}
+
+	switch {
+	case errors.Is(err, ErrCircuitOpen):
+		status = http.StatusServiceUnavailable
+		body.Code = "circuit_open"
+		retryAfter := int(t.breaker.RetryAfter().Seconds()) + 1
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	case errors.Is(err, loadbalancer.ErrNoHealthyEndpoints):
+		status = http.StatusServiceUnavailable
+		body.Code = "no_healthy_upstream"
+	case errors.Is(err, context.DeadlineExceeded):
+		status = http.StatusGatewayTimeout
+		body.Error = fmt.Sprintf("%s service timed out", t.name)
+		body.Code = "upstream_timeout"
+	case errors.Is(err, context.Canceled):
+		// The client disconnected; nobody is left to read a response
+		logrus.WithContext(r.Context()).Debugf("Request to %s service canceled: %s", t.name, r.URL.Path)
+		return
+	}
+
+	logrus.WithContext(r.Context()).Errorf("Proxy error for %s service: %s %s: %v", t.name, r.Method, r.URL.Path, err)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// closeNotifier runs a callback once the response body is closed
+type closeNotifier struct {
+	io.ReadCloser
+	once    sync.Once
+	onClose func()
+}
+
+func (c *closeNotifier) Close() error {
+	err := c.ReadCloser.Close()
+	c.once.Do(c.onClose)
+	return err
+}
+
+// isIdempotent reports whether requests with the method can safely be retried
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableStatus reports whether the upstream status indicates a transient failure
+func isRetryableStatus(status int) bool {
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+}
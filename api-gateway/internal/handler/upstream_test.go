@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// serviceTimeout is the attempt timeout of the upstream service in the tests
+const serviceTimeout = 100 * time.Millisecond
+
+// newTestProxy returns a service proxy for one upstream service with a short
+// timeout and a single attempt per request
+func newTestProxy(t *testing.T, upstream http.Handler) *ServiceProxy {
+	t.Helper()
+
+	server := httptest.NewServer(upstream)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	proxy, err := newUpstreamProxy(ctx, "inventory", config.ServiceConfig{
+		BaseURL: server.URL,
+		Timeout: serviceTimeout.String(),
+		Retry:   config.RetryConfig{MaxAttempts: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &ServiceProxy{services: map[string]*httputil.ReverseProxy{"inventory": proxy}}
+}
+
+func TestProxyTimeouts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	upstream := http.NewServeMux()
+	upstream.HandleFunc("/health/ready", func(w http.ResponseWriter, r *http.Request) {})
+	// Answers once the work is done, like an import
+	upstream.HandleFunc("/slow-headers", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(3 * serviceTimeout)
+		io.WriteString(w, "done")
+	})
+	// Answers right away and streams the body, like an export
+	upstream.HandleFunc("/slow-body", func(w http.ResponseWriter, r *http.Request) {
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, "chunk %d\n", i)
+			w.(http.Flusher).Flush()
+			time.Sleep(serviceTimeout)
+		}
+	})
+	// Reads the whole request body first, like a media upload
+	upstream.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, "%d bytes", len(data))
+	})
+	proxy := newTestProxy(t, upstream)
+
+	tests := []struct {
+		name    string
+		path    string
+		upload  bool
+		timeout string
+		status  int
+		body    string
+	}{
+		{name: "headers after the service timeout", path: "/slow-headers", status: http.StatusGatewayTimeout},
+		{name: "headers within a longer route timeout", path: "/slow-headers", timeout: "2s", status: http.StatusOK, body: "done"},
+		{name: "headers after the route timeout", path: "/slow-headers", timeout: "50ms", status: http.StatusGatewayTimeout},
+		{name: "body streamed past the service timeout", path: "/slow-body", status: http.StatusOK, body: "chunk 0\nchunk 1\nchunk 2\n"},
+		{name: "body streamed within a longer route timeout", path: "/slow-body", timeout: "2s", status: http.StatusOK, body: "chunk 0\nchunk 1\nchunk 2\n"},
+		{name: "upload past the service timeout", path: "/upload", upload: true, status: http.StatusGatewayTimeout},
+		{name: "upload within a longer route timeout", path: "/upload", upload: true, timeout: "2s", status: http.StatusOK, body: "3072 bytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := http.MethodGet
+			var body io.Reader
+			if tt.upload {
+				method = http.MethodPost
+				body = slowBody(3, 1024, serviceTimeout)
+			}
+
+			route := config.Route{Method: method, Path: tt.path, Upstream: "inventory", Timeout: tt.timeout}
+			engine := gin.New()
+			engine.Handle(method, tt.path, proxy.Proxy(route))
+
+			server := httptest.NewServer(engine)
+			defer server.Close()
+
+			req, err := http.NewRequest(method, server.URL+tt.path, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			got, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("reading the body failed after %q: %v", got, err)
+			}
+
+			if resp.StatusCode != tt.status {
+				t.Fatalf("status = %d, want %d (body %q)", resp.StatusCode, tt.status, got)
+			}
+			if tt.body != "" && string(got) != tt.body {
+				t.Errorf("body = %q, want %q", got, tt.body)
+			}
+			if tt.status == http.StatusGatewayTimeout && !strings.Contains(string(got), "upstream_timeout") {
+				t.Errorf("body = %q, want an upstream_timeout error", got)
+			}
+		})
+	}
+}
+
+// slowBody returns a request body of the given number of chunks, written with a
+// delay before each chunk
+func slowBody(chunks, size int, delay time.Duration) io.Reader {
+	r, w := io.Pipe()
+	go func() {
+		for i := 0; i < chunks; i++ {
+			time.Sleep(delay)
+			if _, err := w.Write(make([]byte, size)); err != nil {
+				return
+			}
+		}
+		w.Close()
+	}()
+	return r
+}
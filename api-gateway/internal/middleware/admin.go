@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAdmin middleware only lets requests bearing the admin token through
+func RequireAdmin(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		presented := strings.TrimPrefix(header, "Bearer ")
+
+		if !strings.HasPrefix(header, "Bearer ") || subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "admin authorization required"})
+			return
+		}
+
+		c.Next()
+	}
+}
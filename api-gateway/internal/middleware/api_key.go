@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// APIKeyHeader carries partner API keys
+	APIKeyHeader = "X-API-Key"
+	// APIKeyIDHeader tells the upstream which API key made the request
+	APIKeyIDHeader = "X-API-Key-ID"
+	// APIKeyIDKey is the context key holding the ID of an authenticated API key
+	APIKeyIDKey = "api_key_id"
+	// APIKeyScopesKey is the context key holding the scopes of an authenticated API key
+	APIKeyScopesKey = "api_key_scopes"
+)
+
+// APIKeyAuthenticator validates partner API keys
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, secret, group string) (*model.APIKeyGrant, error)
+}
+
+// APIKey middleware authenticates requests carrying a partner API key against
+// the route group and the key's monthly quota. Requests without a key pass
+// through unchanged. The key itself is not forwarded upstream.
+func APIKey(auth APIKeyAuthenticator, group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Only the gateway may tell upstreams which key was used
+		c.Request.Header.Del(APIKeyIDHeader)
+
+		secret := c.GetHeader(APIKeyHeader)
+		if secret == "" {
+			c.Next()
+			return
+		}
+
+		grant, err := auth.Authenticate(c.Request.Context(), secret, group)
+		if grant != nil {
+			setQuotaHeaders(c, grant)
+		}
+		if err != nil {
+			switch err.Error() {
+			case model.ErrInvalidAPIKey, model.ErrAPIKeyRevoked:
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			case model.ErrAPIKeyScopeDenied:
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			case model.ErrAPIKeyQuotaExceeded:
+				c.Header("Retry-After", strconv.Itoa(int(time.Until(grant.ResetsAt).Seconds())+1))
+				c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			default:
+				logrus.WithContext(c.Request.Context()).Errorf("Failed to authenticate API key: %v", err)
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to authenticate API key"})
+			}
+			return
+		}
+
+		c.Set(APIKeyIDKey, grant.KeyID.String())
+		c.Set(APIKeyScopesKey, grant.Scopes)
+		c.Request.Header.Del(APIKeyHeader)
+		c.Request.Header.Set(APIKeyIDHeader, grant.KeyID.String())
+
+		c.Next()
+	}
+}
+
+// setQuotaHeaders reports the key's monthly quota to the caller
+func setQuotaHeaders(c *gin.Context, grant *model.APIKeyGrant) {
+	if grant.Quota == 0 {
+		return
+	}
+
+	c.Header("X-Quota-Limit", strconv.FormatInt(grant.Quota, 10))
+	c.Header("X-Quota-Remaining", strconv.FormatInt(max(grant.Quota-grant.Used, 0), 10))
+	c.Header("X-Quota-Reset", strconv.FormatInt(grant.ResetsAt.Unix(), 10))
+}
@@ -24,23 +24,21 @@ func Logger() gin.HandlerFunc {
 		status := c.Writer.Status()
 
 		// Set log level based on status code
-		var logFunc func(args ...interface{})
+		level := logrus.InfoLevel
 		if status >= 500 {
-			logFunc = logrus.Error
+			level = logrus.ErrorLevel
 		} else if status >= 400 {
-			logFunc = logrus.Warn
-		} else {
-			logFunc = logrus.Info
+			level = logrus.WarnLevel
 		}
 
-		// Log request details
-		logFunc(map[string]interface{}{
+		// Log request details along with the request ID and trace ID of the request context
+		logrus.WithContext(c.Request.Context()).WithFields(logrus.Fields{
 			"status":     status,
 			"method":     c.Request.Method,
 			"path":       requestPath,
 			"ip":         c.ClientIP(),
 			"latency":    latency,
 			"user-agent": c.Request.UserAgent(),
-		})
+		}).Log(level, "Request processed")
 	}
 }
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestID middleware for tagging each request with the request ID sent by
+// the client, or a new one. The ID is returned to the client and forwarded to
+// the upstream services, so that their log lines can be correlated. A user ID
+// sent by the client is dropped.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(logging.RequestIDHeader)
+		if !logging.ValidID(requestID) {
+			requestID = logging.NewRequestID()
+		}
+		c.Request.Header.Set(logging.RequestIDHeader, requestID)
+		c.Header(logging.RequestIDHeader, requestID)
+
+		// The user is only forwarded once Identify has verified it
+		c.Request.Header.Del(logging.UserIDHeader)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), requestID))
+
+		c.Next()
+	}
+}
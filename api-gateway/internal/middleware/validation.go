@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// validationOptions leave authentication to the auth middlewares and forward
+// requests unchanged instead of filling in defaults
+var validationOptions = &openapi3filter.Options{
+	MultiError:          true,
+	SkipSettingDefaults: true,
+	AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+}
+
+// Catalog imports are passed through as they are, so the inventory service can
+// report malformed rows one by one instead of the whole file being rejected here
+func init() {
+	openapi3filter.RegisterBodyDecoder("text/csv", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/x-ndjson", openapi3filter.FileBodyDecoder)
+}
+
+// ValidateRequest middleware checks requests against the operation the OpenAPI
+// document defines for the matched route and rejects invalid ones with 400 and
+// the offending fields. Paths are looked up without their API version prefix;
+// routes the document doesn't describe pass through.
+func ValidateRequest(spec *openapi3.T, versions []string) gin.HandlerFunc {
+	var operations sync.Map
+
+	return func(c *gin.Context) {
+		key := c.Request.Method + " " + c.FullPath()
+		route, ok := operations.Load(key)
+		if !ok {
+			route, _ = operations.LoadOrStore(key, findOperation(spec, versions, c.Request.Method, c.FullPath()))
+		}
+		if route.(*routers.Route) == nil {
+			c.Next()
+			return
+		}
+
+		pathParams := make(map[string]string, len(c.Params))
+		for _, param := range c.Params {
+			pathParams[param.Key] = param.Value
+		}
+
+		err := openapi3filter.ValidateRequest(c.Request.Context(), &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route.(*routers.Route),
+			Options:    validationOptions,
+		})
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error":  model.ErrRequestValidation,
+				"fields": fieldErrors(err, "", ""),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// findOperation returns the documented operation of a Gin route, or nil
+func findOperation(spec *openapi3.T, versions []string, method, fullPath string) *routers.Route {
+	for _, version := range versions {
+		if rest, ok := strings.CutPrefix(fullPath, "/"+version+"/"); ok {
+			fullPath = "/" + rest
+			break
+		}
+	}
+
+	// Gin's :param segments are {param} in OpenAPI
+	segments := strings.Split(fullPath, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	path := strings.Join(segments, "/")
+
+	pathItem := spec.Paths.Value(path)
+	if pathItem == nil {
+		return nil
+	}
+	operation := pathItem.GetOperation(method)
+	if operation == nil {
+		return nil
+	}
+
+	return &routers.Route{
+		Spec:      spec,
+		Path:      path,
+		PathItem:  pathItem,
+		Method:    method,
+		Operation: operation,
+	}
+}
+
+// fieldErrors flattens a validation error into the invalid fields
+func fieldErrors(err error, in, field string) []model.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var fields []model.FieldError
+		for _, inner := range e {
+			fields = append(fields, fieldErrors(inner, in, field)...)
+		}
+		return fields
+
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			in, field = e.Parameter.In, e.Parameter.Name
+		case e.RequestBody != nil:
+			in = model.FieldInBody
+		}
+		if e.Err == nil {
+			return []model.FieldError{{Field: field, In: in, Message: e.Reason}}
+		}
+		return fieldErrors(e.Err, in, field)
+
+	case *openapi3.SchemaError:
+		if in == model.FieldInBody {
+			field = strings.Join(e.JSONPointer(), ".")
+		}
+		message := e.Reason
+		if e.SchemaField == "format" {
+			// The reason spells out the format's pattern, which is noise to callers
+			message = "must be a valid " + e.Schema.Format
+		}
+		return []model.FieldError{{Field: field, In: in, Message: message}}
+	}
+
+	return []model.FieldError{{Field: field, In: in, Message: err.Error()}}
+}
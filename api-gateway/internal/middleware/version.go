@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// APIVersion middleware tags responses with the public API version that served them
+func APIVersion(version string, deprecated bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("X-API-Version", version)
+		if deprecated {
+			c.Header("Deprecation", "true")
+		}
+
+		c.Next()
+	}
+}
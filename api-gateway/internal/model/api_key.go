@@ -0,0 +1,93 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ScopeAll grants an API key access to every route group
+const ScopeAll = "*"
+
+// ScopeOrders grants an API key access to the orders of every user, wherever
+// they are served
+const ScopeOrders = "orders"
+
+// APIKey is a partner credential. Only a hash of the secret is stored;
+// the secret itself is returned once, when the key is created or rotated.
+type APIKey struct {
+	ID           uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	Name         string     `json:"name" gorm:"type:varchar(255);not null"`
+	Prefix       string     `json:"prefix" gorm:"type:varchar(16);not null"`
+	KeyHash      string     `json:"-" gorm:"type:char(64);not null;uniqueIndex"`
+	Scopes       []string   `json:"scopes" gorm:"serializer:json;not null"`
+	MonthlyQuota int64      `json:"monthly_quota" gorm:"not null;default:0"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	RotatedAt    *time.Time `json:"rotated_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"not null;default:now()"`
+	UpdatedAt    time.Time  `json:"updated_at" gorm:"not null;default:now()"`
+}
+
+func (k *APIKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == uuid.Nil {
+		k.ID = uuid.New()
+	}
+	return nil
+}
+
+// Revoked reports whether the key has been revoked
+func (k *APIKey) Revoked() bool {
+	return k.RevokedAt != nil
+}
+
+// Allows reports whether the key may call routes of the group
+func (k *APIKey) Allows(group string) bool {
+	for _, scope := range k.Scopes {
+		if scope == ScopeAll || (group != "" && scope == group) {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyUsage counts the requests made with a key in a calendar month (UTC)
+type APIKeyUsage struct {
+	KeyID     uuid.UUID `json:"-" gorm:"type:uuid;primaryKey"`
+	Period    string    `json:"period" gorm:"type:char(7);primaryKey"`
+	Requests  int64     `json:"requests" gorm:"not null;default:0"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null;default:now()"`
+}
+
+// CreateAPIKeyRequest represents the request body for creating an API key
+type CreateAPIKeyRequest struct {
+	Name         string   `json:"name" binding:"required"`
+	Scopes       []string `json:"scopes" binding:"required,min=1,dive,required"`
+	MonthlyQuota int64    `json:"monthly_quota" binding:"min=0"`
+}
+
+// APIKeyWithSecret is returned when a key is created or rotated
+type APIKeyWithSecret struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+// APIKeyGrant is the result of authenticating a request with an API key.
+// A zero quota means the key is unlimited.
+type APIKeyGrant struct {
+	KeyID    uuid.UUID
+	Scopes   []string
+	Quota    int64
+	Used     int64
+	ResetsAt time.Time
+}
+
+// APIKeyUsageReport lists the monthly usage of a key, most recent first
+type APIKeyUsageReport struct {
+	KeyID        uuid.UUID     `json:"key_id"`
+	MonthlyQuota int64         `json:"monthly_quota"`
+	Period       string        `json:"period"`
+	Requests     int64         `json:"requests"`
+	Remaining    *int64        `json:"remaining"`
+	History      []APIKeyUsage `json:"history"`
+}
@@ -0,0 +1,40 @@
+package model
+
+// Caller is the verified identity of a request: the user of a bearer token,
+// a partner API key, or both
+type Caller struct {
+	UserID       string
+	APIKeyID     string
+	APIKeyScopes []string
+}
+
+// Authenticated reports whether the request was made with verified credentials
+func (c Caller) Authenticated() bool {
+	return c.UserID != "" || c.APIKeyID != ""
+}
+
+// Allows reports whether the caller may use the route group. Only API keys are
+// scoped; a request made with one is limited to its scopes.
+func (c Caller) Allows(group string) bool {
+	if c.APIKeyID == "" {
+		return true
+	}
+	for _, scope := range c.APIKeyScopes {
+		if scope == ScopeAll || scope == group {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAccessUser reports whether the caller may see the orders of the user.
+// Users only see their own; partner keys scoped to orders act for any user.
+func (c Caller) CanAccessUser(userID string) bool {
+	if !c.Allows(ScopeOrders) {
+		return false
+	}
+	if c.UserID != "" {
+		return c.UserID == userID
+	}
+	return c.APIKeyID != ""
+}
@@ -0,0 +1,30 @@
+package model
+
+import "testing"
+
+func TestCallerCanAccessUser(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller Caller
+		userID string
+		want   bool
+	}{
+		{name: "anonymous", caller: Caller{}, userID: "user-1", want: false},
+		{name: "own orders", caller: Caller{UserID: "user-1"}, userID: "user-1", want: true},
+		{name: "orders of another user", caller: Caller{UserID: "user-1"}, userID: "user-2", want: false},
+		{name: "key scoped to orders", caller: Caller{APIKeyID: "key", APIKeyScopes: []string{ScopeOrders}}, userID: "user-2", want: true},
+		{name: "key scoped to everything", caller: Caller{APIKeyID: "key", APIKeyScopes: []string{ScopeAll}}, userID: "user-2", want: true},
+		{name: "key scoped to graphql only", caller: Caller{APIKeyID: "key", APIKeyScopes: []string{"graphql"}}, userID: "user-2", want: false},
+		{name: "key without scopes", caller: Caller{APIKeyID: "key"}, userID: "user-2", want: false},
+		{name: "user with a key scoped to graphql only", caller: Caller{UserID: "user-1", APIKeyID: "key", APIKeyScopes: []string{"graphql"}}, userID: "user-1", want: false},
+		{name: "user with a key scoped to orders", caller: Caller{UserID: "user-1", APIKeyID: "key", APIKeyScopes: []string{"graphql", ScopeOrders}}, userID: "user-2", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.caller.CanAccessUser(tt.userID); got != tt.want {
+				t.Errorf("CanAccessUser(%q) = %v, want %v", tt.userID, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package model
+
+// Error constants for the API gateway
+const (
+	ErrOrderNotFound  = "order not found"
+	ErrInvalidOrderID = "invalid order ID"
+
+	ErrAuthorizationRequired = "authorization required"
+	ErrInvalidToken          = "invalid bearer token"
+	ErrUserIDRequired        = "user ID required"
+	ErrForbiddenUser         = "not allowed to access the orders of another user"
+
+	ErrAPIKeyNotFound      = "API key not found"
+	ErrAPIKeyRevoked       = "API key is revoked"
+	ErrInvalidAPIKey       = "invalid API key"
+	ErrInvalidAPIKeyScope  = "unknown API key scope"
+	ErrAPIKeyScopeDenied   = "API key is not allowed to access this route"
+	ErrOrdersScopeDenied   = "API key is not allowed to access orders"
+	ErrAPIKeyQuotaExceeded = "API key monthly quota exceeded"
+
+	ErrRequestValidation = "request validation failed"
+)
@@ -0,0 +1,39 @@
+package model
+
+import "encoding/json"
+
+// Parts of the order detail document
+const (
+	PartOrder     = "order"
+	PartReviews   = "reviews"
+	PartProducts  = "products"
+	PartDiscounts = "discounts"
+)
+
+// OrderDetail is the order page composed from the order and inventory services.
+// Parts that could not be fetched are left empty and reported in Errors.
+type OrderDetail struct {
+	Order   json.RawMessage      `json:"order"`
+	Payment json.RawMessage      `json:"payment"`
+	Items   []OrderDetailItem    `json:"items"`
+	Reviews json.RawMessage      `json:"reviews"`
+	Errors  map[string]PartError `json:"errors,omitempty"`
+}
+
+// OrderDetailItem is an order line with the current product details and discounts.
+// VariantID identifies the ordered variant among the variants of the product.
+type OrderDetailItem struct {
+	ProductID string          `json:"product_id"`
+	VariantID string          `json:"variant_id,omitempty"`
+	Quantity  int32           `json:"quantity"`
+	UnitPrice float64         `json:"unit_price"`
+	Product   json.RawMessage `json:"product"`
+	Discounts json.RawMessage `json:"discounts"`
+	Error     *PartError      `json:"error,omitempty"`
+}
+
+// PartError describes why a part of a composed document is missing
+type PartError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,17 @@
+package model
+
+// Locations of an invalid request field
+const (
+	FieldInPath   = "path"
+	FieldInQuery  = "query"
+	FieldInHeader = "header"
+	FieldInBody   = "body"
+)
+
+// FieldError describes why a field of a request is invalid. Body fields are
+// named by their dotted path, e.g. "items.0.quantity".
+type FieldError struct {
+	Field   string `json:"field"`
+	In      string `json:"in"`
+	Message string `json:"message"`
+}
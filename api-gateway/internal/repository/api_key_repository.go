@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *model.APIKey) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.APIKey, error)
+	FindByHash(ctx context.Context, hash string) (*model.APIKey, error)
+	FindAll(ctx context.Context) ([]model.APIKey, error)
+	Update(ctx context.Context, key *model.APIKey) error
+	IncrementUsage(ctx context.Context, keyID uuid.UUID, period string, quota int64) (int64, bool, error)
+	FindUsage(ctx context.Context, keyID uuid.UUID, limit int) ([]model.APIKeyUsage, error)
+}
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *model.APIKey) error {
+	return r.db.WithContext(ctx).Create(key).Error
+}
+
+func (r *apiKeyRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.APIKey, error) {
+	var key model.APIKey
+
+	if err := r.db.WithContext(ctx).First(&key, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (r *apiKeyRepository) FindByHash(ctx context.Context, hash string) (*model.APIKey, error) {
+	var key model.APIKey
+
+	if err := r.db.WithContext(ctx).First(&key, "key_hash = ?", hash).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (r *apiKeyRepository) FindAll(ctx context.Context) ([]model.APIKey, error) {
+	var keys []model.APIKey
+
+	if err := r.db.WithContext(ctx).Order("created_at").Find(&keys).Error; err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (r *apiKeyRepository) Update(ctx context.Context, key *model.APIKey) error {
+	return r.db.WithContext(ctx).Save(key).Error
+}
+
+// IncrementUsage counts a request against the key's usage in the period and returns
+// the new count. When the quota is already used up nothing is counted and ok is false;
+// a zero quota is unlimited.
+func (r *apiKeyRepository) IncrementUsage(ctx context.Context, keyID uuid.UUID, period string, quota int64) (int64, bool, error) {
+	var requests int64
+
+	result := r.db.WithContext(ctx).Raw(`
+		INSERT INTO api_key_usages (key_id, period, requests, updated_at)
+		VALUES (?, ?, 1, now())
+		ON CONFLICT (key_id, period) DO UPDATE
+		SET requests = api_key_usages.requests + 1, updated_at = now()
+		WHERE ? = 0 OR api_key_usages.requests < ?
+		RETURNING requests`,
+		keyID, period, quota, quota,
+	).Scan(&requests)
+	if result.Error != nil {
+		return 0, false, result.Error
+	}
+
+	return requests, result.RowsAffected > 0, nil
+}
+
+// FindUsage returns the most recent monthly usage of a key
+func (r *apiKeyRepository) FindUsage(ctx context.Context, keyID uuid.UUID, limit int) ([]model.APIKeyUsage, error) {
+	var usage []model.APIKeyUsage
+
+	if err := r.db.WithContext(ctx).Where("key_id = ?", keyID).Order("period DESC").Limit(limit).Find(&usage).Error; err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// apiKeyPrefix marks partner keys so they are recognizable in logs and configs
+	apiKeyPrefix = "pk_"
+	// apiKeyVisiblePrefix is how much of a key is kept to identify it
+	apiKeyVisiblePrefix = 10
+	// usagePeriodLayout formats the calendar month usage is counted in
+	usagePeriodLayout = "2006-01"
+)
+
+type apiKeyUseCase struct {
+	apiKeyRepo  repository.APIKeyRepository
+	routeGroups atomic.Pointer[map[string]bool]
+}
+
+// NewAPIKeyUseCase creates a new API key use case
+func NewAPIKeyUseCase(apiKeyRepo repository.APIKeyRepository) APIKeyUseCase {
+	u := &apiKeyUseCase{apiKeyRepo: apiKeyRepo}
+	u.SetRouteGroups(nil)
+	return u
+}
+
+func (u *apiKeyUseCase) SetRouteGroups(groups []string) {
+	known := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		known[group] = true
+	}
+	u.routeGroups.Store(&known)
+}
+
+func (u *apiKeyUseCase) CreateAPIKey(ctx context.Context, request model.CreateAPIKeyRequest) (*model.APIKeyWithSecret, error) {
+	known := *u.routeGroups.Load()
+	for _, scope := range request.Scopes {
+		if scope != model.ScopeAll && !known[scope] {
+			return nil, fmt.Errorf("%s: %s", model.ErrInvalidAPIKeyScope, scope)
+		}
+	}
+
+	secret, err := generateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &model.APIKey{
+		Name:         request.Name,
+		Prefix:       secret[:apiKeyVisiblePrefix],
+		KeyHash:      hashAPIKey(secret),
+		Scopes:       request.Scopes,
+		MonthlyQuota: request.MonthlyQuota,
+	}
+
+	if err := u.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, fmt.Errorf("error creating API key: %w", err)
+	}
+
+	return &model.APIKeyWithSecret{APIKey: *key, Key: secret}, nil
+}
+
+func (u *apiKeyUseCase) GetAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKey, error) {
+	key, err := u.apiKeyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error finding API key: %w", err)
+	}
+
+	if key == nil {
+		return nil, errors.New(model.ErrAPIKeyNotFound)
+	}
+
+	return key, nil
+}
+
+func (u *apiKeyUseCase) ListAPIKeys(ctx context.Context) ([]model.APIKey, error) {
+	keys, err := u.apiKeyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing API keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (u *apiKeyUseCase) RevokeAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKey, error) {
+	key, err := u.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Revoked() {
+		return key, nil
+	}
+
+	now := time.Now()
+	key.RevokedAt = &now
+	if err := u.apiKeyRepo.Update(ctx, key); err != nil {
+		return nil, fmt.Errorf("error revoking API key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (u *apiKeyUseCase) RotateAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKeyWithSecret, error) {
+	key, err := u.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Revoked() {
+		return nil, errors.New(model.ErrAPIKeyRevoked)
+	}
+
+	secret, err := generateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// The previous secret stops working as soon as the new hash is stored
+	now := time.Now()
+	key.Prefix = secret[:apiKeyVisiblePrefix]
+	key.KeyHash = hashAPIKey(secret)
+	key.RotatedAt = &now
+	if err := u.apiKeyRepo.Update(ctx, key); err != nil {
+		return nil, fmt.Errorf("error rotating API key: %w", err)
+	}
+
+	return &model.APIKeyWithSecret{APIKey: *key, Key: secret}, nil
+}
+
+func (u *apiKeyUseCase) GetAPIKeyUsage(ctx context.Context, id uuid.UUID, months int) (*model.APIKeyUsageReport, error) {
+	key, err := u.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := u.apiKeyRepo.FindUsage(ctx, id, months)
+	if err != nil {
+		return nil, fmt.Errorf("error finding API key usage: %w", err)
+	}
+
+	report := &model.APIKeyUsageReport{
+		KeyID:        key.ID,
+		MonthlyQuota: key.MonthlyQuota,
+		Period:       time.Now().UTC().Format(usagePeriodLayout),
+		History:      history,
+	}
+	for _, usage := range history {
+		if usage.Period == report.Period {
+			report.Requests = usage.Requests
+		}
+	}
+	if key.MonthlyQuota > 0 {
+		remaining := max(key.MonthlyQuota-report.Requests, 0)
+		report.Remaining = &remaining
+	}
+
+	return report, nil
+}
+
+// Authenticate checks a presented key against the route group and counts the
+// request towards the key's monthly quota. When the quota is exhausted the
+// grant is returned alongside the error so callers can report the limit.
+func (u *apiKeyUseCase) Authenticate(ctx context.Context, secret, group string) (*model.APIKeyGrant, error) {
+	key, err := u.apiKeyRepo.FindByHash(ctx, hashAPIKey(secret))
+	if err != nil {
+		return nil, fmt.Errorf("error finding API key: %w", err)
+	}
+
+	if key == nil {
+		return nil, errors.New(model.ErrInvalidAPIKey)
+	}
+
+	if key.Revoked() {
+		return nil, errors.New(model.ErrAPIKeyRevoked)
+	}
+
+	if !key.Allows(group) {
+		return nil, errors.New(model.ErrAPIKeyScopeDenied)
+	}
+
+	now := time.Now().UTC()
+	grant := &model.APIKeyGrant{
+		KeyID:    key.ID,
+		Scopes:   key.Scopes,
+		Quota:    key.MonthlyQuota,
+		ResetsAt: time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	used, ok, err := u.apiKeyRepo.IncrementUsage(ctx, key.ID, now.Format(usagePeriodLayout), key.MonthlyQuota)
+	if err != nil {
+		return nil, fmt.Errorf("error counting API key usage: %w", err)
+	}
+
+	if !ok {
+		grant.Used = key.MonthlyQuota
+		return grant, errors.New(model.ErrAPIKeyQuotaExceeded)
+	}
+
+	grant.Used = used
+	return grant, nil
+}
+
+// generateAPIKey returns a new random partner key
+func generateAPIKey() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("error generating API key: %w", err)
+	}
+	return apiKeyPrefix + hex.EncodeToString(buf), nil
+}
+
+// hashAPIKey returns the stored form of a key
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
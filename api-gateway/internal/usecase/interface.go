@@ -1 +1,25 @@
 package usecase
+
+import (
+	"context"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	"github.com/google/uuid"
+)
+
+// OrderDetailUseCase composes the order page from the order and inventory services
+type OrderDetailUseCase interface {
+	GetOrderDetail(ctx context.Context, orderID string, caller model.Caller) (*model.OrderDetail, error)
+}
+
+// APIKeyUseCase manages partner API keys and authenticates requests made with them
+type APIKeyUseCase interface {
+	CreateAPIKey(ctx context.Context, request model.CreateAPIKeyRequest) (*model.APIKeyWithSecret, error)
+	GetAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKey, error)
+	RotateAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKeyWithSecret, error)
+	GetAPIKeyUsage(ctx context.Context, id uuid.UUID, months int) (*model.APIKeyUsageReport, error)
+	Authenticate(ctx context.Context, secret, group string) (*model.APIKeyGrant, error)
+	SetRouteGroups(groups []string)
+}
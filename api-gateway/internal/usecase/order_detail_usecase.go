@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/adapter/grpc/client/inventory"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/adapter/grpc/client/order"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/internal/model"
+	inventorypb "github.com/baccala1010/e-commerce/inventory/pkg/pb"
+	orderpb "github.com/baccala1010/e-commerce/order/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// marshalOptions keeps the field names of the services' own JSON APIs
+var marshalOptions = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+type orderDetailUseCase struct {
+	orderClient      *order.Client
+	inventoryClient  *inventory.Client
+	orderTimeout     time.Duration
+	inventoryTimeout time.Duration
+}
+
+// NewOrderDetailUseCase creates a new order detail use case
+func NewOrderDetailUseCase(orderClient *order.Client, inventoryClient *inventory.Client, cfg *config.Config) OrderDetailUseCase {
+	return &orderDetailUseCase{
+		orderClient:      orderClient,
+		inventoryClient:  inventoryClient,
+		orderTimeout:     cfg.Services.Order.GetTimeout(),
+		inventoryTimeout: cfg.Services.Inventory.GetTimeout(),
+	}
+}
+
+// orderDetailBuilder collects the parts of an order detail fetched concurrently
+type orderDetailBuilder struct {
+	mu     sync.Mutex
+	detail model.OrderDetail
+}
+
+func (b *orderDetailBuilder) fail(part string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.detail.Errors == nil {
+		b.detail.Errors = make(map[string]model.PartError)
+	}
+	b.detail.Errors[part] = newPartError(err)
+}
+
+// GetOrderDetail composes the order page. Orders of other users than the caller
+// are reported as not found, so their existence is not revealed. Nothing else is
+// fetched before the order is known to belong to the caller.
+func (u *orderDetailUseCase) GetOrderDetail(ctx context.Context, orderID string, caller model.Caller) (*model.OrderDetail, error) {
+	b := &orderDetailBuilder{
+		detail: model.OrderDetail{Items: []model.OrderDetailItem{}},
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, u.orderTimeout)
+	resp, err := u.orderClient.GetOrderByID(callCtx, &orderpb.GetOrderRequest{Id: orderID})
+	cancel()
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, errors.New(model.ErrOrderNotFound)
+		case codes.InvalidArgument:
+			return nil, errors.New(model.ErrInvalidOrderID)
+		}
+
+		logrus.WithContext(ctx).Errorf("Failed to get order %s: %v", orderID, err)
+		b.fail(model.PartOrder, err)
+		return &b.detail, nil
+	}
+
+	orderMsg := resp.GetOrder()
+	if !caller.CanAccessUser(orderMsg.GetUserId()) {
+		return nil, errors.New(model.ErrOrderNotFound)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		u.fetchReviews(ctx, b, orderID)
+	}()
+
+	items := orderMsg.GetItems()
+
+	// The payment and items are reported as parts of their own
+	payment := orderMsg.GetPayment()
+	orderMsg = proto.Clone(orderMsg).(*orderpb.Order)
+	orderMsg.Payment = nil
+	orderMsg.Items = nil
+
+	b.detail.Order, _ = marshalOptions.Marshal(orderMsg)
+	if payment != nil {
+		b.detail.Payment, _ = marshalOptions.Marshal(payment)
+	}
+
+	b.detail.Items = make([]model.OrderDetailItem, len(items))
+	productIDs := make([]string, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		b.detail.Items[i] = model.OrderDetailItem{
+			ProductID: item.GetProductId(),
+			VariantID: item.GetVariantId(),
+			Quantity:  item.GetQuantity(),
+			UnitPrice: item.GetUnitPrice(),
+		}
+		if !seen[item.GetProductId()] {
+			seen[item.GetProductId()] = true
+			productIDs = append(productIDs, item.GetProductId())
+		}
+	}
+
+	if len(productIDs) > 0 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			u.fetchProducts(ctx, b, productIDs)
+		}()
+		go func() {
+			defer wg.Done()
+			u.fetchDiscounts(ctx, b, productIDs)
+		}()
+	}
+
+	wg.Wait()
+	return &b.detail, nil
+}
+
+// fetchReviews adds the reviews of the order to the detail
+func (u *orderDetailUseCase) fetchReviews(ctx context.Context, b *orderDetailBuilder, orderID string) {
+	ctx, cancel := context.WithTimeout(ctx, u.orderTimeout)
+	defer cancel()
+
+	resp, err := u.orderClient.GetOrderReviews(ctx, &orderpb.GetOrderReviewsRequest{OrderId: orderID})
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to get reviews of order %s: %v", orderID, err)
+		b.fail(model.PartReviews, err)
+		return
+	}
+
+	reviews, err := marshalList(resp.GetReviews())
+	if err != nil {
+		b.fail(model.PartReviews, err)
+		return
+	}
+
+	b.mu.Lock()
+	b.detail.Reviews = reviews
+	b.mu.Unlock()
+}
+
+// fetchProducts looks up the products of the order concurrently and adds them to the items
+func (u *orderDetailUseCase) fetchProducts(ctx context.Context, b *orderDetailBuilder, productIDs []string) {
+	ctx, cancel := context.WithTimeout(ctx, u.inventoryTimeout)
+	defer cancel()
+
+	type result struct {
+		product json.RawMessage
+		err     error
+	}
+	results := make(map[string]result, len(productIDs))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, productID := range productIDs {
+		wg.Add(1)
+		go func(productID string) {
+			defer wg.Done()
+
+			var r result
+			resp, err := u.inventoryClient.GetProductByID(ctx, &inventorypb.GetProductRequest{Id: productID})
+			if err != nil {
+				r.err = err
+			} else {
+				r.product, r.err = marshalOptions.Marshal(resp.GetProduct())
+			}
+
+			mu.Lock()
+			results[productID] = r
+			mu.Unlock()
+		}(productID)
+	}
+	wg.Wait()
+
+	failed := 0
+	b.mu.Lock()
+	for i := range b.detail.Items {
+		item := &b.detail.Items[i]
+		r := results[item.ProductID]
+		if r.err != nil {
+			partErr := newPartError(r.err)
+			item.Error = &partErr
+			continue
+		}
+		item.Product = r.product
+	}
+	b.mu.Unlock()
+
+	for productID, r := range results {
+		if r.err != nil {
+			logrus.WithContext(ctx).Errorf("Failed to get product %s: %v", productID, r.err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		b.fail(model.PartProducts, fmt.Errorf("%d of %d product lookups failed", failed, len(productIDs)))
+	}
+}
+
+// fetchDiscounts adds the currently active discounts of each product to the items
+func (u *orderDetailUseCase) fetchDiscounts(ctx context.Context, b *orderDetailBuilder, productIDs []string) {
+	ctx, cancel := context.WithTimeout(ctx, u.inventoryTimeout)
+	defer cancel()
+
+	resp, err := u.inventoryClient.GetActiveDiscountsForProducts(ctx, &inventorypb.GetActiveDiscountsForProductsRequest{
+		ProductIds: productIDs,
+	})
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to get discounts for products: %v", err)
+		b.fail(model.PartDiscounts, err)
+		return
+	}
+
+	discounts := make(map[string]json.RawMessage, len(resp.GetProductDiscounts()))
+	for _, productDiscounts := range resp.GetProductDiscounts() {
+		list, err := marshalList(productDiscounts.GetDiscounts())
+		if err != nil {
+			b.fail(model.PartDiscounts, err)
+			return
+		}
+		discounts[productDiscounts.GetProductId()] = list
+	}
+
+	b.mu.Lock()
+	for i := range b.detail.Items {
+		item := &b.detail.Items[i]
+		item.Discounts = discounts[item.ProductID]
+	}
+	b.mu.Unlock()
+}
+
+// marshalList encodes a list of messages as a JSON array
+func marshalList[T proto.Message](messages []T) (json.RawMessage, error) {
+	list := make([]json.RawMessage, 0, len(messages))
+	for _, message := range messages {
+		data, err := marshalOptions.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, data)
+	}
+	return json.Marshal(list)
+}
+
+// newPartError describes a failed backend call
+func newPartError(err error) model.PartError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return model.PartError{Code: "upstream_error", Message: err.Error()}
+	}
+
+	code := "upstream_error"
+	switch st.Code() {
+	case codes.DeadlineExceeded:
+		code = "upstream_timeout"
+	case codes.Unavailable:
+		code = "upstream_unavailable"
+	case codes.NotFound:
+		code = "not_found"
+	}
+
+	return model.PartError{Code: code, Message: st.Message()}
+}
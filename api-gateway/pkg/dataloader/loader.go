@@ -0,0 +1,147 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BatchFunc fetches the values of many keys at once. Keys missing from the
+// returned map are reported as not found.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader collects the keys requested concurrently within a short window and
+// fetches them with a single call of the batch function. Results are kept for
+// the lifetime of the loader, so a loader is meant to serve a single request.
+type Loader[K comparable, V any] struct {
+	ctx      context.Context
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	results map[K]*result[V]
+	pending []K
+	timer   *time.Timer
+}
+
+// result is the outcome of loading one key; done is closed once it is known
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	found bool
+	err   error
+}
+
+// New creates a loader whose batches are fetched with ctx. A batch is fetched
+// once wait has passed since its first key or when it holds maxBatch keys.
+func New[K comparable, V any](ctx context.Context, fetch BatchFunc[K, V], wait time.Duration, maxBatch int) *Loader[K, V] {
+	return &Loader[K, V]{
+		ctx:      ctx,
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		results:  make(map[K]*result[V]),
+	}
+}
+
+// Load returns the value of the key, waiting for the batch it is part of
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, bool, error) {
+	res := l.enqueue(key)
+
+	select {
+	case <-res.done:
+		return res.value, res.found, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, false, ctx.Err()
+	}
+}
+
+// LoadMany returns the values of the keys that were found, in the order of the keys
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) ([]V, error) {
+	results := make([]*result[V], len(keys))
+	for i, key := range keys {
+		results[i] = l.enqueue(key)
+	}
+
+	values := make([]V, 0, len(keys))
+	for _, res := range results {
+		select {
+		case <-res.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if res.err != nil {
+			return nil, res.err
+		}
+		if res.found {
+			values = append(values, res.value)
+		}
+	}
+
+	return values, nil
+}
+
+// enqueue returns the result of the key, adding the key to the pending batch
+// when it has not been requested before
+func (l *Loader[K, V]) enqueue(key K) *result[V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if res, ok := l.results[key]; ok {
+		return res
+	}
+
+	res := &result[V]{done: make(chan struct{})}
+	l.results[key] = res
+	l.pending = append(l.pending, key)
+
+	if l.maxBatch > 0 && len(l.pending) >= l.maxBatch {
+		l.dispatchLocked()
+	} else if l.timer == nil {
+		l.timer = time.AfterFunc(l.wait, l.dispatch)
+	}
+
+	return res
+}
+
+// dispatch fetches the pending batch once its window has passed
+func (l *Loader[K, V]) dispatch() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.dispatchLocked()
+}
+
+// dispatchLocked starts fetching the pending batch; l.mu must be held
+func (l *Loader[K, V]) dispatchLocked() {
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+	if len(l.pending) == 0 {
+		return
+	}
+
+	keys := l.pending
+	l.pending = nil
+
+	batch := make([]*result[V], len(keys))
+	for i, key := range keys {
+		batch[i] = l.results[key]
+	}
+
+	go func() {
+		values, err := l.fetch(l.ctx, keys)
+		for i, key := range keys {
+			res := batch[i]
+			if err != nil {
+				res.err = err
+			} else {
+				res.value, res.found = values[key]
+			}
+			close(res.done)
+		}
+	}()
+}
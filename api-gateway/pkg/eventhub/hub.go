@@ -0,0 +1,162 @@
+package eventhub
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it is dropped
+const subscriberBuffer = 32
+
+// Event is a message delivered to the subscribers of a stream
+type Event struct {
+	ID   string
+	Type string
+	Data []byte
+	Time time.Time
+}
+
+// Hub fans events out to the subscribers of named streams and keeps the recent
+// events of every stream, so subscribers that reconnect can resume after the
+// last event they received.
+type Hub struct {
+	retention   time.Duration
+	maxBuffered int
+
+	mu      sync.Mutex
+	streams map[string]*stream
+}
+
+type stream struct {
+	events      []Event
+	subscribers map[*Subscription]struct{}
+}
+
+// Subscription receives the events published to a stream. Events is closed when
+// the subscription is canceled or the subscriber fell too far behind.
+type Subscription struct {
+	Events <-chan Event
+
+	hub    *Hub
+	name   string
+	events chan Event
+}
+
+// New creates a hub keeping up to maxBuffered events per stream for the retention period
+func New(retention time.Duration, maxBuffered int) *Hub {
+	return &Hub{
+		retention:   retention,
+		maxBuffered: maxBuffered,
+		streams:     make(map[string]*stream),
+	}
+}
+
+// Publish appends an event to a stream and delivers it to the stream's subscribers
+func (h *Hub) Publish(name string, event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.streams[name]
+	if !ok {
+		s = &stream{subscribers: make(map[*Subscription]struct{})}
+		h.streams[name] = s
+	}
+
+	s.events = append(s.events, event)
+	if len(s.events) > h.maxBuffered {
+		s.events = append(s.events[:0:0], s.events[len(s.events)-h.maxBuffered:]...)
+	}
+
+	for sub := range s.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			// A slow subscriber is dropped rather than holding up the others;
+			// it can reconnect and resume from the last event it received
+			delete(s.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// Subscribe starts receiving the events of a stream. The buffered events
+// published after lastEventID are returned to be sent first. When lastEventID
+// is no longer buffered all buffered events are returned, so a subscriber may
+// see an event twice but does not miss one within the retention period.
+func (h *Hub) Subscribe(name, lastEventID string) ([]Event, *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.streams[name]
+	if !ok {
+		s = &stream{subscribers: make(map[*Subscription]struct{})}
+		h.streams[name] = s
+	}
+
+	var replay []Event
+	if lastEventID != "" {
+		replay = s.events
+		for i, event := range s.events {
+			if event.ID == lastEventID {
+				replay = s.events[i+1:]
+				break
+			}
+		}
+		replay = append([]Event(nil), replay...)
+	}
+
+	events := make(chan Event, subscriberBuffer)
+	sub := &Subscription{Events: events, hub: h, name: name, events: events}
+	s.subscribers[sub] = struct{}{}
+
+	return replay, sub
+}
+
+// Cancel stops the subscription
+func (s *Subscription) Cancel() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if st, ok := s.hub.streams[s.name]; ok {
+		if _, subscribed := st.subscribers[s]; subscribed {
+			delete(st.subscribers, s)
+			close(s.events)
+		}
+	}
+}
+
+// StartPruning drops expired events and idle streams at the given interval
+// until the context is canceled
+func (h *Hub) StartPruning(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				h.prune(time.Now().Add(-h.retention))
+			}
+		}
+	}()
+}
+
+// prune drops the events published before the cutoff
+func (h *Hub) prune(cutoff time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for name, s := range h.streams {
+		expired := 0
+		for expired < len(s.events) && s.events[expired].Time.Before(cutoff) {
+			expired++
+		}
+		s.events = append(s.events[:0:0], s.events[expired:]...)
+
+		if len(s.events) == 0 && len(s.subscribers) == 0 {
+			delete(h.streams, name)
+		}
+	}
+}
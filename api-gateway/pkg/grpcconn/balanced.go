@@ -0,0 +1,97 @@
+package grpcconn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/loadbalancer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// balancedConn spreads calls over the connections to the instances of a service
+type balancedConn struct {
+	serviceName      string
+	balancer         *loadbalancer.Balancer
+	conns            map[string]*grpc.ClientConn
+	stopHealthChecks context.CancelFunc
+}
+
+// Invoke sends a unary call to a healthy instance
+func (b *balancedConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	endpoint, err := b.balancer.Pick()
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "%s service: %v", b.serviceName, err)
+	}
+	defer b.balancer.Done(endpoint)
+
+	return b.conns[endpoint.Address].Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream opens a stream to a healthy instance
+func (b *balancedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	endpoint, err := b.balancer.Pick()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%s service: %v", b.serviceName, err)
+	}
+
+	stream, err := b.conns[endpoint.Address].NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		b.balancer.Done(endpoint)
+		return nil, err
+	}
+
+	// The stream context is done once the stream has finished
+	go func() {
+		<-stream.Context().Done()
+		b.balancer.Done(endpoint)
+	}()
+
+	return stream, nil
+}
+
+// probe checks an instance with the standard gRPC health service. Instances
+// that do not implement it are considered healthy as long as they answer.
+func (b *balancedConn) probe(ctx context.Context, endpoint *loadbalancer.Endpoint) error {
+	conn := b.conns[endpoint.Address]
+	if conn.GetState() == connectivity.Idle {
+		conn.Connect()
+	}
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true))
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("health check returned %s", resp.GetStatus())
+	}
+	return nil
+}
+
+// ping probes the instances in turn and succeeds as soon as one of them is healthy
+func (b *balancedConn) ping(ctx context.Context) error {
+	var err error
+	for _, endpoint := range b.balancer.Endpoints() {
+		if err = b.probe(ctx, endpoint); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("no healthy %s instance: %w", b.serviceName, err)
+}
+
+// close stops the health checks and closes every connection
+func (b *balancedConn) close() {
+	if b.stopHealthChecks != nil {
+		b.stopHealthChecks()
+	}
+	for _, conn := range b.conns {
+		conn.Close()
+	}
+}
@@ -3,22 +3,32 @@ package grpcconn
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/loadbalancer"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/logging"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/metrics"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 // Connection represents a gRPC client connection
 type Connection struct {
 	ServiceName string
-	Client      *grpc.ClientConn
+	Client      grpc.ClientConnInterface
+
+	pool *balancedConn
 }
 
 // ConnectionManager manages gRPC connections to various services
 type ConnectionManager struct {
+	mu          sync.Mutex
 	connections map[string]*Connection
 }
 
@@ -29,55 +39,134 @@ func NewConnectionManager() *ConnectionManager {
 	}
 }
 
-// Connect establishes a connection to a gRPC service
-func (m *ConnectionManager) Connect(ctx context.Context, serviceName string, cfg config.ServiceConfig) (*grpc.ClientConn, error) {
+// Connect establishes connections to every instance of a gRPC service and
+// returns a client connection that balances calls over the healthy ones
+func (m *ConnectionManager) Connect(ctx context.Context, serviceName string, cfg config.ServiceConfig) (grpc.ClientConnInterface, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	// Check if connection already exists
 	if conn, exists := m.connections[serviceName]; exists && conn.Client != nil {
 		return conn.Client, nil
 	}
 
+	endpoints := cfg.GetEndpoints()
+	addresses := make([]string, len(endpoints))
+	for i, endpoint := range endpoints {
+		addresses[i] = endpoint.GetGRPCAddress()
+	}
+
+	balancer, err := loadbalancer.New(serviceName, cfg.GetLoadBalancing(), addresses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s service: %w", serviceName, err)
+	}
+
+	pool := &balancedConn{
+		serviceName: serviceName,
+		balancer:    balancer,
+		conns:       make(map[string]*grpc.ClientConn, len(addresses)),
+	}
+
 	// Create connection options
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
+		grpc.WithChainUnaryInterceptor(logging.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck())))),
 	}
 
-	// Connect to the service
-	address := fmt.Sprintf("%s:%d", cfg.GRPCHost, cfg.GRPCPort)
-	logrus.Infof("Connecting to %s gRPC service at %s", serviceName, address)
+	// Connect to every instance; at least one has to be reachable
+	ready := 0
+	for i, address := range addresses {
+		logrus.Infof("Connecting to %s gRPC service at %s", serviceName, address)
 
-	// Create a context with timeout
-	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
+		conn, err := grpc.NewClient(address, opts...)
+		if err != nil {
+			pool.close()
+			return nil, fmt.Errorf("failed to connect to %s service: %w", serviceName, err)
+		}
+		pool.conns[address] = conn
 
-	conn, err := grpc.DialContext(timeoutCtx, address, opts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to %s service: %w", serviceName, err)
+		// Create a context with timeout
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		err = waitForReady(timeoutCtx, conn)
+		cancel()
+		if err != nil {
+			logrus.Warnf("%s gRPC endpoint %s is not ready: %v", serviceName, address, err)
+			balancer.Endpoints()[i].SetHealthy(false)
+			continue
+		}
+		ready++
+	}
+
+	// Calls fail until the health checks find a reachable endpoint
+	if ready == 0 {
+		logrus.Warnf("No %s gRPC endpoint is reachable yet", serviceName)
 	}
 
+	healthCtx, stopHealthChecks := context.WithCancel(context.Background())
+	pool.stopHealthChecks = stopHealthChecks
+	balancer.StartHealthChecks(healthCtx, cfg.HealthCheck, pool.probe)
+
 	// Store the connection
 	m.connections[serviceName] = &Connection{
 		ServiceName: serviceName,
-		Client:      conn,
+		Client:      pool,
+		pool:        pool,
 	}
 
-	return conn, nil
+	return pool, nil
 }
 
 // GetConnection returns an existing connection or creates a new one
-func (m *ConnectionManager) GetConnection(ctx context.Context, serviceName string, cfg config.ServiceConfig) (*grpc.ClientConn, error) {
-	if conn, exists := m.connections[serviceName]; exists && conn.Client != nil {
+func (m *ConnectionManager) GetConnection(ctx context.Context, serviceName string, cfg config.ServiceConfig) (grpc.ClientConnInterface, error) {
+	m.mu.Lock()
+	conn, exists := m.connections[serviceName]
+	m.mu.Unlock()
+
+	if exists && conn.Client != nil {
 		return conn.Client, nil
 	}
 	return m.Connect(ctx, serviceName, cfg)
 }
 
+// Ping checks that at least one instance of a connected service answers the gRPC health check
+func (m *ConnectionManager) Ping(ctx context.Context, serviceName string) error {
+	m.mu.Lock()
+	conn, exists := m.connections[serviceName]
+	m.mu.Unlock()
+
+	if !exists || conn.pool == nil {
+		return fmt.Errorf("not connected to %s service", serviceName)
+	}
+	return conn.pool.ping(ctx)
+}
+
 // Close closes all connections
 func (m *ConnectionManager) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	for name, conn := range m.connections {
-		if conn.Client != nil {
+		if conn.pool != nil {
 			logrus.Infof("Closing connection to %s service", name)
-			conn.Client.Close()
+			conn.pool.close()
+		}
+	}
+}
+
+// waitForReady blocks until the connection is ready or the context is done
+func waitForReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if state == connectivity.TransientFailure {
+			return fmt.Errorf("connection failed")
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return ctx.Err()
 		}
 	}
 }
@@ -0,0 +1,129 @@
+package httpcache
+
+import (
+	"container/list"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/metrics"
+)
+
+// Entry is a cached upstream response
+type Entry struct {
+	Status   int
+	Header   http.Header
+	Body     []byte
+	ETag     string
+	Upstream string
+	Path     string
+	Stored   time.Time
+	Expires  time.Time
+	Private  bool
+}
+
+// Age returns how long ago the entry was stored
+func (e *Entry) Age() time.Duration {
+	return time.Since(e.Stored)
+}
+
+// TTL returns how long the entry stays fresh
+func (e *Entry) TTL() time.Duration {
+	return time.Until(e.Expires)
+}
+
+type item struct {
+	key   string
+	entry *Entry
+}
+
+// Store is an in-memory LRU cache of upstream responses
+type Store struct {
+	mu         sync.Mutex
+	maxEntries int
+	items      map[string]*list.Element
+	lru        *list.List
+}
+
+// New creates a response store holding at most maxEntries responses
+func New(maxEntries int) *Store {
+	return &Store{
+		maxEntries: maxEntries,
+		items:      make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// Get returns a fresh entry for the key
+func (s *Store) Get(key string) (*Entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.items[key]
+	if !ok {
+		metrics.CacheLookup("response", false)
+		return nil, false
+	}
+
+	entry := elem.Value.(*item).entry
+	if time.Now().After(entry.Expires) {
+		s.remove(elem)
+		metrics.CacheLookup("response", false)
+		return nil, false
+	}
+
+	s.lru.MoveToFront(elem)
+	metrics.CacheLookup("response", true)
+	return entry, true
+}
+
+// Set stores an entry, evicting the least recently used one when the store is full
+func (s *Store) Set(key string, entry *Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.items[key]; ok {
+		elem.Value.(*item).entry = entry
+		s.lru.MoveToFront(elem)
+		return
+	}
+
+	s.items[key] = s.lru.PushFront(&item{key: key, entry: entry})
+	for s.lru.Len() > s.maxEntries {
+		s.remove(s.lru.Back())
+	}
+}
+
+// PurgePrefix removes the entries of an upstream whose path starts with the prefix
+// and returns how many were removed
+func (s *Store) PurgePrefix(upstream, prefix string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	purged := 0
+	for elem := s.lru.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*item).entry
+		if entry.Upstream == upstream && strings.HasPrefix(entry.Path, prefix) {
+			s.remove(elem)
+			purged++
+		}
+		elem = next
+	}
+	return purged
+}
+
+// Len returns the number of cached entries
+func (s *Store) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lru.Len()
+}
+
+// remove deletes an element; the caller must hold the lock
+func (s *Store) remove(elem *list.Element) {
+	s.lru.Remove(elem)
+	delete(s.items, elem.Value.(*item).key)
+}
@@ -0,0 +1,205 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/logging"
+	"github.com/baccala1010/e-commerce/api-gateway/pkg/metrics"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ConsumerConfig represents Kafka consumer options
+type ConsumerConfig struct {
+	BootstrapServers string
+	GroupID          string
+	AutoOffsetReset  string
+	// Rewind, when set, starts reading every assigned partition at the first
+	// message published within this duration instead of the committed offset
+	Rewind time.Duration
+}
+
+// Handler processes a consumed message. The context carries the trace and the
+// request ID the message was published in.
+type Handler func(ctx context.Context, msg *kafka.Message)
+
+// Consumer reads messages from a set of topics and hands them to a handler
+type Consumer struct {
+	consumer *kafka.Consumer
+	groupID  string
+	topics   []string
+	rewind   time.Duration
+	closed   atomic.Bool
+	done     chan struct{}
+}
+
+// NewConsumer creates a new Kafka consumer
+func NewConsumer(config ConsumerConfig, topics []string) (*Consumer, error) {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  config.BootstrapServers,
+		"group.id":           config.GroupID,
+		"auto.offset.reset":  config.AutoOffsetReset,
+		"enable.auto.commit": true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka consumer: %w", err)
+	}
+
+	return &Consumer{
+		consumer: consumer,
+		groupID:  config.GroupID,
+		topics:   topics,
+		rewind:   config.Rewind,
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Start subscribes to the topics and passes messages to the handler until the
+// context is canceled, then closes the consumer
+func (c *Consumer) Start(ctx context.Context, handler Handler) error {
+	var rebalance kafka.RebalanceCb
+	if c.rewind > 0 {
+		rebalance = c.rewindAssigned
+	}
+
+	if err := c.consumer.SubscribeTopics(c.topics, rebalance); err != nil {
+		c.close()
+		return fmt.Errorf("failed to subscribe to topics: %w", err)
+	}
+
+	logrus.Infof("Kafka consumer started, subscribed to topics: %v", c.topics)
+
+	go func() {
+		defer c.close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				logrus.Info("Stopping Kafka consumer")
+				return
+			default:
+			}
+
+			msg, err := c.consumer.ReadMessage(100 * time.Millisecond)
+			if err != nil {
+				var kafkaErr kafka.Error
+				if !errors.As(err, &kafkaErr) || kafkaErr.Code() != kafka.ErrTimedOut {
+					logrus.Errorf("Kafka consumer error: %v", err)
+				}
+				continue
+			}
+
+			c.handle(ctx, handler, msg)
+			c.observe(msg)
+		}
+	}()
+
+	return nil
+}
+
+// Ping checks that the brokers answer by fetching their metadata
+func (c *Consumer) Ping(ctx context.Context) error {
+	if c.closed.Load() {
+		return errors.New("kafka consumer is closed")
+	}
+
+	timeoutMs := 5000
+	if deadline, ok := ctx.Deadline(); ok {
+		timeoutMs = int(time.Until(deadline).Milliseconds())
+	}
+	if timeoutMs <= 0 {
+		return context.DeadlineExceeded
+	}
+
+	if _, err := c.consumer.GetMetadata(nil, false, timeoutMs); err != nil {
+		return fmt.Errorf("failed to fetch broker metadata: %w", err)
+	}
+	return nil
+}
+
+// Done is closed once the consumer has been closed, after its context is canceled
+func (c *Consumer) Done() <-chan struct{} {
+	return c.done
+}
+
+// close closes the consumer; it must not be used afterwards
+func (c *Consumer) close() {
+	c.closed.Store(true)
+	c.consumer.Close()
+	close(c.done)
+}
+
+// rewindAssigned assigns newly assigned partitions at the offsets of the rewind
+// window. When the offsets cannot be looked up the committed offsets are used.
+func (c *Consumer) rewindAssigned(consumer *kafka.Consumer, event kafka.Event) error {
+	assigned, ok := event.(kafka.AssignedPartitions)
+	if !ok {
+		return nil
+	}
+
+	since := time.Now().Add(-c.rewind).UnixMilli()
+	times := make([]kafka.TopicPartition, len(assigned.Partitions))
+	for i, partition := range assigned.Partitions {
+		times[i] = partition
+		times[i].Offset = kafka.Offset(since)
+	}
+
+	offsets, err := consumer.OffsetsForTimes(times, 5000)
+	if err != nil {
+		logrus.Warnf("Failed to look up offsets to rewind to, using committed offsets: %v", err)
+		return nil
+	}
+
+	// Partitions without messages in the window have no offset; start them at the end
+	for i := range offsets {
+		if offsets[i].Offset < 0 {
+			offsets[i].Offset = kafka.OffsetEnd
+		}
+	}
+
+	return consumer.Assign(offsets)
+}
+
+// handle passes a message to the handler in a span continuing the producer's trace
+func (c *Consumer) handle(ctx context.Context, handler Handler, msg *kafka.Message) {
+	topic := *msg.TopicPartition.Topic
+	carrier := HeaderCarrier{Headers: &msg.Headers}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	if requestID := carrier.Get(logging.RequestIDKey); logging.ValidID(requestID) {
+		ctx = logging.WithRequestID(ctx, requestID)
+	}
+	if userID := carrier.Get(logging.UserIDKey); logging.ValidID(userID) {
+		ctx = logging.WithUserID(ctx, userID)
+	}
+	ctx, span := otel.Tracer("kafka").Start(ctx, topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingKafkaConsumerGroup(c.groupID),
+			semconv.MessagingDestinationPartitionID(fmt.Sprint(msg.TopicPartition.Partition)),
+			semconv.MessagingKafkaMessageOffset(int(msg.TopicPartition.Offset)),
+		),
+	)
+	defer span.End()
+
+	handler(ctx, msg)
+}
+
+// observe records a consumed message and the lag of its partition, taken from
+// the high watermark of the last fetch so no broker round trip is needed
+func (c *Consumer) observe(msg *kafka.Message) {
+	partition := msg.TopicPartition
+	lag := int64(-1)
+	if _, high, err := c.consumer.GetWatermarkOffsets(*partition.Topic, partition.Partition); err == nil {
+		lag = high - int64(partition.Offset) - 1
+	}
+	metrics.KafkaConsumed(c.groupID, *partition.Topic, partition.Partition, lag)
+}
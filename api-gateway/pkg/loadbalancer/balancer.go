@@ -0,0 +1,114 @@
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// Balancing strategies
+const (
+	RoundRobin       = "round_robin"
+	LeastConnections = "least_connections"
+)
+
+// ErrNoHealthyEndpoints is returned when every endpoint is out of rotation
+var ErrNoHealthyEndpoints = errors.New("no healthy endpoints")
+
+// Endpoint is a single instance of an upstream service
+type Endpoint struct {
+	Address string
+
+	healthy atomic.Bool
+	active  atomic.Int64
+
+	// Consecutive probe results, only touched by the health checker
+	successes int
+	failures  int
+}
+
+// Healthy reports whether the endpoint is in rotation
+func (e *Endpoint) Healthy() bool {
+	return e.healthy.Load()
+}
+
+// SetHealthy moves the endpoint in or out of rotation
+func (e *Endpoint) SetHealthy(healthy bool) {
+	e.healthy.Store(healthy)
+}
+
+// ActiveRequests returns the number of requests currently sent to the endpoint
+func (e *Endpoint) ActiveRequests() int64 {
+	return e.active.Load()
+}
+
+// Balancer spreads requests over the healthy endpoints of a service
+type Balancer struct {
+	name      string
+	strategy  string
+	endpoints []*Endpoint
+	next      atomic.Uint64
+}
+
+// New creates a balancer over the given addresses. Endpoints start healthy
+// so that traffic flows before the first health probe completes.
+func New(name, strategy string, addresses []string) (*Balancer, error) {
+	if strategy != RoundRobin && strategy != LeastConnections {
+		return nil, fmt.Errorf("unknown load balancing strategy %q", strategy)
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no endpoints configured for %s", name)
+	}
+
+	endpoints := make([]*Endpoint, len(addresses))
+	for i, address := range addresses {
+		endpoints[i] = &Endpoint{Address: address}
+		endpoints[i].healthy.Store(true)
+	}
+
+	return &Balancer{
+		name:      name,
+		strategy:  strategy,
+		endpoints: endpoints,
+	}, nil
+}
+
+// Endpoints returns all endpoints of the balancer
+func (b *Balancer) Endpoints() []*Endpoint {
+	return b.endpoints
+}
+
+// Pick selects a healthy endpoint for a request. The caller must call Done
+// once the request has finished.
+func (b *Balancer) Pick() (*Endpoint, error) {
+	var picked *Endpoint
+
+	// Start at a rotating offset so that ties are spread evenly
+	start := int(b.next.Add(1) - 1)
+	for i := range b.endpoints {
+		endpoint := b.endpoints[(start+i)%len(b.endpoints)]
+		if !endpoint.Healthy() {
+			continue
+		}
+
+		if b.strategy == RoundRobin {
+			picked = endpoint
+			break
+		}
+		if picked == nil || endpoint.ActiveRequests() < picked.ActiveRequests() {
+			picked = endpoint
+		}
+	}
+
+	if picked == nil {
+		return nil, ErrNoHealthyEndpoints
+	}
+
+	picked.active.Add(1)
+	return picked, nil
+}
+
+// Done releases an endpoint returned by Pick
+func (b *Balancer) Done(endpoint *Endpoint) {
+	endpoint.active.Add(-1)
+}
@@ -0,0 +1,74 @@
+package loadbalancer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/baccala1010/e-commerce/api-gateway/internal/config"
+	"github.com/sirupsen/logrus"
+)
+
+// Probe checks whether a single endpoint is able to serve requests
+type Probe func(ctx context.Context, endpoint *Endpoint) error
+
+// StartHealthChecks probes every endpoint periodically until the context is canceled.
+// An endpoint leaves rotation after UnhealthyThreshold consecutive failed probes
+// and comes back after HealthyThreshold consecutive successful ones.
+func (b *Balancer) StartHealthChecks(ctx context.Context, cfg config.HealthCheckConfig, probe Probe) {
+	interval := cfg.GetInterval()
+	timeout := cfg.GetTimeout()
+	unhealthyThreshold := cfg.GetUnhealthyThreshold()
+	healthyThreshold := cfg.GetHealthyThreshold()
+
+	check := func() {
+		var wg sync.WaitGroup
+		for _, endpoint := range b.endpoints {
+			wg.Add(1)
+			go func(endpoint *Endpoint) {
+				defer wg.Done()
+
+				probeCtx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+
+				b.record(endpoint, probe(probeCtx, endpoint), unhealthyThreshold, healthyThreshold)
+			}(endpoint)
+		}
+		wg.Wait()
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		check()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				check()
+			}
+		}
+	}()
+}
+
+// record updates the endpoint's probe streak and moves it in or out of rotation
+func (b *Balancer) record(endpoint *Endpoint, err error, unhealthyThreshold, healthyThreshold int) {
+	if err != nil {
+		endpoint.successes = 0
+		endpoint.failures++
+		if endpoint.Healthy() && endpoint.failures >= unhealthyThreshold {
+			endpoint.healthy.Store(false)
+			logrus.Warnf("Taking %s endpoint %s out of rotation: %v", b.name, endpoint.Address, err)
+		}
+		return
+	}
+
+	endpoint.failures = 0
+	endpoint.successes++
+	if !endpoint.Healthy() && endpoint.successes >= healthyThreshold {
+		endpoint.healthy.Store(true)
+		logrus.Infof("Putting %s endpoint %s back into rotation", b.name, endpoint.Address)
+	}
+}
@@ -0,0 +1,22 @@
+package logging
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryClientInterceptor passes the request ID and user ID of the context on
+// to the called service in the outgoing metadata
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if requestID := RequestID(ctx); requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, RequestIDKey, requestID)
+		}
+		if userID := UserID(ctx); userID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, UserIDKey, userID)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
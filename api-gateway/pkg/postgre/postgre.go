@@ -1,12 +1,16 @@
 package postgre
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/plugin/opentelemetry/tracing"
 )
 
 // DB represents a PostgreSQL database connection
@@ -25,6 +29,15 @@ func Connect(options *DBOptions, logLevel logger.LogLevel) (*DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	// Record a span for every query, without the query arguments
+	if err := conn.Use(tracing.NewPlugin(
+		tracing.WithDBName(options.Database),
+		tracing.WithoutQueryVariables(),
+		tracing.WithoutMetrics(),
+	)); err != nil {
+		return nil, fmt.Errorf("failed to set up query tracing: %w", err)
+	}
+
 	// Set connection pool parameters
 	sqlDB, err := conn.DB()
 	if err != nil {
@@ -35,6 +48,11 @@ func Connect(options *DBOptions, logLevel logger.LogLevel) (*DB, error) {
 	sqlDB.SetMaxOpenConns(options.MaxOpenConnections)
 	sqlDB.SetConnMaxLifetime(options.ConnectionMaxLifetime)
 
+	// Export connection pool stats next to the service metrics
+	if err := prometheus.Register(collectors.NewDBStatsCollector(sqlDB, options.Database)); err != nil {
+		logrus.Warnf("Failed to register database pool metrics: %v", err)
+	}
+
 	logrus.Info("Successfully connected to PostgreSQL database")
 	return &DB{conn: conn}, nil
 }
@@ -44,6 +62,15 @@ func (db *DB) GetConnection() *gorm.DB {
 	return db.conn
 }
 
+// Ping checks that the database behind a GORM connection answers
+func Ping(ctx context.Context, conn *gorm.DB) error {
+	sqlDB, err := conn.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	return sqlDB.PingContext(ctx)
+}
+
 // AutoMigrate automatically migrates the schemas for the given models
 func (db *DB) AutoMigrate(models ...interface{}) error {
 	if err := db.conn.AutoMigrate(models...); err != nil {
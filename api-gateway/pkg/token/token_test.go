@@ -0,0 +1,98 @@
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "test-secret"
+
+// sign builds a token with the given header and claims, signed with HS256
+func sign(secret, header, claims string) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// swapClaims replaces the claims of a token and keeps its signature
+func swapClaims(token, claims string) string {
+	parts := strings.Split(token, ".")
+	parts[1] = base64.RawURLEncoding.EncodeToString([]byte(claims))
+	return strings.Join(parts, ".")
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	hs256 := `{"alg":"HS256","typ":"JWT"}`
+	valid := `{"sub":"user-1","exp":1700000600}`
+
+	unsigned := strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)),
+		base64.RawURLEncoding.EncodeToString([]byte(valid)),
+		"",
+	}, ".")
+	tampered := sign(testSecret, hs256, valid)
+	tampered = tampered[:strings.LastIndex(tampered, ".")+1] + base64.RawURLEncoding.EncodeToString([]byte("not the signature"))
+
+	tests := []struct {
+		name    string
+		issuer  string
+		token   string
+		subject string
+		err     error
+	}{
+		{name: "valid", token: sign(testSecret, hs256, valid), subject: "user-1"},
+		{name: "valid with issuer", issuer: "idp", token: sign(testSecret, hs256, `{"sub":"user-1","iss":"idp","exp":1700000600}`), subject: "user-1"},
+		{name: "expired within leeway", token: sign(testSecret, hs256, `{"sub":"user-1","exp":1699999990}`), subject: "user-1"},
+		{name: "not before within leeway", token: sign(testSecret, hs256, `{"sub":"user-1","exp":1700000600,"nbf":1700000010}`), subject: "user-1"},
+		{name: "other secret", token: sign("other-secret", hs256, valid), err: ErrSignature},
+		{name: "tampered signature", token: tampered, err: ErrSignature},
+		{name: "tampered claims", token: swapClaims(sign(testSecret, hs256, valid), `{"sub":"admin","exp":1700000600}`), err: ErrSignature},
+		{name: "alg none", token: unsigned, err: ErrAlgorithm},
+		{name: "alg none signed", token: sign(testSecret, `{"alg":"none"}`, valid), err: ErrAlgorithm},
+		{name: "alg lowercase", token: sign(testSecret, `{"alg":"hs256"}`, valid), err: ErrAlgorithm},
+		{name: "alg HS512", token: sign(testSecret, `{"alg":"HS512"}`, valid), err: ErrAlgorithm},
+		{name: "alg RS256", token: sign(testSecret, `{"alg":"RS256"}`, valid), err: ErrAlgorithm},
+		{name: "alg missing", token: sign(testSecret, `{"typ":"JWT"}`, valid), err: ErrAlgorithm},
+		{name: "expired", token: sign(testSecret, hs256, `{"sub":"user-1","exp":1699999900}`), err: ErrExpired},
+		{name: "not valid yet", token: sign(testSecret, hs256, `{"sub":"user-1","exp":1700000600,"nbf":1700000100}`), err: ErrNotYetValid},
+		{name: "missing exp", token: sign(testSecret, hs256, `{"sub":"user-1"}`), err: ErrMissingClaim},
+		{name: "missing sub", token: sign(testSecret, hs256, `{"exp":1700000600}`), err: ErrMissingClaim},
+		{name: "issuer mismatch", issuer: "idp", token: sign(testSecret, hs256, `{"sub":"user-1","iss":"other","exp":1700000600}`), err: ErrIssuer},
+		{name: "issuer missing", issuer: "idp", token: sign(testSecret, hs256, valid), err: ErrIssuer},
+		{name: "claims not JSON", token: sign(testSecret, hs256, `not json`), err: ErrMalformed},
+		{name: "header not JSON", token: sign(testSecret, `not json`, valid), err: ErrMalformed},
+		{name: "two segments", token: "a.b", err: ErrMalformed},
+		{name: "four segments", token: sign(testSecret, hs256, valid) + ".d", err: ErrMalformed},
+		{name: "signature not base64", token: sign(testSecret, hs256, valid) + "!", err: ErrMalformed},
+		{name: "empty", token: "", err: ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := NewVerifier(testSecret, tt.issuer)
+			verifier.now = func() time.Time { return now }
+
+			claims, err := verifier.Verify(tt.token)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Verify() failed: %v", err)
+			}
+			if claims.Subject != tt.subject {
+				t.Errorf("Subject = %q, want %q", claims.Subject, tt.subject)
+			}
+		})
+	}
+}
@@ -19,11 +19,15 @@ import (
 	"github.com/baccala1010/e-commerce/inventory/internal/middleware"
 	"github.com/baccala1010/e-commerce/inventory/internal/repository"
 	"github.com/baccala1010/e-commerce/inventory/internal/usecase"
+	"github.com/baccala1010/e-commerce/inventory/pkg/grpcserver"
+	"github.com/baccala1010/e-commerce/inventory/pkg/health"
 	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/baccala1010/e-commerce/inventory/pkg/metrics"
 	"github.com/baccala1010/e-commerce/inventory/pkg/pb"
+	"github.com/baccala1010/e-commerce/inventory/pkg/postgre"
+	"github.com/baccala1010/e-commerce/inventory/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc"
 )
 
 func main() {
@@ -37,6 +41,18 @@ func main() {
 	// Set up logging
 	app.SetupLogging(cfg)
 
+	// Set up tracing
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Options{
+		ServiceName: cfg.Server.Name,
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.GetEndpoint(),
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.GetSampleRatio(),
+	})
+	if err != nil {
+		logrus.Fatalf("Failed to initialize tracing: %v", err)
+	}
+
 	// Initialize database
 	db, err := database.InitDB(cfg)
 	if err != nil {
@@ -44,15 +60,28 @@ func main() {
 	}
 
 	// Initialize repositories
-	baseProductRepo := repository.NewProductRepository(db)
-	categoryRepo := repository.NewCategoryRepository(db)
+	baseProductRepo := repository.NewProductRepository(db, cfg.Search.GetLanguage())
+	baseCategoryRepo := repository.NewCategoryRepository(db)
 	discountRepo := repository.NewDiscountRepository(db)
+	baseVariantRepo := repository.NewVariantRepository(db)
+	baseWarehouseRepo := repository.NewWarehouseRepository(db)
+	baseStockRepo := repository.NewStockRepository(db)
+	baseMovementRepo := repository.NewStockMovementRepository(db)
+	baseMediaRepo := repository.NewMediaRepository(db)
+	baseCatalogRepo := repository.NewCatalogRepository(db)
 
 	// Initialize cache
 	productCache := cache.NewMemoryCache()
 
 	// Create cached repository
 	productRepo := repository.NewCachedProductRepository(baseProductRepo, productCache)
+	variantRepo := repository.NewCachedVariantRepository(baseVariantRepo, productCache)
+	warehouseRepo := repository.NewCachedWarehouseRepository(baseWarehouseRepo, productCache)
+	stockRepo := repository.NewCachedStockRepository(baseStockRepo, productCache)
+	movementRepo := repository.NewCachedStockMovementRepository(baseMovementRepo, productCache)
+	categoryRepo := repository.NewCachedCategoryRepository(baseCategoryRepo, productCache)
+	mediaRepo := repository.NewCachedMediaRepository(baseMediaRepo, productCache)
+	catalogRepo := repository.NewCachedCatalogRepository(baseCatalogRepo, productCache)
 
 	// Initialize cache with data
 	cachedRepo, ok := productRepo.(*repository.CachedProductRepository)
@@ -65,8 +94,8 @@ func main() {
 		productCache.StartPeriodicRefresh(12*time.Hour, cachedRepo.RefreshCache)
 	}
 
-	// Initialize Kafka producer
-	kafkaProducer, err := kafka.NewProducer(cfg.Kafka.BootstrapServers, cfg.Kafka.Topics.ProductEvents)
+	// Initialize Kafka producer for catalog changes, which have their own topic
+	kafkaProducer, err := kafka.NewProducer(cfg.Kafka.BootstrapServers, cfg.Kafka.Topics.CatalogEvents)
 	if err != nil {
 		logrus.Warnf("Failed to initialize Kafka producer: %v", err)
 		logrus.Warn("Events will not be published to Kafka")
@@ -75,17 +104,50 @@ func main() {
 		logrus.Info("Kafka producer initialized successfully")
 	}
 
+	// Low stock alerts go to their own topic
+	stockAlertProducer, err := kafka.NewProducer(cfg.Kafka.BootstrapServers, cfg.Kafka.Topics.StockAlerts)
+	if err != nil {
+		logrus.Warnf("Failed to initialize Kafka producer for stock alerts: %v", err)
+		logrus.Warn("Low stock alerts will not be published to Kafka")
+		stockAlertProducer = nil
+	}
+
+	// Initialize the blob store for product media
+	blobStore, err := app.NewBlobStore(context.Background(), cfg)
+	if err != nil {
+		logrus.Fatalf("Failed to initialize media storage: %v", err)
+	}
+
 	// Initialize use cases
-	productUseCase := usecase.NewProductUseCase(productRepo, categoryRepo, kafkaProducer)
-	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo)
-	discountUseCase := usecase.NewDiscountUseCase(discountRepo, productRepo)
+	stockAlerter := usecase.NewStockAlerter(productRepo, stockAlertProducer)
+	mediaUseCase := usecase.NewMediaUseCase(mediaRepo, productRepo, blobStore, cfg.Media.GetMaxUploadSize(), cfg.Media.GetThumbnailSize(), kafkaProducer)
+	productUseCase := usecase.NewProductUseCase(productRepo, categoryRepo, mediaUseCase, stockAlerter, kafkaProducer)
+	variantUseCase := usecase.NewVariantUseCase(variantRepo, productRepo, stockAlerter, kafkaProducer)
+	warehouseUseCase := usecase.NewWarehouseUseCase(warehouseRepo, kafkaProducer)
+	stockUseCase := usecase.NewStockUseCase(stockRepo, warehouseRepo, productRepo, stockAlerter, kafkaProducer)
+	stockLedgerUseCase := usecase.NewStockLedgerUseCase(movementRepo, productRepo, warehouseRepo, stockAlerter, kafkaProducer)
+	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo, kafkaProducer)
+	discountUseCase := usecase.NewDiscountUseCase(discountRepo, productRepo, kafkaProducer)
+	catalogUseCase := usecase.NewCatalogUseCase(catalogRepo, stockAlerter, kafkaProducer)
 
 	// Initialize handlers
 	productHandler := handler.NewProductHandler(productUseCase)
+	variantHandler := handler.NewVariantHandler(variantUseCase)
+	mediaHandler := handler.NewMediaHandler(mediaUseCase, cfg.Media.GetMaxUploadSize())
+	warehouseHandler := handler.NewWarehouseHandler(warehouseUseCase, stockUseCase)
+	stockLedgerHandler := handler.NewStockLedgerHandler(stockLedgerUseCase)
 	categoryHandler := handler.NewCategoryHandler(categoryUseCase)
 	discountHandler := handler.NewDiscountHandler(discountUseCase)
+	catalogHandler := handler.NewCatalogHandler(catalogUseCase, cfg.Catalog.GetMaxImportSize())
 	// Create backoffice gRPC server instance
-	backofficeServer := backoffice.NewServer(productUseCase, categoryUseCase, discountUseCase)
+	backofficeServer := backoffice.NewServer(productUseCase, variantUseCase, categoryUseCase, discountUseCase, warehouseUseCase, stockUseCase, stockLedgerUseCase)
+
+	// Set up health checks
+	healthChecker := health.NewChecker(cfg.Server.Name, cfg.Health.GetCheckTimeout())
+	healthChecker.Register("database", func(ctx context.Context) error {
+		return postgre.Ping(ctx, db)
+	})
+	healthChecker.RegisterOptional("kafka", kafkaProducer.Ping)
 
 	// Set up Gin router
 	gin.SetMode(gin.ReleaseMode)
@@ -94,14 +156,20 @@ func main() {
 	}
 
 	router := gin.New()
+	router.Use(middleware.RequestID())
 	router.Use(middleware.Recovery())
+	router.Use(middleware.Tracing(cfg.Server.Name))
 	router.Use(middleware.Logger())
+	router.Use(middleware.Metrics())
 	router.Use(middleware.CORS())
 
-	// Add health check endpoint
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{"status": "up", "service": cfg.Server.Name})
-	})
+	// Liveness and readiness probes; /health reports readiness for existing checks
+	router.GET("/health", gin.WrapH(healthChecker.ReadyHandler()))
+	router.GET("/health/live", gin.WrapH(healthChecker.LiveHandler()))
+	router.GET("/health/ready", gin.WrapH(healthChecker.ReadyHandler()))
+
+	// Expose Prometheus metrics
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
 
 	// Register API routes
 	v1 := router.Group("/api/v1")
@@ -115,6 +183,43 @@ func main() {
 			products.DELETE("/:id", productHandler.DeleteProduct)
 			products.GET("", productHandler.ListProducts)
 			products.GET("/promotions", discountHandler.GetAllProductsWithPromotion)
+			products.GET("/low-stock", productHandler.ListLowStockProducts)
+
+			// Variant routes
+			products.POST("/:id/variants", variantHandler.CreateVariant)
+			products.GET("/:id/variants", variantHandler.ListVariants)
+			products.GET("/:id/variants/:variant_id", variantHandler.GetVariant)
+			products.PUT("/:id/variants/:variant_id", variantHandler.UpdateVariant)
+			products.DELETE("/:id/variants/:variant_id", variantHandler.DeleteVariant)
+
+			// Images and files of a product
+			products.POST("/:id/media", mediaHandler.UploadMedia)
+			products.GET("/:id/media", mediaHandler.ListMedia)
+			products.PUT("/:id/media/order", mediaHandler.ReorderMedia)
+			products.GET("/:id/media/:media_id", mediaHandler.GetMediaContent)
+			products.GET("/:id/media/:media_id/thumbnail", mediaHandler.GetMediaThumbnail)
+			products.DELETE("/:id/media/:media_id", mediaHandler.DeleteMedia)
+
+			// Per-warehouse stock of a product and its variants
+			products.GET("/:id/stock", warehouseHandler.GetProductStock)
+
+			// Stock ledger of a product and its variants
+			products.POST("/:id/stock/adjustments", stockLedgerHandler.AdjustStock)
+			products.GET("/:id/stock/movements", stockLedgerHandler.ListStockMovements)
+		}
+
+		// Warehouse routes
+		warehouses := v1.Group("/warehouses")
+		{
+			warehouses.POST("", warehouseHandler.CreateWarehouse)
+			warehouses.GET("/:id", warehouseHandler.GetWarehouseByID)
+			warehouses.PUT("/:id", warehouseHandler.UpdateWarehouse)
+			warehouses.DELETE("/:id", warehouseHandler.DeleteWarehouse)
+			warehouses.GET("", warehouseHandler.ListWarehouses)
+			warehouses.GET("/:id/stock", warehouseHandler.GetWarehouseStock)
+			warehouses.PUT("/:id/stock", warehouseHandler.SetStock)
+			warehouses.GET("/:id/transfers", warehouseHandler.ListTransfers)
+			warehouses.POST("/transfers", warehouseHandler.TransferStock)
 		}
 
 		// Category routes
@@ -125,6 +230,9 @@ func main() {
 			categories.PUT("/:id", categoryHandler.UpdateCategory)
 			categories.DELETE("/:id", categoryHandler.DeleteCategory)
 			categories.GET("", categoryHandler.ListCategories)
+			categories.GET("/tree", categoryHandler.GetCategoryTree)
+			categories.GET("/:id/breadcrumbs", categoryHandler.GetCategoryBreadcrumbs)
+			categories.POST("/:id/move", categoryHandler.MoveCategory)
 		}
 
 		// Discount routes
@@ -137,6 +245,13 @@ func main() {
 			discounts.GET("", discountHandler.ListDiscounts)
 			discounts.GET("/:id/products", discountHandler.GetProductsByDiscountID)
 		}
+
+		// Bulk import and export of categories, products and discounts
+		catalog := v1.Group("/catalog")
+		{
+			catalog.POST("/import/:entity", catalogHandler.ImportCatalog)
+			catalog.GET("/export/:entity", catalogHandler.ExportCatalog)
+		}
 	}
 
 	// Set up signal handling
@@ -145,7 +260,9 @@ func main() {
 
 	// Start the gRPC server
 	grpcAddr := fmt.Sprintf(":%d", cfg.Server.GRPCPort)
-	grpcServer := grpc.NewServer()
+	grpcServer := grpcserver.New(grpcserver.Options{
+		DefaultTimeout: cfg.Server.GetGRPCTimeout(),
+	})
 	pb.RegisterInventoryServiceServer(grpcServer, backofficeServer)
 
 	grpcListener, err := net.Listen("tcp", grpcAddr)
@@ -177,6 +294,11 @@ func main() {
 	<-signalChan
 	logrus.Info("Received termination signal, shutting down...")
 
+	// Report not ready and give load balancers time to stop sending requests
+	healthChecker.Shutdown()
+	grpcServer.Shutdown()
+	time.Sleep(cfg.Health.GetShutdownDelay())
+
 	// Graceful shutdown
 	grpcServer.GracefulStop()
 	logrus.Info("gRPC server stopped")
@@ -188,4 +310,8 @@ func main() {
 		logrus.Errorf("HTTP server shutdown error: %v", err)
 	}
 	logrus.Info("HTTP server stopped")
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logrus.Errorf("Tracing shutdown error: %v", err)
+	}
 }
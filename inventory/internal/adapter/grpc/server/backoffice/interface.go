@@ -13,16 +13,24 @@ type InventoryServiceServer interface {
 // Server represents the gRPC server for inventory service
 type Server struct {
 	pb.UnimplementedInventoryServiceServer
-	productUseCase  usecase.ProductUseCase
-	categoryUseCase usecase.CategoryUseCase
-	discountUseCase usecase.DiscountUseCase
+	productUseCase     usecase.ProductUseCase
+	variantUseCase     usecase.VariantUseCase
+	categoryUseCase    usecase.CategoryUseCase
+	discountUseCase    usecase.DiscountUseCase
+	warehouseUseCase   usecase.WarehouseUseCase
+	stockUseCase       usecase.StockUseCase
+	stockLedgerUseCase usecase.StockLedgerUseCase
 }
 
 // NewServer creates a new inventory gRPC server
-func NewServer(productUseCase usecase.ProductUseCase, categoryUseCase usecase.CategoryUseCase, discountUseCase usecase.DiscountUseCase) *Server {
+func NewServer(productUseCase usecase.ProductUseCase, variantUseCase usecase.VariantUseCase, categoryUseCase usecase.CategoryUseCase, discountUseCase usecase.DiscountUseCase, warehouseUseCase usecase.WarehouseUseCase, stockUseCase usecase.StockUseCase, stockLedgerUseCase usecase.StockLedgerUseCase) *Server {
 	return &Server{
-		productUseCase:  productUseCase,
-		categoryUseCase: categoryUseCase,
-		discountUseCase: discountUseCase,
+		productUseCase:     productUseCase,
+		variantUseCase:     variantUseCase,
+		categoryUseCase:    categoryUseCase,
+		discountUseCase:    discountUseCase,
+		warehouseUseCase:   warehouseUseCase,
+		stockUseCase:       stockUseCase,
+		stockLedgerUseCase: stockLedgerUseCase,
 	}
 }
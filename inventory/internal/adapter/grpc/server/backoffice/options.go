@@ -3,32 +3,196 @@ package backoffice
 import (
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
 	"github.com/baccala1010/e-commerce/inventory/pkg/pb"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Helper functions to convert between model and proto
 func convertProductToProto(product *model.Product) *pb.Product {
 	return &pb.Product{
-		Id:          product.ID.String(),
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		StockLevel:  int32(product.StockLevel),
-		CategoryId:  product.CategoryID.String(),
-		Category:    convertCategoryToProto(&product.Category),
-		CreatedAt:   timestamppb.New(product.CreatedAt),
-		UpdatedAt:   timestamppb.New(product.UpdatedAt),
+		Id:               product.ID.String(),
+		Name:             product.Name,
+		Description:      product.Description,
+		Price:            product.Price,
+		StockLevel:       int32(product.StockLevel),
+		CategoryId:       product.CategoryID.String(),
+		Category:         convertCategoryToProto(&product.Category),
+		CreatedAt:        timestamppb.New(product.CreatedAt),
+		UpdatedAt:        timestamppb.New(product.UpdatedAt),
+		Variants:         convertVariantsToProto(product.Variants),
+		AvailableToSell:  int32(product.AvailableToSell),
+		ReorderThreshold: optionalInt32(product.ReorderThreshold),
+		Media:            convertMediaToProto(product.Media),
 	}
 }
 
+func convertVariantsToProto(variants []model.ProductVariant) []*pb.ProductVariant {
+	protoVariants := make([]*pb.ProductVariant, 0, len(variants))
+	for i := range variants {
+		protoVariants = append(protoVariants, convertVariantToProto(&variants[i]))
+	}
+	return protoVariants
+}
+
+func convertVariantToProto(variant *model.ProductVariant) *pb.ProductVariant {
+	return &pb.ProductVariant{
+		Id:              variant.ID.String(),
+		ProductId:       variant.ProductID.String(),
+		Sku:             variant.SKU,
+		Options:         variant.Options,
+		Price:           variant.Price,
+		StockLevel:      int32(variant.StockLevel),
+		CreatedAt:       timestamppb.New(variant.CreatedAt),
+		UpdatedAt:       timestamppb.New(variant.UpdatedAt),
+		AvailableToSell: int32(variant.AvailableToSell),
+	}
+}
+
+func convertMediaToProto(media []model.ProductMedia) []*pb.ProductMedia {
+	protoMedia := make([]*pb.ProductMedia, 0, len(media))
+	for _, m := range media {
+		protoMedia = append(protoMedia, &pb.ProductMedia{
+			Id:           m.ID.String(),
+			ProductId:    m.ProductID.String(),
+			Position:     int32(m.Position),
+			Kind:         string(m.Kind),
+			FileName:     m.FileName,
+			ContentType:  m.ContentType,
+			Size:         m.Size,
+			Width:        optionalInt32(m.Width),
+			Height:       optionalInt32(m.Height),
+			HasThumbnail: m.HasThumbnail,
+			CreatedAt:    timestamppb.New(m.CreatedAt),
+		})
+	}
+	return protoMedia
+}
+
+func convertWarehouseToProto(warehouse *model.Warehouse) *pb.Warehouse {
+	return &pb.Warehouse{
+		Id:        warehouse.ID.String(),
+		Code:      warehouse.Code,
+		Name:      warehouse.Name,
+		Address:   warehouse.Address,
+		IsActive:  warehouse.IsActive,
+		CreatedAt: timestamppb.New(warehouse.CreatedAt),
+		UpdatedAt: timestamppb.New(warehouse.UpdatedAt),
+	}
+}
+
+func convertStockToProto(stock *model.WarehouseStock) *pb.WarehouseStock {
+	return &pb.WarehouseStock{
+		WarehouseId: stock.WarehouseID.String(),
+		ProductId:   stock.ProductID.String(),
+		VariantId:   optionalUUIDString(stock.VariantID),
+		Quantity:    int32(stock.Quantity),
+		UpdatedAt:   timestamppb.New(stock.UpdatedAt),
+	}
+}
+
+func convertTransferToProto(transfer *model.StockTransfer) *pb.StockTransfer {
+	return &pb.StockTransfer{
+		Id:              transfer.ID.String(),
+		FromWarehouseId: transfer.FromWarehouseID.String(),
+		ToWarehouseId:   transfer.ToWarehouseID.String(),
+		ProductId:       transfer.ProductID.String(),
+		VariantId:       optionalUUIDString(transfer.VariantID),
+		Quantity:        int32(transfer.Quantity),
+		Note:            transfer.Note,
+		CreatedAt:       timestamppb.New(transfer.CreatedAt),
+	}
+}
+
+func convertMovementToProto(movement *model.StockMovement) *pb.StockMovement {
+	return &pb.StockMovement{
+		Id:           movement.ID.String(),
+		ProductId:    movement.ProductID.String(),
+		VariantId:    optionalUUIDString(movement.VariantID),
+		WarehouseId:  optionalUUIDString(movement.WarehouseID),
+		Delta:        int32(movement.Delta),
+		BalanceAfter: int32(movement.BalanceAfter),
+		Reason:       string(movement.Reason),
+		Actor:        movement.Actor,
+		OrderId:      optionalUUIDString(movement.OrderID),
+		Note:         movement.Note,
+		CreatedAt:    timestamppb.New(movement.CreatedAt),
+	}
+}
+
+func convertFacetsToProto(facets *model.ProductFacets) *pb.ProductFacets {
+	categories := make([]*pb.CategoryFacet, len(facets.Categories))
+	for i, category := range facets.Categories {
+		categories[i] = &pb.CategoryFacet{
+			CategoryId: category.CategoryID.String(),
+			Name:       category.Name,
+			Count:      int32(category.Count),
+		}
+	}
+
+	priceRanges := make([]*pb.PriceRangeFacet, len(facets.PriceRanges))
+	for i, priceRange := range facets.PriceRanges {
+		priceRanges[i] = &pb.PriceRangeFacet{
+			Min:   priceRange.Min,
+			Max:   priceRange.Max,
+			Count: int32(priceRange.Count),
+		}
+	}
+
+	return &pb.ProductFacets{
+		Categories:  categories,
+		PriceRanges: priceRanges,
+	}
+}
+
+// optionalInt32 converts an optional model number to its proto form
+func optionalInt32(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	converted := int32(*value)
+	return &converted
+}
+
+// optionalInt converts an optional proto number to its model form
+func optionalInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+// optionalUUIDString renders an optional ID, or an empty string when it is not set
+func optionalUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
 func convertCategoryToProto(category *model.Category) *pb.Category {
 	return &pb.Category{
-		Id:          category.ID.String(),
-		Name:        category.Name,
-		Description: category.Description,
-		CreatedAt:   timestamppb.New(category.CreatedAt),
-		UpdatedAt:   timestamppb.New(category.UpdatedAt),
+		Id:                      category.ID.String(),
+		Name:                    category.Name,
+		Description:             category.Description,
+		CreatedAt:               timestamppb.New(category.CreatedAt),
+		UpdatedAt:               timestamppb.New(category.UpdatedAt),
+		DefaultReorderThreshold: optionalInt32(category.DefaultReorderThreshold),
+		ParentId:                optionalUUIDString(category.ParentID),
+		Children:                convertCategoriesToProto(category.Children),
+	}
+}
+
+func convertCategoriesToProto(categories []model.Category) []*pb.Category {
+	if len(categories) == 0 {
+		return nil
+	}
+
+	protoCategories := make([]*pb.Category, len(categories))
+	for i := range categories {
+		protoCategories[i] = convertCategoryToProto(&categories[i])
 	}
+	return protoCategories
 }
 
 // convertDiscountToProto converts a model.Discount to a pb.Discount
@@ -20,14 +20,19 @@ func (s *Server) CreateProduct(ctx context.Context, req *pb.CreateProductRequest
 	}
 
 	createReq := model.CreateProductRequest{
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       req.Price,
-		StockLevel:  int(req.StockLevel),
-		CategoryID:  categoryID,
+		Name:             req.Name,
+		Description:      req.Description,
+		Price:            req.Price,
+		StockLevel:       int(req.StockLevel),
+		CategoryID:       categoryID,
+		ReorderThreshold: optionalInt(req.ReorderThreshold),
 	}
 
-	product, err := s.productUseCase.CreateProduct(createReq)
+	if createReq.ReorderThreshold != nil && *createReq.ReorderThreshold < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "reorder threshold must not be negative")
+	}
+
+	product, err := s.productUseCase.CreateProduct(ctx, createReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create product: %v", err)
 	}
@@ -43,7 +48,7 @@ func (s *Server) GetProductByID(ctx context.Context, req *pb.GetProductRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
 	}
 
-	product, err := s.productUseCase.GetProductByID(productID)
+	product, err := s.productUseCase.GetProductByID(ctx, productID)
 	if err != nil {
 		if err.Error() == model.ErrProductNotFound {
 			return nil, status.Errorf(codes.NotFound, "product not found")
@@ -56,6 +61,31 @@ func (s *Server) GetProductByID(ctx context.Context, req *pb.GetProductRequest)
 	}, nil
 }
 
+func (s *Server) GetProductsByIDs(ctx context.Context, req *pb.GetProductsByIDsRequest) (*pb.GetProductsByIDsResponse, error) {
+	productIDs := make([]uuid.UUID, 0, len(req.Ids))
+	for _, productID := range req.Ids {
+		id, err := uuid.Parse(productID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+		}
+		productIDs = append(productIDs, id)
+	}
+
+	products, err := s.productUseCase.GetProductsByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get products: %v", err)
+	}
+
+	protoProducts := make([]*pb.Product, 0, len(products))
+	for _, product := range products {
+		protoProducts = append(protoProducts, convertProductToProto(&product))
+	}
+
+	return &pb.GetProductsByIDsResponse{
+		Products: protoProducts,
+	}, nil
+}
+
 func (s *Server) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductResponse, error) {
 	productID, err := uuid.Parse(req.Id)
 	if err != nil {
@@ -83,8 +113,13 @@ func (s *Server) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest
 		}
 		updateReq.CategoryID = &categoryID
 	}
+	if req.ReorderThreshold != nil && *req.ReorderThreshold < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "reorder threshold must not be negative")
+	}
+	updateReq.ReorderThreshold = optionalInt(req.ReorderThreshold)
+	updateReq.ClearReorderThreshold = req.ClearReorderThreshold
 
-	product, err := s.productUseCase.UpdateProduct(productID, updateReq)
+	product, err := s.productUseCase.UpdateProduct(ctx, productID, updateReq)
 	if err != nil {
 		if err.Error() == model.ErrProductNotFound {
 			return nil, status.Errorf(codes.NotFound, "product not found")
@@ -92,6 +127,9 @@ func (s *Server) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest
 		if err.Error() == model.ErrCategoryNotFound {
 			return nil, status.Errorf(codes.NotFound, "category not found")
 		}
+		if err.Error() == model.ErrStockLevelReadOnly {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update product: %v", err)
 	}
 
@@ -106,7 +144,7 @@ func (s *Server) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest
 		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
 	}
 
-	if err := s.productUseCase.DeleteProduct(productID); err != nil {
+	if err := s.productUseCase.DeleteProduct(ctx, productID); err != nil {
 		if err.Error() == model.ErrProductNotFound {
 			return nil, status.Errorf(codes.NotFound, "product not found")
 		}
@@ -130,31 +168,560 @@ func (s *Server) ListProducts(ctx context.Context, req *pb.ListProductsRequest)
 		params.CategoryID = &categoryID
 	}
 
-	products, total, err := s.productUseCase.ListProducts(params)
+	if req.Sku != "" {
+		params.SKU = &req.Sku
+	}
+	if len(req.Options) > 0 {
+		params.Options = req.Options
+	}
+	params.MinPrice = req.MinPrice
+	params.MaxPrice = req.MaxPrice
+	if req.Search != "" {
+		params.Search = &req.Search
+	}
+	params.InStock = req.InStock
+	params.OnPromotion = req.OnPromotion
+	params.Sort = model.ProductSort(req.Sort)
+
+	if req.Cursor != nil {
+		return s.listProductsAfter(ctx, params, *req.Cursor)
+	}
+
+	products, total, err := s.productUseCase.ListProducts(ctx, params)
 	if err != nil {
+		if err.Error() == model.ErrInvalidProductSort {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %q", req.Sort)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
 	}
 
+	facets, err := s.productUseCase.GetProductFacets(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count product facets: %v", err)
+	}
+
 	protoProducts := make([]*pb.Product, len(products))
 	for i, product := range products {
 		protoProducts[i] = convertProductToProto(&product)
 	}
 
+	return &pb.ListProductsResponse{
+		Products: protoProducts,
+		Total:    int32(total),
+		Facets:   convertFacetsToProto(facets),
+	}, nil
+}
+
+// listProductsAfter lists the page of products following a cursor, with facets on the first page
+func (s *Server) listProductsAfter(ctx context.Context, params repository.ListProductParams, cursor string) (*pb.ListProductsResponse, error) {
+	products, next, err := s.productUseCase.ListProductsAfter(ctx, params, cursor)
+	if err != nil {
+		switch err.Error() {
+		case model.ErrInvalidProductSort:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %q", params.Sort)
+		case model.ErrInvalidCursor:
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
+	}
+
+	response := &pb.ListProductsResponse{
+		Products:   make([]*pb.Product, len(products)),
+		NextCursor: next,
+	}
+	for i := range products {
+		response.Products[i] = convertProductToProto(&products[i])
+	}
+
+	if cursor == "" {
+		facets, err := s.productUseCase.GetProductFacets(ctx, params)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count product facets: %v", err)
+		}
+		response.Facets = convertFacetsToProto(facets)
+	}
+
+	return response, nil
+}
+
+func (s *Server) ListLowStockProducts(ctx context.Context, req *pb.ListLowStockProductsRequest) (*pb.ListProductsResponse, error) {
+	products, total, err := s.productUseCase.ListLowStockProducts(ctx, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list low stock products: %v", err)
+	}
+
+	protoProducts := make([]*pb.Product, 0, len(products))
+	for i := range products {
+		protoProducts = append(protoProducts, convertProductToProto(&products[i]))
+	}
+
 	return &pb.ListProductsResponse{
 		Products: protoProducts,
 		Total:    int32(total),
 	}, nil
 }
 
+// Variant methods
+func (s *Server) CreateVariant(ctx context.Context, req *pb.CreateVariantRequest) (*pb.VariantResponse, error) {
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+	}
+
+	createReq := model.CreateVariantRequest{
+		SKU:        req.Sku,
+		Options:    req.Options,
+		Price:      req.Price,
+		StockLevel: int(req.StockLevel),
+	}
+	if createReq.Price != nil && *createReq.Price <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "price must be positive")
+	}
+	if createReq.StockLevel < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "stock level must not be negative")
+	}
+
+	variant, err := s.variantUseCase.CreateVariant(ctx, productID, createReq)
+	if err != nil {
+		return nil, variantError("create", err)
+	}
+
+	return &pb.VariantResponse{
+		Variant: convertVariantToProto(variant),
+	}, nil
+}
+
+func (s *Server) GetVariant(ctx context.Context, req *pb.GetVariantRequest) (*pb.VariantResponse, error) {
+	productID, variantID, err := parseVariantIDs(req.ProductId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := s.variantUseCase.GetVariant(ctx, productID, variantID)
+	if err != nil {
+		return nil, variantError("get", err)
+	}
+
+	return &pb.VariantResponse{
+		Variant: convertVariantToProto(variant),
+	}, nil
+}
+
+func (s *Server) ListVariants(ctx context.Context, req *pb.ListVariantsRequest) (*pb.ListVariantsResponse, error) {
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+	}
+
+	variants, err := s.variantUseCase.ListVariants(ctx, productID)
+	if err != nil {
+		return nil, variantError("list", err)
+	}
+
+	return &pb.ListVariantsResponse{
+		Variants: convertVariantsToProto(variants),
+	}, nil
+}
+
+func (s *Server) UpdateVariant(ctx context.Context, req *pb.UpdateVariantRequest) (*pb.VariantResponse, error) {
+	productID, variantID, err := parseVariantIDs(req.ProductId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updateReq := model.UpdateVariantRequest{
+		SKU:        req.Sku,
+		Price:      req.Price,
+		ClearPrice: req.ClearPrice,
+	}
+	if req.ReplaceOptions {
+		updateReq.Options = req.Options
+		if updateReq.Options == nil {
+			updateReq.Options = map[string]string{}
+		}
+	}
+	if req.Price != nil && *req.Price <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "price must be positive")
+	}
+	if req.StockLevel != nil {
+		stockLevel := int(*req.StockLevel)
+		updateReq.StockLevel = &stockLevel
+	}
+
+	variant, err := s.variantUseCase.UpdateVariant(ctx, productID, variantID, updateReq)
+	if err != nil {
+		return nil, variantError("update", err)
+	}
+
+	return &pb.VariantResponse{
+		Variant: convertVariantToProto(variant),
+	}, nil
+}
+
+func (s *Server) DeleteVariant(ctx context.Context, req *pb.DeleteVariantRequest) (*emptypb.Empty, error) {
+	productID, variantID, err := parseVariantIDs(req.ProductId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.variantUseCase.DeleteVariant(ctx, productID, variantID); err != nil {
+		return nil, variantError("delete", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// parseVariantIDs parses the product and variant IDs of a variant request
+func parseVariantIDs(productID, variantID string) (uuid.UUID, uuid.UUID, error) {
+	parsedProductID, err := uuid.Parse(productID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+	}
+
+	parsedVariantID, err := uuid.Parse(variantID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid variant ID: %v", err)
+	}
+
+	return parsedProductID, parsedVariantID, nil
+}
+
+// variantError maps variant use case errors to gRPC status errors
+func variantError(operation string, err error) error {
+	switch err.Error() {
+	case model.ErrProductNotFound:
+		return status.Errorf(codes.NotFound, "product not found")
+	case model.ErrVariantNotFound:
+		return status.Errorf(codes.NotFound, "variant not found")
+	case model.ErrDuplicateSKU:
+		return status.Errorf(codes.AlreadyExists, "sku already in use")
+	case model.ErrStockLevelReadOnly:
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case model.ErrInvalidVariantData:
+		return status.Errorf(codes.InvalidArgument, "invalid variant data")
+	default:
+		return status.Errorf(codes.Internal, "failed to %s variant: %v", operation, err)
+	}
+}
+
+// Warehouse methods
+func (s *Server) CreateWarehouse(ctx context.Context, req *pb.CreateWarehouseRequest) (*pb.WarehouseResponse, error) {
+	if req.Code == "" || req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code and name are required")
+	}
+
+	createReq := model.CreateWarehouseRequest{
+		Code:    req.Code,
+		Name:    req.Name,
+		Address: req.Address,
+	}
+
+	warehouse, err := s.warehouseUseCase.CreateWarehouse(ctx, createReq)
+	if err != nil {
+		return nil, stockError("create warehouse", err)
+	}
+
+	return &pb.WarehouseResponse{
+		Warehouse: convertWarehouseToProto(warehouse),
+	}, nil
+}
+
+func (s *Server) GetWarehouse(ctx context.Context, req *pb.GetWarehouseRequest) (*pb.WarehouseResponse, error) {
+	warehouseID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse ID: %v", err)
+	}
+
+	warehouse, err := s.warehouseUseCase.GetWarehouseByID(ctx, warehouseID)
+	if err != nil {
+		return nil, stockError("get warehouse", err)
+	}
+
+	return &pb.WarehouseResponse{
+		Warehouse: convertWarehouseToProto(warehouse),
+	}, nil
+}
+
+func (s *Server) UpdateWarehouse(ctx context.Context, req *pb.UpdateWarehouseRequest) (*pb.WarehouseResponse, error) {
+	warehouseID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse ID: %v", err)
+	}
+
+	updateReq := model.UpdateWarehouseRequest{
+		Name:     req.Name,
+		Address:  req.Address,
+		IsActive: req.IsActive,
+	}
+
+	warehouse, err := s.warehouseUseCase.UpdateWarehouse(ctx, warehouseID, updateReq)
+	if err != nil {
+		return nil, stockError("update warehouse", err)
+	}
+
+	return &pb.WarehouseResponse{
+		Warehouse: convertWarehouseToProto(warehouse),
+	}, nil
+}
+
+func (s *Server) DeleteWarehouse(ctx context.Context, req *pb.DeleteWarehouseRequest) (*emptypb.Empty, error) {
+	warehouseID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse ID: %v", err)
+	}
+
+	if err := s.warehouseUseCase.DeleteWarehouse(ctx, warehouseID); err != nil {
+		return nil, stockError("delete warehouse", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListWarehouses(ctx context.Context, req *pb.ListWarehousesRequest) (*pb.ListWarehousesResponse, error) {
+	warehouses, err := s.warehouseUseCase.ListWarehouses(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list warehouses: %v", err)
+	}
+
+	protoWarehouses := make([]*pb.Warehouse, 0, len(warehouses))
+	for i := range warehouses {
+		protoWarehouses = append(protoWarehouses, convertWarehouseToProto(&warehouses[i]))
+	}
+
+	return &pb.ListWarehousesResponse{
+		Warehouses: protoWarehouses,
+	}, nil
+}
+
+func (s *Server) SetStock(ctx context.Context, req *pb.SetStockRequest) (*pb.StockResponse, error) {
+	warehouseID, err := uuid.Parse(req.WarehouseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse ID: %v", err)
+	}
+
+	productID, variantID, err := parseStockItem(req.ProductId, req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Quantity < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "quantity must not be negative")
+	}
+
+	setReq := model.SetStockRequest{
+		ProductID: productID,
+		VariantID: variantID,
+		Quantity:  int(req.Quantity),
+	}
+
+	stock, err := s.stockUseCase.SetStock(ctx, warehouseID, setReq)
+	if err != nil {
+		return nil, stockError("set stock", err)
+	}
+
+	return &pb.StockResponse{
+		Stock: convertStockToProto(stock),
+	}, nil
+}
+
+func (s *Server) GetProductStock(ctx context.Context, req *pb.GetProductStockRequest) (*pb.GetProductStockResponse, error) {
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+	}
+
+	stocks, err := s.stockUseCase.GetProductStock(ctx, productID)
+	if err != nil {
+		return nil, stockError("get product stock", err)
+	}
+
+	product, err := s.productUseCase.GetProductByID(ctx, productID)
+	if err != nil {
+		return nil, stockError("get product stock", err)
+	}
+
+	protoStocks := make([]*pb.WarehouseStock, 0, len(stocks))
+	for i := range stocks {
+		protoStocks = append(protoStocks, convertStockToProto(&stocks[i]))
+	}
+
+	return &pb.GetProductStockResponse{
+		Stock:           protoStocks,
+		AvailableToSell: int32(product.AvailableToSell),
+	}, nil
+}
+
+func (s *Server) TransferStock(ctx context.Context, req *pb.TransferStockRequest) (*pb.TransferStockResponse, error) {
+	fromWarehouseID, err := uuid.Parse(req.FromWarehouseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid source warehouse ID: %v", err)
+	}
+
+	toWarehouseID, err := uuid.Parse(req.ToWarehouseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid destination warehouse ID: %v", err)
+	}
+
+	productID, variantID, err := parseStockItem(req.ProductId, req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+
+	transferReq := model.TransferStockRequest{
+		FromWarehouseID: fromWarehouseID,
+		ToWarehouseID:   toWarehouseID,
+		ProductID:       productID,
+		VariantID:       variantID,
+		Quantity:        int(req.Quantity),
+		Note:            req.Note,
+	}
+
+	transfer, err := s.stockUseCase.TransferStock(ctx, transferReq)
+	if err != nil {
+		return nil, stockError("transfer stock", err)
+	}
+
+	return &pb.TransferStockResponse{
+		Transfer: convertTransferToProto(transfer),
+	}, nil
+}
+
+// Stock ledger methods
+func (s *Server) AdjustStock(ctx context.Context, req *pb.AdjustStockRequest) (*pb.StockMovementResponse, error) {
+	productID, variantID, err := parseStockItem(req.ProductId, req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustReq := model.AdjustStockRequest{
+		VariantID: variantID,
+		Delta:     int(req.Delta),
+		Reason:    model.StockMovementReason(req.Reason),
+		Note:      req.Note,
+	}
+
+	if req.WarehouseId != "" {
+		warehouseID, err := uuid.Parse(req.WarehouseId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse ID: %v", err)
+		}
+		adjustReq.WarehouseID = &warehouseID
+	}
+
+	if req.OrderId != "" {
+		orderID, err := uuid.Parse(req.OrderId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order ID: %v", err)
+		}
+		adjustReq.OrderID = &orderID
+	}
+
+	movement, err := s.stockLedgerUseCase.AdjustStock(ctx, productID, adjustReq)
+	if err != nil {
+		if err.Error() == model.ErrInvalidStockMovement {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, stockError("adjust stock", err)
+	}
+
+	return &pb.StockMovementResponse{
+		Movement: convertMovementToProto(movement),
+	}, nil
+}
+
+func (s *Server) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	productID, variantID, err := parseStockItem(req.ProductId, req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+
+	params := repository.ListStockMovementParams{
+		ProductID: productID,
+		VariantID: variantID,
+		Page:      int(req.Page),
+		PageSize:  int(req.Limit),
+	}
+
+	movements, total, err := s.stockLedgerUseCase.ListStockMovements(ctx, params)
+	if err != nil {
+		return nil, stockError("list stock movements", err)
+	}
+
+	protoMovements := make([]*pb.StockMovement, 0, len(movements))
+	for i := range movements {
+		protoMovements = append(protoMovements, convertMovementToProto(&movements[i]))
+	}
+
+	return &pb.ListStockMovementsResponse{
+		Movements: protoMovements,
+		Total:     int32(total),
+	}, nil
+}
+
+// parseStockItem parses the product and optional variant ID of a stock request
+func parseStockItem(productID, variantID string) (uuid.UUID, *uuid.UUID, error) {
+	parsedProductID, err := uuid.Parse(productID)
+	if err != nil {
+		return uuid.Nil, nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+	}
+
+	if variantID == "" {
+		return parsedProductID, nil, nil
+	}
+
+	parsedVariantID, err := uuid.Parse(variantID)
+	if err != nil {
+		return uuid.Nil, nil, status.Errorf(codes.InvalidArgument, "invalid variant ID: %v", err)
+	}
+
+	return parsedProductID, &parsedVariantID, nil
+}
+
+// stockError maps warehouse and stock use case errors to gRPC status errors
+func stockError(operation string, err error) error {
+	switch err.Error() {
+	case model.ErrWarehouseNotFound:
+		return status.Errorf(codes.NotFound, "warehouse not found")
+	case model.ErrProductNotFound:
+		return status.Errorf(codes.NotFound, "product not found")
+	case model.ErrVariantNotFound:
+		return status.Errorf(codes.NotFound, "variant not found")
+	case model.ErrDuplicateWarehouse:
+		return status.Errorf(codes.AlreadyExists, "warehouse code already in use")
+	case model.ErrWarehouseHasStock, model.ErrWarehouseInactive, model.ErrInsufficientStock, model.ErrStockInWarehouses:
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case model.ErrInvalidWarehouseData, model.ErrInvalidStockData:
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", operation, err)
+	}
+}
+
 // Category methods
 func (s *Server) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CategoryResponse, error) {
 	createReq := model.CreateCategoryRequest{
-		Name:        req.Name,
-		Description: req.Description,
+		Name:                    req.Name,
+		Description:             req.Description,
+		DefaultReorderThreshold: optionalInt(req.DefaultReorderThreshold),
+	}
+
+	if createReq.DefaultReorderThreshold != nil && *createReq.DefaultReorderThreshold < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "default reorder threshold must not be negative")
 	}
 
-	category, err := s.categoryUseCase.CreateCategory(createReq)
+	if req.ParentId != "" {
+		parentID, err := uuid.Parse(req.ParentId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent category ID: %v", err)
+		}
+		createReq.ParentID = &parentID
+	}
+
+	category, err := s.categoryUseCase.CreateCategory(ctx, createReq)
 	if err != nil {
+		if err.Error() == model.ErrParentNotFound {
+			return nil, status.Errorf(codes.NotFound, "parent category not found")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create category: %v", err)
 	}
 
@@ -169,7 +736,7 @@ func (s *Server) GetCategoryByID(ctx context.Context, req *pb.GetCategoryRequest
 		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID: %v", err)
 	}
 
-	category, err := s.categoryUseCase.GetCategoryByID(categoryID)
+	category, err := s.categoryUseCase.GetCategoryByID(ctx, categoryID)
 	if err != nil {
 		if err.Error() == model.ErrCategoryNotFound {
 			return nil, status.Errorf(codes.NotFound, "category not found")
@@ -195,8 +762,13 @@ func (s *Server) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryReque
 	if req.Description != nil {
 		updateReq.Description = req.Description
 	}
+	if req.DefaultReorderThreshold != nil && *req.DefaultReorderThreshold < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "default reorder threshold must not be negative")
+	}
+	updateReq.DefaultReorderThreshold = optionalInt(req.DefaultReorderThreshold)
+	updateReq.ClearDefaultReorderThreshold = req.ClearDefaultReorderThreshold
 
-	category, err := s.categoryUseCase.UpdateCategory(categoryID, updateReq)
+	category, err := s.categoryUseCase.UpdateCategory(ctx, categoryID, updateReq)
 	if err != nil {
 		if err.Error() == model.ErrCategoryNotFound {
 			return nil, status.Errorf(codes.NotFound, "category not found")
@@ -215,9 +787,12 @@ func (s *Server) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryReque
 		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID: %v", err)
 	}
 
-	if err := s.categoryUseCase.DeleteCategory(categoryID); err != nil {
-		if err.Error() == model.ErrCategoryNotFound {
+	if err := s.categoryUseCase.DeleteCategory(ctx, categoryID); err != nil {
+		switch err.Error() {
+		case model.ErrCategoryNotFound:
 			return nil, status.Errorf(codes.NotFound, "category not found")
+		case model.ErrCategoryHasChildren:
+			return nil, status.Errorf(codes.FailedPrecondition, "category has subcategories")
 		}
 		return nil, status.Errorf(codes.Internal, "failed to delete category: %v", err)
 	}
@@ -226,7 +801,7 @@ func (s *Server) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryReque
 }
 
 func (s *Server) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
-	categories, err := s.categoryUseCase.ListCategories()
+	categories, err := s.categoryUseCase.ListCategories(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
 	}
@@ -242,6 +817,83 @@ func (s *Server) ListCategories(ctx context.Context, req *pb.ListCategoriesReque
 	}, nil
 }
 
+func (s *Server) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.ListCategoriesResponse, error) {
+	var rootID *uuid.UUID
+	if req.RootId != "" {
+		id, err := uuid.Parse(req.RootId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid root category ID: %v", err)
+		}
+		rootID = &id
+	}
+
+	tree, err := s.categoryUseCase.GetCategoryTree(ctx, rootID)
+	if err != nil {
+		if err.Error() == model.ErrCategoryNotFound {
+			return nil, status.Errorf(codes.NotFound, "category not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get category tree: %v", err)
+	}
+
+	return &pb.ListCategoriesResponse{
+		Categories: convertCategoriesToProto(tree),
+		Total:      int32(len(tree)),
+	}, nil
+}
+
+func (s *Server) GetCategoryBreadcrumbs(ctx context.Context, req *pb.GetCategoryRequest) (*pb.ListCategoriesResponse, error) {
+	categoryID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID: %v", err)
+	}
+
+	breadcrumbs, err := s.categoryUseCase.GetCategoryBreadcrumbs(ctx, categoryID)
+	if err != nil {
+		if err.Error() == model.ErrCategoryNotFound {
+			return nil, status.Errorf(codes.NotFound, "category not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get category breadcrumbs: %v", err)
+	}
+
+	return &pb.ListCategoriesResponse{
+		Categories: convertCategoriesToProto(breadcrumbs),
+		Total:      int32(len(breadcrumbs)),
+	}, nil
+}
+
+func (s *Server) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.CategoryResponse, error) {
+	categoryID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID: %v", err)
+	}
+
+	var moveReq model.MoveCategoryRequest
+	if req.ParentId != "" {
+		parentID, err := uuid.Parse(req.ParentId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent category ID: %v", err)
+		}
+		moveReq.ParentID = &parentID
+	}
+
+	category, err := s.categoryUseCase.MoveCategory(ctx, categoryID, moveReq)
+	if err != nil {
+		switch err.Error() {
+		case model.ErrCategoryNotFound:
+			return nil, status.Errorf(codes.NotFound, "category not found")
+		case model.ErrParentNotFound:
+			return nil, status.Errorf(codes.NotFound, "parent category not found")
+		case model.ErrCategoryCycle:
+			return nil, status.Errorf(codes.FailedPrecondition, "category cannot be moved into its own subtree")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to move category: %v", err)
+	}
+
+	return &pb.CategoryResponse{
+		Category: convertCategoryToProto(category),
+	}, nil
+}
+
 // Discount methods
 func (s *Server) CreateDiscount(ctx context.Context, req *pb.CreateDiscountRequest) (*pb.DiscountResponse, error) {
 	// Convert applicable products from strings to UUIDs
@@ -263,7 +915,7 @@ func (s *Server) CreateDiscount(ctx context.Context, req *pb.CreateDiscountReque
 		EndDate:            req.EndDate.AsTime(),
 	}
 
-	discount, err := s.discountUseCase.CreateDiscount(createReq)
+	discount, err := s.discountUseCase.CreateDiscount(ctx, createReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create discount: %v", err)
 	}
@@ -279,7 +931,7 @@ func (s *Server) GetDiscountByID(ctx context.Context, req *pb.GetDiscountRequest
 		return nil, status.Errorf(codes.InvalidArgument, "invalid discount ID: %v", err)
 	}
 
-	discount, err := s.discountUseCase.GetDiscountByID(discountID)
+	discount, err := s.discountUseCase.GetDiscountByID(ctx, discountID)
 	if err != nil {
 		if err.Error() == model.ErrDiscountNotFound {
 			return nil, status.Errorf(codes.NotFound, "discount not found")
@@ -334,7 +986,7 @@ func (s *Server) UpdateDiscount(ctx context.Context, req *pb.UpdateDiscountReque
 		updateReq.EndDate = &endDate
 	}
 
-	discount, err := s.discountUseCase.UpdateDiscount(discountID, updateReq)
+	discount, err := s.discountUseCase.UpdateDiscount(ctx, discountID, updateReq)
 	if err != nil {
 		if err.Error() == model.ErrDiscountNotFound {
 			return nil, status.Errorf(codes.NotFound, "discount not found")
@@ -353,7 +1005,7 @@ func (s *Server) DeleteDiscount(ctx context.Context, req *pb.DeleteDiscountReque
 		return nil, status.Errorf(codes.InvalidArgument, "invalid discount ID: %v", err)
 	}
 
-	err = s.discountUseCase.DeleteDiscount(discountID)
+	err = s.discountUseCase.DeleteDiscount(ctx, discountID)
 	if err != nil {
 		if err.Error() == model.ErrDiscountNotFound {
 			return nil, status.Errorf(codes.NotFound, "discount not found")
@@ -365,7 +1017,7 @@ func (s *Server) DeleteDiscount(ctx context.Context, req *pb.DeleteDiscountReque
 }
 
 func (s *Server) GetAllProductsWithPromotion(ctx context.Context, req *pb.GetProductsWithPromotionRequest) (*pb.ListProductsResponse, error) {
-	productsWithPromotions, err := s.discountUseCase.GetAllProductsWithPromotion()
+	productsWithPromotions, err := s.discountUseCase.GetAllProductsWithPromotion(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get products with promotions: %v", err)
 	}
@@ -394,7 +1046,7 @@ func (s *Server) GetProductsByDiscountID(ctx context.Context, req *pb.GetProduct
 		return nil, status.Errorf(codes.InvalidArgument, "invalid discount ID: %v", err)
 	}
 
-	products, err := s.discountUseCase.GetProductsByDiscountID(discountID)
+	products, err := s.discountUseCase.GetProductsByDiscountID(ctx, discountID)
 	if err != nil {
 		if err.Error() == model.ErrDiscountNotFound {
 			return nil, status.Errorf(codes.NotFound, "discount not found")
@@ -413,3 +1065,38 @@ func (s *Server) GetProductsByDiscountID(ctx context.Context, req *pb.GetProduct
 		Total:    int32(len(protoProducts)),
 	}, nil
 }
+
+func (s *Server) GetActiveDiscountsForProducts(ctx context.Context, req *pb.GetActiveDiscountsForProductsRequest) (*pb.GetActiveDiscountsForProductsResponse, error) {
+	productIDs := make([]uuid.UUID, 0, len(req.ProductIds))
+	for _, productID := range req.ProductIds {
+		id, err := uuid.Parse(productID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+		}
+		productIDs = append(productIDs, id)
+	}
+
+	discountsByProduct, err := s.discountUseCase.GetActiveDiscountsForProducts(ctx, productIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get discounts for products: %v", err)
+	}
+
+	// Keep the order of the request
+	productDiscounts := make([]*pb.ProductDiscounts, 0, len(productIDs))
+	for _, productID := range productIDs {
+		discounts := discountsByProduct[productID]
+		protoDiscounts := make([]*pb.Discount, 0, len(discounts))
+		for _, discount := range discounts {
+			protoDiscounts = append(protoDiscounts, convertDiscountToProto(&discount))
+		}
+
+		productDiscounts = append(productDiscounts, &pb.ProductDiscounts{
+			ProductId: productID.String(),
+			Discounts: protoDiscounts,
+		})
+	}
+
+	return &pb.GetActiveDiscountsForProductsResponse{
+		ProductDiscounts: productDiscounts,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/config"
+	"github.com/baccala1010/e-commerce/inventory/pkg/blob"
+	"github.com/sirupsen/logrus"
+)
+
+// NewBlobStore creates the blob store for product media selected in the configuration
+func NewBlobStore(ctx context.Context, cfg *config.Config) (blob.Store, error) {
+	media := cfg.Media
+
+	switch media.GetBackend() {
+	case "local":
+		logrus.Infof("Storing product media in %s", media.GetLocalRoot())
+		return blob.NewLocalStore(media.GetLocalRoot())
+	case "s3":
+		store, err := blob.NewS3Store(blob.S3Config{
+			Endpoint:  media.S3.Endpoint,
+			Region:    media.S3.Region,
+			Bucket:    media.S3.Bucket,
+			AccessKey: media.S3.AccessKey,
+			SecretKey: media.S3.SecretKey,
+			PathStyle: media.S3.PathStyle,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := store.EnsureBucket(ctx); err != nil {
+			return nil, err
+		}
+
+		logrus.Infof("Storing product media in bucket %s at %s", media.S3.Bucket, media.S3.Endpoint)
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown media backend: %q", media.Backend)
+	}
+}
@@ -17,6 +17,7 @@ type ProductCache interface {
 	// Product list operations
 	GetProductList(key string) ([]model.Product, int64, bool)
 	SetProductList(key string, products []model.Product, total int64)
+	ClearProductLists()
 
 	// Cache management
 	Clear()
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/pkg/metrics"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
@@ -39,6 +40,7 @@ func (c *MemoryCache) GetProduct(id uuid.UUID) (*model.Product, bool) {
 	defer c.mu.RUnlock()
 
 	product, exists := c.products[id]
+	metrics.CacheLookup("product", exists)
 	if !exists {
 		return nil, false
 	}
@@ -76,6 +78,7 @@ func (c *MemoryCache) GetProductList(key string) ([]model.Product, int64, bool)
 	defer c.mu.RUnlock()
 
 	item, exists := c.productLists[key]
+	metrics.CacheLookup("product_list", exists)
 	if !exists {
 		return nil, 0, false
 	}
@@ -107,6 +110,14 @@ func (c *MemoryCache) SetProductList(key string, products []model.Product, total
 	}
 }
 
+// ClearProductLists removes all product lists from the cache, keeping individual products
+func (c *MemoryCache) ClearProductLists() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.productLists = make(map[string]productListCacheItem)
+}
+
 // Clear removes all items from the cache
 func (c *MemoryCache) Clear() {
 	c.mu.Lock()
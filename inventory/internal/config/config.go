@@ -11,13 +11,28 @@ type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
 	Kafka    KafkaConfig
+	Search   SearchConfig
+	Media    MediaConfig
+	Catalog  CatalogConfig
+	Tracing  TracingConfig
+	Health   HealthConfig
 	Logging  LoggingConfig
 }
 
 type ServerConfig struct {
-	Port     int
-	GRPCPort int `mapstructure:"grpc_port"`
-	Name     string
+	Port        int
+	GRPCPort    int    `mapstructure:"grpc_port"`
+	GRPCTimeout string `mapstructure:"grpc_timeout"`
+	Name        string
+}
+
+// GetGRPCTimeout returns the timeout of gRPC calls without a deadline, 10 seconds by default
+func (sc *ServerConfig) GetGRPCTimeout() time.Duration {
+	d, err := time.ParseDuration(sc.GRPCTimeout)
+	if err != nil || d <= 0 {
+		return 10 * time.Second
+	}
+	return d
 }
 
 type DatabaseConfig struct {
@@ -32,10 +47,141 @@ type DatabaseConfig struct {
 	ConnectionMaxLifetime string `mapstructure:"connection_max_lifetime"`
 }
 
+// SearchConfig configures the full-text search of products
+type SearchConfig struct {
+	Language string
+}
+
+// GetLanguage returns the text search configuration used to index and query
+// products, english by default
+func (sc *SearchConfig) GetLanguage() string {
+	if sc.Language == "" {
+		return "english"
+	}
+	return sc.Language
+}
+
+// MediaConfig selects where product media is stored: "local" or "s3"
+type MediaConfig struct {
+	Backend       string
+	Local         LocalMediaConfig
+	S3            S3MediaConfig `mapstructure:"s3"`
+	MaxUploadSize int64         `mapstructure:"max_upload_size"`
+	ThumbnailSize int           `mapstructure:"thumbnail_size"`
+}
+
+type LocalMediaConfig struct {
+	Root string
+}
+
+type S3MediaConfig struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+	PathStyle bool   `mapstructure:"path_style"`
+}
+
+// GetBackend returns the configured blob store, local by default
+func (mc *MediaConfig) GetBackend() string {
+	if mc.Backend == "" {
+		return "local"
+	}
+	return mc.Backend
+}
+
+// GetLocalRoot returns the directory of the local blob store, ./data/media by default
+func (mc *MediaConfig) GetLocalRoot() string {
+	if mc.Local.Root == "" {
+		return "./data/media"
+	}
+	return mc.Local.Root
+}
+
+// GetMaxUploadSize returns the largest accepted upload in bytes, 10 MiB by default
+func (mc *MediaConfig) GetMaxUploadSize() int64 {
+	if mc.MaxUploadSize <= 0 {
+		return 10 << 20
+	}
+	return mc.MaxUploadSize
+}
+
+// GetThumbnailSize returns the longer edge of generated thumbnails in pixels, 256 by default
+func (mc *MediaConfig) GetThumbnailSize() int {
+	if mc.ThumbnailSize <= 0 {
+		return 256
+	}
+	return mc.ThumbnailSize
+}
+
+// CatalogConfig limits bulk imports of the catalog
+type CatalogConfig struct {
+	MaxImportSize int64 `mapstructure:"max_import_size"`
+}
+
+// GetMaxImportSize returns the largest accepted import file in bytes, 32 MiB by default
+func (cc *CatalogConfig) GetMaxImportSize() int64 {
+	if cc.MaxImportSize <= 0 {
+		return 32 << 20
+	}
+	return cc.MaxImportSize
+}
+
 type LoggingConfig struct {
 	Level string
 }
 
+// TracingConfig selects where spans are exported: "otlp", "stdout" or "none"
+type TracingConfig struct {
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// GetEndpoint returns the OTLP collector address, localhost:4317 by default
+func (tc *TracingConfig) GetEndpoint() string {
+	if tc.Endpoint == "" {
+		return "localhost:4317"
+	}
+	return tc.Endpoint
+}
+
+// GetSampleRatio returns the share of traces started here that are sampled, all by default
+func (tc *TracingConfig) GetSampleRatio() float64 {
+	if tc.SampleRatio <= 0 || tc.SampleRatio > 1 {
+		return 1
+	}
+	return tc.SampleRatio
+}
+
+// HealthConfig tunes the readiness probe and the graceful shutdown
+type HealthConfig struct {
+	CheckTimeout  string `mapstructure:"check_timeout"`
+	ShutdownDelay string `mapstructure:"shutdown_delay"`
+}
+
+// GetCheckTimeout returns the time each dependency check may take, 2 seconds by default
+func (hc *HealthConfig) GetCheckTimeout() time.Duration {
+	d, err := time.ParseDuration(hc.CheckTimeout)
+	if err != nil || d <= 0 {
+		return 2 * time.Second
+	}
+	return d
+}
+
+// GetShutdownDelay returns how long the service keeps serving after it
+// stopped reporting ready, so load balancers can take it out first. No delay
+// by default.
+func (hc *HealthConfig) GetShutdownDelay() time.Duration {
+	d, err := time.ParseDuration(hc.ShutdownDelay)
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
+
 type KafkaConfig struct {
 	BootstrapServers string `mapstructure:"bootstrap_servers"`
 	Topics           KafkaTopics
@@ -43,6 +189,9 @@ type KafkaConfig struct {
 
 type KafkaTopics struct {
 	ProductEvents string `mapstructure:"product_events"`
+	// JSON catalog change events; product_events carries protobuf ProductEvents for the statistics service
+	CatalogEvents string `mapstructure:"catalog_events"`
+	StockAlerts   string `mapstructure:"stock_alerts"`
 }
 
 func LoadConfig(path string) (*Config, error) {
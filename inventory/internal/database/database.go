@@ -1,6 +1,9 @@
 package database
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/baccala1010/e-commerce/inventory/internal/config"
@@ -39,15 +42,60 @@ func InitDB(cfg *config.Config) (*gorm.DB, error) {
 	// Auto-migrate the models
 	if err := db.AutoMigrate(
 		&model.Product{},
+		&model.ProductVariant{},
+		&model.ProductMedia{},
 		&model.Category{},
 		&model.Discount{},
+		&model.Warehouse{},
+		&model.WarehouseStock{},
+		&model.StockTransfer{},
+		&model.StockMovement{},
 	); err != nil {
 		return nil, err
 	}
 
+	if err := migrateProductSearch(db.GetConnection(), cfg.Search.GetLanguage()); err != nil {
+		return nil, err
+	}
+
 	return db.GetConnection(), nil
 }
 
+var searchLanguagePattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// migrateProductSearch adds the weighted full-text search vector of products, name
+// ranking above description, with a GIN index. The vector is a generated column
+// tied to one text search configuration, so it is rebuilt when the language changes.
+func migrateProductSearch(db *gorm.DB, language string) error {
+	if !searchLanguagePattern.MatchString(language) {
+		return fmt.Errorf("invalid search language: %q", language)
+	}
+
+	var expression string
+	if err := db.Raw(`SELECT COALESCE(generation_expression, '') FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'products' AND column_name = 'search_vector'`).
+		Scan(&expression).Error; err != nil {
+		return err
+	}
+
+	if expression != "" && !strings.Contains(expression, "'"+language+"'::regconfig") {
+		logrus.Infof("Rebuilding product search vector for language %s", language)
+		if err := db.Exec("ALTER TABLE products DROP COLUMN search_vector").Error; err != nil {
+			return err
+		}
+	}
+
+	if err := db.Exec(fmt.Sprintf(`ALTER TABLE products ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (
+			setweight(to_tsvector('%[1]s'::regconfig, COALESCE(name, '')), 'A') ||
+			setweight(to_tsvector('%[1]s'::regconfig, COALESCE(description, '')), 'B')
+		) STORED`, language)).Error; err != nil {
+		return err
+	}
+
+	return db.Exec("CREATE INDEX IF NOT EXISTS idx_products_search_vector ON products USING GIN (search_vector)").Error
+}
+
 // parseDuration parses a duration string with a fallback to 1 hour
 func parseDuration(durationStr string) time.Duration {
 	duration, err := time.ParseDuration(durationStr)
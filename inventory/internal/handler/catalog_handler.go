@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// catalogContentTypes are the content types of exports in each format
+var catalogContentTypes = map[model.CatalogFormat]string{
+	model.CatalogFormatCSV:   "text/csv; charset=utf-8",
+	model.CatalogFormatJSONL: "application/x-ndjson",
+}
+
+type CatalogHandler struct {
+	catalogUseCase usecase.CatalogUseCase
+	maxImportSize  int64
+}
+
+func NewCatalogHandler(catalogUseCase usecase.CatalogUseCase, maxImportSize int64) *CatalogHandler {
+	return &CatalogHandler{
+		catalogUseCase: catalogUseCase,
+		maxImportSize:  maxImportSize,
+	}
+}
+
+// ImportCatalog imports the file in the request body. The report is returned with
+// 422 when an import that is not a dry run was rolled back.
+func (h *CatalogHandler) ImportCatalog(c *gin.Context) {
+	options := model.ImportOptions{
+		Format: model.CatalogFormat(c.DefaultQuery("format", string(model.CatalogFormatCSV))),
+		Mode:   model.ImportMode(c.DefaultQuery("mode", string(model.ImportAtomic))),
+	}
+
+	if value := c.Query("dry_run"); value != "" {
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid dry_run value"})
+			return
+		}
+		options.DryRun = dryRun
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, h.maxImportSize)
+
+	report, err := h.catalogUseCase.Import(c.Request.Context(), model.CatalogEntity(c.Param("entity")), body, options)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			err = errors.New(model.ErrImportTooLarge)
+		}
+		respondCatalogError(c, err)
+		return
+	}
+
+	status := http.StatusOK
+	if !report.Committed && !report.DryRun {
+		status = http.StatusUnprocessableEntity
+	}
+
+	c.JSON(status, report)
+}
+
+// ExportCatalog streams every record of the entity as a file download
+func (h *CatalogHandler) ExportCatalog(c *gin.Context) {
+	entity := model.CatalogEntity(c.Param("entity"))
+	format := model.CatalogFormat(c.DefaultQuery("format", string(model.CatalogFormatCSV)))
+
+	if !entity.Valid() {
+		respondCatalogError(c, errors.New(model.ErrInvalidCatalogEntity))
+		return
+	}
+	if !format.Valid() {
+		respondCatalogError(c, errors.New(model.ErrInvalidCatalogFormat))
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", entity, time.Now().UTC().Format("20060102-150405"), format)
+	c.Header("Content-Type", catalogContentTypes[format])
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	c.Status(http.StatusOK)
+
+	if err := h.catalogUseCase.Export(c.Request.Context(), entity, format, c.Writer); err != nil {
+		// Once the download started the error can only cut it short
+		if c.Writer.Written() {
+			logrus.WithContext(c.Request.Context()).Errorf("Failed to export %s: %v", entity, err)
+			c.Abort()
+			return
+		}
+
+		c.Writer.Header().Del("Content-Disposition")
+		respondCatalogError(c, err)
+	}
+}
+
+// respondCatalogError maps catalog use case errors to HTTP responses
+func respondCatalogError(c *gin.Context, err error) {
+	switch err.Error() {
+	case model.ErrInvalidCatalogEntity:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown catalog entity, expected categories, products, discounts or variants"})
+	case model.ErrInvalidCatalogFormat:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format, expected csv or jsonl"})
+	case model.ErrInvalidImportMode:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid mode, expected atomic or best_effort"})
+	case model.ErrImportTooLarge:
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Import file too large"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
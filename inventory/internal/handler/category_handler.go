@@ -26,8 +26,12 @@ func (h *CategoryHandler) CreateCategory(c *gin.Context) {
 		return
 	}
 
-	category, err := h.categoryUseCase.CreateCategory(request)
+	category, err := h.categoryUseCase.CreateCategory(c.Request.Context(), request)
 	if err != nil {
+		if err.Error() == model.ErrParentNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Parent category not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -43,7 +47,7 @@ func (h *CategoryHandler) GetCategoryByID(c *gin.Context) {
 		return
 	}
 
-	category, err := h.categoryUseCase.GetCategoryByID(id)
+	category, err := h.categoryUseCase.GetCategoryByID(c.Request.Context(), id)
 	if err != nil {
 		if err.Error() == model.ErrCategoryNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
@@ -70,7 +74,7 @@ func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
 		return
 	}
 
-	category, err := h.categoryUseCase.UpdateCategory(id, request)
+	category, err := h.categoryUseCase.UpdateCategory(c.Request.Context(), id, request)
 	if err != nil {
 		if err.Error() == model.ErrCategoryNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
@@ -91,13 +95,16 @@ func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
 		return
 	}
 
-	err = h.categoryUseCase.DeleteCategory(id)
+	err = h.categoryUseCase.DeleteCategory(c.Request.Context(), id)
 	if err != nil {
-		if err.Error() == model.ErrCategoryNotFound {
+		switch err.Error() {
+		case model.ErrCategoryNotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
-			return
+		case model.ErrCategoryHasChildren:
+			c.JSON(http.StatusConflict, gin.H{"error": "Category has subcategories, move or delete them first"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
@@ -105,7 +112,7 @@ func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
 }
 
 func (h *CategoryHandler) ListCategories(c *gin.Context) {
-	categories, err := h.categoryUseCase.ListCategories()
+	categories, err := h.categoryUseCase.ListCategories(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -114,10 +121,91 @@ func (h *CategoryHandler) ListCategories(c *gin.Context) {
 	c.JSON(http.StatusOK, categories)
 }
 
+// GetCategoryTree returns the category tree, or the subtree below root_id
+func (h *CategoryHandler) GetCategoryTree(c *gin.Context) {
+	var rootID *uuid.UUID
+	if rootIDStr := c.Query("root_id"); rootIDStr != "" {
+		id, err := uuid.Parse(rootIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid root ID format"})
+			return
+		}
+		rootID = &id
+	}
+
+	tree, err := h.categoryUseCase.GetCategoryTree(c.Request.Context(), rootID)
+	if err != nil {
+		if err.Error() == model.ErrCategoryNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, tree)
+}
+
+// GetCategoryBreadcrumbs returns the path from the root down to a category
+func (h *CategoryHandler) GetCategoryBreadcrumbs(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	breadcrumbs, err := h.categoryUseCase.GetCategoryBreadcrumbs(c.Request.Context(), id)
+	if err != nil {
+		if err.Error() == model.ErrCategoryNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, breadcrumbs)
+}
+
+// MoveCategory moves a category with its subtree below another parent
+func (h *CategoryHandler) MoveCategory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var request model.MoveCategoryRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	category, err := h.categoryUseCase.MoveCategory(c.Request.Context(), id, request)
+	if err != nil {
+		switch err.Error() {
+		case model.ErrCategoryNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+		case model.ErrParentNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Parent category not found"})
+		case model.ErrCategoryCycle:
+			c.JSON(http.StatusConflict, gin.H{"error": "A category cannot be moved below itself or its descendants"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
 func RegisterCategoryRoutes(router *gin.Engine, categoryHandler *CategoryHandler) {
 	router.POST("/categories", categoryHandler.CreateCategory)
 	router.GET("/categories/:id", categoryHandler.GetCategoryByID)
 	router.PATCH("/categories/:id", categoryHandler.UpdateCategory)
 	router.DELETE("/categories/:id", categoryHandler.DeleteCategory)
 	router.GET("/categories", categoryHandler.ListCategories)
+	router.GET("/categories/tree", categoryHandler.GetCategoryTree)
+	router.GET("/categories/:id/breadcrumbs", categoryHandler.GetCategoryBreadcrumbs)
+	router.POST("/categories/:id/move", categoryHandler.MoveCategory)
 }
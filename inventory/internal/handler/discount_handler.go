@@ -26,7 +26,7 @@ func (h *DiscountHandler) CreateDiscount(c *gin.Context) {
 		return
 	}
 
-	discount, err := h.discountUseCase.CreateDiscount(request)
+	discount, err := h.discountUseCase.CreateDiscount(c.Request.Context(), request)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -43,7 +43,7 @@ func (h *DiscountHandler) GetDiscountByID(c *gin.Context) {
 		return
 	}
 
-	discount, err := h.discountUseCase.GetDiscountByID(id)
+	discount, err := h.discountUseCase.GetDiscountByID(c.Request.Context(), id)
 	if err != nil {
 		if err.Error() == model.ErrDiscountNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Discount not found"})
@@ -70,7 +70,7 @@ func (h *DiscountHandler) UpdateDiscount(c *gin.Context) {
 		return
 	}
 
-	discount, err := h.discountUseCase.UpdateDiscount(id, request)
+	discount, err := h.discountUseCase.UpdateDiscount(c.Request.Context(), id, request)
 	if err != nil {
 		if err.Error() == model.ErrDiscountNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Discount not found"})
@@ -91,7 +91,7 @@ func (h *DiscountHandler) DeleteDiscount(c *gin.Context) {
 		return
 	}
 
-	err = h.discountUseCase.DeleteDiscount(id)
+	err = h.discountUseCase.DeleteDiscount(c.Request.Context(), id)
 	if err != nil {
 		if err.Error() == model.ErrDiscountNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Discount not found"})
@@ -105,7 +105,7 @@ func (h *DiscountHandler) DeleteDiscount(c *gin.Context) {
 }
 
 func (h *DiscountHandler) ListDiscounts(c *gin.Context) {
-	discounts, err := h.discountUseCase.ListDiscounts()
+	discounts, err := h.discountUseCase.ListDiscounts(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -115,7 +115,7 @@ func (h *DiscountHandler) ListDiscounts(c *gin.Context) {
 }
 
 func (h *DiscountHandler) GetAllProductsWithPromotion(c *gin.Context) {
-	productsWithPromotions, err := h.discountUseCase.GetAllProductsWithPromotion()
+	productsWithPromotions, err := h.discountUseCase.GetAllProductsWithPromotion(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -132,7 +132,7 @@ func (h *DiscountHandler) GetProductsByDiscountID(c *gin.Context) {
 		return
 	}
 
-	products, err := h.discountUseCase.GetProductsByDiscountID(id)
+	products, err := h.discountUseCase.GetProductsByDiscountID(c.Request.Context(), id)
 	if err != nil {
 		if err.Error() == model.ErrDiscountNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Discount not found"})
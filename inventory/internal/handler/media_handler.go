@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/usecase"
+	"github.com/baccala1010/e-commerce/inventory/pkg/imaging"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// multipartOverhead is the room left for the multipart envelope around an upload
+const multipartOverhead = 1 << 20
+
+type MediaHandler struct {
+	mediaUseCase  usecase.MediaUseCase
+	maxUploadSize int64
+}
+
+func NewMediaHandler(mediaUseCase usecase.MediaUseCase, maxUploadSize int64) *MediaHandler {
+	return &MediaHandler{
+		mediaUseCase:  mediaUseCase,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+// UploadMedia stores the file of the multipart form field "file" as media of the product
+func (h *MediaHandler) UploadMedia(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize+multipartOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			respondMediaError(c, errors.New(model.ErrMediaTooLarge))
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A file is required in the form field \"file\""})
+		return
+	}
+
+	if header.Size > h.maxUploadSize {
+		respondMediaError(c, errors.New(model.ErrMediaTooLarge))
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read the uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, h.maxUploadSize+1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read the uploaded file"})
+		return
+	}
+
+	media, err := h.mediaUseCase.UploadMedia(c.Request.Context(), productID, model.MediaUpload{
+		FileName:    header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+		Data:        data,
+	})
+	if err != nil {
+		respondMediaError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, media)
+}
+
+func (h *MediaHandler) ListMedia(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	media, err := h.mediaUseCase.ListMedia(c.Request.Context(), productID)
+	if err != nil {
+		respondMediaError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"media": media})
+}
+
+func (h *MediaHandler) ReorderMedia(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var request model.ReorderMediaRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	media, err := h.mediaUseCase.ReorderMedia(c.Request.Context(), productID, request)
+	if err != nil {
+		respondMediaError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"media": media})
+}
+
+func (h *MediaHandler) DeleteMedia(c *gin.Context) {
+	productID, mediaID, ok := parseMediaIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.mediaUseCase.DeleteMedia(c.Request.Context(), productID, mediaID); err != nil {
+		respondMediaError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Media deleted successfully"})
+}
+
+// GetMediaContent streams the stored file of the media
+func (h *MediaHandler) GetMediaContent(c *gin.Context) {
+	h.serveMedia(c, false)
+}
+
+// GetMediaThumbnail streams the thumbnail of an image
+func (h *MediaHandler) GetMediaThumbnail(c *gin.Context) {
+	h.serveMedia(c, true)
+}
+
+func (h *MediaHandler) serveMedia(c *gin.Context, thumbnail bool) {
+	productID, mediaID, ok := parseMediaIDs(c)
+	if !ok {
+		return
+	}
+
+	media, content, err := h.mediaUseCase.OpenMedia(c.Request.Context(), productID, mediaID, thumbnail)
+	if err != nil {
+		respondMediaError(c, err)
+		return
+	}
+	defer content.Close()
+
+	contentType, size := media.ContentType, media.Size
+	if thumbnail {
+		contentType, size = imaging.ThumbnailContentType(strings.TrimPrefix(media.ContentType, "image/")), -1
+	}
+
+	// Only images are shown inline; other files are downloaded, so uploaded
+	// HTML or scripts never run in the context of the API
+	disposition := "attachment"
+	if media.Kind == model.MediaKindImage {
+		disposition = "inline"
+	}
+
+	c.DataFromReader(http.StatusOK, size, contentType, content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType(disposition, map[string]string{"filename": media.FileName}),
+		"X-Content-Type-Options": "nosniff",
+		"Cache-Control":          fmt.Sprintf("public, max-age=%d", 24*60*60),
+	})
+}
+
+// parseMediaIDs parses the product and media IDs of the path, responding with 400 if either is invalid
+func parseMediaIDs(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	mediaID, err := uuid.Parse(c.Param("media_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid media ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return productID, mediaID, true
+}
+
+// respondMediaError maps media use case errors to HTTP responses
+func respondMediaError(c *gin.Context, err error) {
+	switch err.Error() {
+	case model.ErrProductNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+	case model.ErrMediaNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Media not found"})
+	case model.ErrMediaTooLarge:
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Media file too large"})
+	case model.ErrEmptyMedia:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Media file is empty"})
+	case model.ErrInvalidMediaOrder:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid media order: list every media of the product exactly once"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -28,7 +28,7 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 		return
 	}
 
-	product, err := h.productUseCase.CreateProduct(request)
+	product, err := h.productUseCase.CreateProduct(c.Request.Context(), request)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -45,7 +45,7 @@ func (h *ProductHandler) GetProductByID(c *gin.Context) {
 		return
 	}
 
-	product, err := h.productUseCase.GetProductByID(id)
+	product, err := h.productUseCase.GetProductByID(c.Request.Context(), id)
 	if err != nil {
 		if err.Error() == model.ErrProductNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
@@ -72,12 +72,16 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 		return
 	}
 
-	product, err := h.productUseCase.UpdateProduct(id, request)
+	product, err := h.productUseCase.UpdateProduct(c.Request.Context(), id, request)
 	if err != nil {
 		if err.Error() == model.ErrProductNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
 			return
 		}
+		if err.Error() == model.ErrStockLevelReadOnly {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Stock level cannot be updated, record a stock adjustment instead"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -93,7 +97,7 @@ func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 		return
 	}
 
-	err = h.productUseCase.DeleteProduct(id)
+	err = h.productUseCase.DeleteProduct(c.Request.Context(), id)
 	if err != nil {
 		if err.Error() == model.ErrProductNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
@@ -159,7 +163,38 @@ func (h *ProductHandler) ListProducts(c *gin.Context) {
 		params.Search = &search
 	}
 
-	products, total, err := h.productUseCase.ListProducts(params)
+	// Parse variant filters, e.g. sku=TSHIRT-M-RED or option[size]=M&option[colour]=red
+	sku := c.Query("sku")
+	if sku != "" {
+		params.SKU = &sku
+	}
+
+	if options := c.QueryMap("option"); len(options) > 0 {
+		params.Options = options
+	}
+
+	// Parse stock and promotion filters and the sort order
+	params.InStock, _ = strconv.ParseBool(c.Query("in_stock"))
+	params.OnPromotion, _ = strconv.ParseBool(c.Query("on_promotion"))
+	params.Sort = model.ProductSort(c.Query("sort"))
+
+	// A cursor, even an empty one for the first page, selects keyset pagination
+	if cursor, ok := c.GetQuery("cursor"); ok {
+		h.listProductsAfter(c, params, cursor)
+		return
+	}
+
+	products, total, err := h.productUseCase.ListProducts(c.Request.Context(), params)
+	if err != nil {
+		if err.Error() == model.ErrInvalidProductSort {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sort, use one of price_asc, price_desc, name_asc, name_desc, newest, stock_asc or stock_desc"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	facets, err := h.productUseCase.GetProductFacets(c.Request.Context(), params)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -170,6 +205,67 @@ func (h *ProductHandler) ListProducts(c *gin.Context) {
 		"total":    total,
 		"page":     page,
 		"page_size": pageSize,
+		"facets":   facets,
+	})
+}
+
+// listProductsAfter lists the page of products following a cursor. Facets are only
+// counted for the first page.
+func (h *ProductHandler) listProductsAfter(c *gin.Context, params repository.ListProductParams, cursor string) {
+	products, next, err := h.productUseCase.ListProductsAfter(c.Request.Context(), params, cursor)
+	if err != nil {
+		switch err.Error() {
+		case model.ErrInvalidProductSort:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sort, use one of price_asc, price_desc, name_asc, name_desc, newest, stock_asc or stock_desc"})
+		case model.ErrInvalidCursor:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid cursor, it must come from a listing with the same sort"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	response := gin.H{
+		"products":    products,
+		"next_cursor": next,
+		"page_size":   params.PageSize,
+	}
+
+	if cursor == "" {
+		facets, err := h.productUseCase.GetProductFacets(c.Request.Context(), params)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		response["facets"] = facets
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListLowStockProducts lists the products below their reorder threshold
+func (h *ProductHandler) ListLowStockProducts(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+
+	products, total, err := h.productUseCase.ListLowStockProducts(c.Request.Context(), page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"products":  products,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
 	})
 }
 
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type StockLedgerHandler struct {
+	stockLedgerUseCase usecase.StockLedgerUseCase
+}
+
+func NewStockLedgerHandler(stockLedgerUseCase usecase.StockLedgerUseCase) *StockLedgerHandler {
+	return &StockLedgerHandler{
+		stockLedgerUseCase: stockLedgerUseCase,
+	}
+}
+
+func (h *StockLedgerHandler) AdjustStock(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var request model.AdjustStockRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	movement, err := h.stockLedgerUseCase.AdjustStock(c.Request.Context(), id, request)
+	if err != nil {
+		switch err.Error() {
+		case model.ErrProductNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		case model.ErrVariantNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Variant not found"})
+		case model.ErrWarehouseNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Warehouse not found"})
+		case model.ErrInsufficientStock:
+			c.JSON(http.StatusConflict, gin.H{"error": "Stock level cannot become negative"})
+		case model.ErrStockInWarehouses:
+			c.JSON(http.StatusConflict, gin.H{"error": "Stock held in warehouses must be booked against its warehouse"})
+		case model.ErrWarehouseInactive:
+			c.JSON(http.StatusConflict, gin.H{"error": "Warehouse is inactive"})
+		case model.ErrInvalidStockData:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid stock data: products with variants are stocked per variant"})
+		case model.ErrInvalidStockMovement:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid stock movement: sales must remove stock, returns and restocks must add it"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, movement)
+}
+
+func (h *StockLedgerHandler) ListStockMovements(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	params := repository.ListStockMovementParams{ProductID: id}
+
+	// Parse variant ID
+	if variantIDStr := c.Query("variant_id"); variantIDStr != "" {
+		variantID, err := uuid.Parse(variantIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid variant ID format"})
+			return
+		}
+		params.VariantID = &variantID
+	}
+
+	// Parse page and page size
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+
+	params.Page = page
+	params.PageSize = pageSize
+
+	movements, total, err := h.stockLedgerUseCase.ListStockMovements(c.Request.Context(), params)
+	if err != nil {
+		if err.Error() == model.ErrProductNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"movements": movements,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
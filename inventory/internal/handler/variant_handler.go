@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type VariantHandler struct {
+	variantUseCase usecase.VariantUseCase
+}
+
+func NewVariantHandler(variantUseCase usecase.VariantUseCase) *VariantHandler {
+	return &VariantHandler{
+		variantUseCase: variantUseCase,
+	}
+}
+
+func (h *VariantHandler) CreateVariant(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var request model.CreateVariantRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	variant, err := h.variantUseCase.CreateVariant(c.Request.Context(), productID, request)
+	if err != nil {
+		respondVariantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, variant)
+}
+
+func (h *VariantHandler) GetVariant(c *gin.Context) {
+	productID, variantID, ok := parseVariantIDs(c)
+	if !ok {
+		return
+	}
+
+	variant, err := h.variantUseCase.GetVariant(c.Request.Context(), productID, variantID)
+	if err != nil {
+		respondVariantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, variant)
+}
+
+func (h *VariantHandler) ListVariants(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	variants, err := h.variantUseCase.ListVariants(c.Request.Context(), productID)
+	if err != nil {
+		respondVariantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"variants": variants})
+}
+
+func (h *VariantHandler) UpdateVariant(c *gin.Context) {
+	productID, variantID, ok := parseVariantIDs(c)
+	if !ok {
+		return
+	}
+
+	var request model.UpdateVariantRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	variant, err := h.variantUseCase.UpdateVariant(c.Request.Context(), productID, variantID, request)
+	if err != nil {
+		respondVariantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, variant)
+}
+
+func (h *VariantHandler) DeleteVariant(c *gin.Context) {
+	productID, variantID, ok := parseVariantIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.variantUseCase.DeleteVariant(c.Request.Context(), productID, variantID); err != nil {
+		respondVariantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Variant deleted successfully"})
+}
+
+// parseVariantIDs parses the product and variant IDs of the path, responding with 400 if either is invalid
+func parseVariantIDs(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	variantID, err := uuid.Parse(c.Param("variant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid variant ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return productID, variantID, true
+}
+
+// respondVariantError maps variant use case errors to HTTP responses
+func respondVariantError(c *gin.Context, err error) {
+	switch err.Error() {
+	case model.ErrProductNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+	case model.ErrVariantNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Variant not found"})
+	case model.ErrDuplicateSKU:
+		c.JSON(http.StatusConflict, gin.H{"error": "SKU already in use"})
+	case model.ErrStockLevelReadOnly:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Stock level cannot be updated, record a stock adjustment instead"})
+	case model.ErrInvalidVariantData:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid variant data: SKU and option names and values must not be empty"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WarehouseHandler struct {
+	warehouseUseCase usecase.WarehouseUseCase
+	stockUseCase     usecase.StockUseCase
+}
+
+func NewWarehouseHandler(warehouseUseCase usecase.WarehouseUseCase, stockUseCase usecase.StockUseCase) *WarehouseHandler {
+	return &WarehouseHandler{
+		warehouseUseCase: warehouseUseCase,
+		stockUseCase:     stockUseCase,
+	}
+}
+
+func (h *WarehouseHandler) CreateWarehouse(c *gin.Context) {
+	var request model.CreateWarehouseRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	warehouse, err := h.warehouseUseCase.CreateWarehouse(c.Request.Context(), request)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, warehouse)
+}
+
+func (h *WarehouseHandler) GetWarehouseByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	warehouse, err := h.warehouseUseCase.GetWarehouseByID(c.Request.Context(), id)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, warehouse)
+}
+
+func (h *WarehouseHandler) UpdateWarehouse(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var request model.UpdateWarehouseRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	warehouse, err := h.warehouseUseCase.UpdateWarehouse(c.Request.Context(), id, request)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, warehouse)
+}
+
+func (h *WarehouseHandler) DeleteWarehouse(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	if err := h.warehouseUseCase.DeleteWarehouse(c.Request.Context(), id); err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Warehouse deleted successfully"})
+}
+
+func (h *WarehouseHandler) ListWarehouses(c *gin.Context) {
+	warehouses, err := h.warehouseUseCase.ListWarehouses(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, warehouses)
+}
+
+func (h *WarehouseHandler) GetWarehouseStock(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	stocks, err := h.stockUseCase.GetWarehouseStock(c.Request.Context(), id)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stock": stocks})
+}
+
+func (h *WarehouseHandler) SetStock(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var request model.SetStockRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	stock, err := h.stockUseCase.SetStock(c.Request.Context(), id, request)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, stock)
+}
+
+func (h *WarehouseHandler) GetProductStock(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	stocks, err := h.stockUseCase.GetProductStock(c.Request.Context(), id)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stock": stocks})
+}
+
+func (h *WarehouseHandler) TransferStock(c *gin.Context) {
+	var request model.TransferStockRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	transfer, err := h.stockUseCase.TransferStock(c.Request.Context(), request)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, transfer)
+}
+
+func (h *WarehouseHandler) ListTransfers(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	transfers, err := h.stockUseCase.ListTransfers(c.Request.Context(), id)
+	if err != nil {
+		respondStockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transfers": transfers})
+}
+
+// respondStockError maps warehouse and stock use case errors to HTTP responses
+func respondStockError(c *gin.Context, err error) {
+	switch err.Error() {
+	case model.ErrWarehouseNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Warehouse not found"})
+	case model.ErrProductNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+	case model.ErrVariantNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Variant not found"})
+	case model.ErrDuplicateWarehouse:
+		c.JSON(http.StatusConflict, gin.H{"error": "Warehouse code already in use"})
+	case model.ErrWarehouseHasStock:
+		c.JSON(http.StatusConflict, gin.H{"error": "Warehouse still holds stock; transfer it out first"})
+	case model.ErrWarehouseInactive:
+		c.JSON(http.StatusConflict, gin.H{"error": "Warehouse is inactive"})
+	case model.ErrInsufficientStock:
+		c.JSON(http.StatusConflict, gin.H{"error": "Insufficient stock; neither the warehouse quantity nor the stock level may become negative"})
+	case model.ErrInvalidWarehouseData:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse data: code must not be empty"})
+	case model.ErrInvalidStockData:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid stock data: products with variants are stocked per variant, and transfers need two different warehouses"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
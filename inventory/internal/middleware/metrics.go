@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/baccala1010/e-commerce/inventory/pkg/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics middleware for recording request counts and latencies by route
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		// Label by route template rather than path to keep the number of series bounded
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
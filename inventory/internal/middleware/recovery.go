@@ -14,7 +14,7 @@ func Recovery() gin.HandlerFunc {
 		defer func() {
 			if err := recover(); err != nil {
 				// Log the error and stack trace
-				logrus.WithFields(logrus.Fields{
+				logrus.WithContext(c.Request.Context()).WithFields(logrus.Fields{
 					"error": err,
 					"stack": string(debug.Stack()),
 				}).Error("Panic recovered")
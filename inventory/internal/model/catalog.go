@@ -0,0 +1,126 @@
+package model
+
+import "time"
+
+// CatalogEntity names a kind of catalog record that is imported and exported in bulk
+type CatalogEntity string
+
+const (
+	CatalogCategories CatalogEntity = "categories"
+	CatalogProducts   CatalogEntity = "products"
+	CatalogDiscounts  CatalogEntity = "discounts"
+	CatalogVariants   CatalogEntity = "variants"
+)
+
+// Valid reports whether the entity is known
+func (e CatalogEntity) Valid() bool {
+	switch e {
+	case CatalogCategories, CatalogProducts, CatalogDiscounts, CatalogVariants:
+		return true
+	default:
+		return false
+	}
+}
+
+// CatalogFormat is the file format of bulk imports and exports
+type CatalogFormat string
+
+const (
+	CatalogFormatCSV   CatalogFormat = "csv"
+	CatalogFormatJSONL CatalogFormat = "jsonl"
+)
+
+// Valid reports whether the format is known
+func (f CatalogFormat) Valid() bool {
+	return f == CatalogFormatCSV || f == CatalogFormatJSONL
+}
+
+// ImportMode decides what happens to the valid rows of an import with failing rows
+type ImportMode string
+
+const (
+	// ImportAtomic writes nothing unless every row succeeds
+	ImportAtomic ImportMode = "atomic"
+	// ImportBestEffort writes every row that succeeds and reports the others
+	ImportBestEffort ImportMode = "best_effort"
+)
+
+// Valid reports whether the mode is known
+func (m ImportMode) Valid() bool {
+	return m == ImportAtomic || m == ImportBestEffort
+}
+
+// ImportOptions control a bulk import. A dry run validates and reports every row
+// as a real import would, then rolls everything back.
+type ImportOptions struct {
+	Format CatalogFormat
+	Mode   ImportMode
+	DryRun bool
+}
+
+// CategoryRecord is a category in bulk imports and exports. Parent is the name
+// of the parent category, which must exist or come earlier in the same import.
+type CategoryRecord struct {
+	Name                    string `json:"name"`
+	Description             string `json:"description"`
+	Parent                  string `json:"parent,omitempty"`
+	DefaultReorderThreshold *int   `json:"default_reorder_threshold,omitempty"`
+}
+
+// ProductRecord is a product in bulk imports and exports. Category is the name of
+// its category. Without a stock level, imports keep the stock of existing products
+// and start new ones at zero.
+type ProductRecord struct {
+	Name             string  `json:"name"`
+	Description      string  `json:"description"`
+	Price            float64 `json:"price"`
+	StockLevel       *int    `json:"stock_level,omitempty"`
+	Category         string  `json:"category"`
+	ReorderThreshold *int    `json:"reorder_threshold,omitempty"`
+}
+
+// VariantRecord is a product variant in bulk imports and exports, matched by SKU.
+// Product is the name of its product. Without a price the variant is sold at the
+// product price. Without a stock level, imports keep the stock of existing variants
+// and start new ones at zero.
+type VariantRecord struct {
+	SKU        string            `json:"sku"`
+	Product    string            `json:"product"`
+	Options    map[string]string `json:"options,omitempty"`
+	Price      *float64          `json:"price,omitempty"`
+	StockLevel *int              `json:"stock_level,omitempty"`
+}
+
+// DiscountRecord is a discount in bulk imports and exports. Products lists the
+// names of the products it applies to; without any it applies to all products.
+type DiscountRecord struct {
+	Name               string    `json:"name"`
+	Description        string    `json:"description"`
+	DiscountPercentage float64   `json:"discount_percentage"`
+	StartDate          time.Time `json:"start_date"`
+	EndDate            time.Time `json:"end_date"`
+	IsActive           *bool     `json:"is_active,omitempty"`
+	Products           []string  `json:"products,omitempty"`
+}
+
+// ImportReport summarizes a bulk import. Committed is false for dry runs and for
+// atomic imports with failing rows.
+type ImportReport struct {
+	Entity    CatalogEntity    `json:"entity"`
+	Mode      ImportMode       `json:"mode"`
+	DryRun    bool             `json:"dry_run"`
+	Committed bool             `json:"committed"`
+	Total     int              `json:"total"`
+	Created   int              `json:"created"`
+	Updated   int              `json:"updated"`
+	Failed    int              `json:"failed"`
+	Errors    []ImportRowError `json:"errors"`
+}
+
+// ImportRowError describes why a row of an import failed. Line is the line of
+// the row in the uploaded file.
+type ImportRowError struct {
+	Line  int    `json:"line"`
+	Key   string `json:"key,omitempty"`
+	Error string `json:"error"`
+}
@@ -7,14 +7,20 @@ import (
 	"gorm.io/gorm"
 )
 
+// Category groups products. Categories form a tree of any depth through ParentID,
+// roots have none. Children is only filled in category trees. DefaultReorderThreshold
+// applies to its products that have no reorder threshold of their own.
 type Category struct {
-	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
-	Name        string         `json:"name" gorm:"type:varchar(255);not null;unique"`
-	Description string         `json:"description" gorm:"type:text"`
-	Products    []Product      `json:"products,omitempty" gorm:"foreignKey:CategoryID"`
-	CreatedAt   time.Time      `json:"created_at" gorm:"not null;default:now()"`
-	UpdatedAt   time.Time      `json:"updated_at" gorm:"not null;default:now()"`
-	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+	ID                      uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	Name                    string         `json:"name" gorm:"type:varchar(255);not null;unique"`
+	Description             string         `json:"description" gorm:"type:text"`
+	ParentID                *uuid.UUID     `json:"parent_id" gorm:"type:uuid;index"`
+	Children                []Category     `json:"children,omitempty" gorm:"-"`
+	DefaultReorderThreshold *int           `json:"default_reorder_threshold,omitempty"`
+	Products                []Product      `json:"products,omitempty" gorm:"foreignKey:CategoryID"`
+	CreatedAt               time.Time      `json:"created_at" gorm:"not null;default:now()"`
+	UpdatedAt               time.Time      `json:"updated_at" gorm:"not null;default:now()"`
+	DeletedAt               gorm.DeletedAt `json:"-" gorm:"index"`
 }
 
 func (c *Category) BeforeCreate(tx *gorm.DB) error {
@@ -26,12 +32,23 @@ func (c *Category) BeforeCreate(tx *gorm.DB) error {
 
 // CreateCategoryRequest represents the request body for creating a new category
 type CreateCategoryRequest struct {
-	Name        string `json:"name" binding:"required"`
-	Description string `json:"description"`
+	Name                    string     `json:"name" binding:"required"`
+	Description             string     `json:"description"`
+	ParentID                *uuid.UUID `json:"parent_id"`
+	DefaultReorderThreshold *int       `json:"default_reorder_threshold" binding:"omitempty,gte=0"`
 }
 
-// UpdateCategoryRequest represents the request body for updating a category
+// UpdateCategoryRequest represents the request body for updating a category.
+// ClearDefaultReorderThreshold removes the default threshold.
 type UpdateCategoryRequest struct {
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
+	Name                         *string `json:"name"`
+	Description                  *string `json:"description"`
+	DefaultReorderThreshold      *int    `json:"default_reorder_threshold" binding:"omitempty,gte=0"`
+	ClearDefaultReorderThreshold bool    `json:"clear_default_reorder_threshold"`
+}
+
+// MoveCategoryRequest represents the request body for moving a category, together
+// with its subtree, below another parent. Without a parent it becomes a root.
+type MoveCategoryRequest struct {
+	ParentID *uuid.UUID `json:"parent_id"`
 }
@@ -2,11 +2,38 @@ package model
 
 // Error constants for the inventory service
 const (
-	ErrProductNotFound     = "product not found"
-	ErrCategoryNotFound    = "category not found"
-	ErrDiscountNotFound    = "discount not found"
-	ErrInvalidProductData  = "invalid product data"
-	ErrInvalidCategoryData = "invalid category data"
-	ErrInvalidDiscountData = "invalid discount data"
-	ErrDatabaseOperation   = "database operation failed"
+	ErrProductNotFound      = "product not found"
+	ErrCategoryNotFound     = "category not found"
+	ErrParentNotFound       = "parent category not found"
+	ErrCategoryCycle        = "category cannot be moved into its own subtree"
+	ErrCategoryHasChildren  = "category has subcategories"
+	ErrDiscountNotFound     = "discount not found"
+	ErrVariantNotFound      = "variant not found"
+	ErrDuplicateSKU         = "sku already in use"
+	ErrMediaNotFound        = "media not found"
+	ErrInvalidMediaOrder    = "media order must list every media of the product once"
+	ErrMediaTooLarge        = "media file too large"
+	ErrEmptyMedia           = "media file is empty"
+	ErrWarehouseNotFound    = "warehouse not found"
+	ErrDuplicateWarehouse   = "warehouse code already in use"
+	ErrWarehouseInactive    = "warehouse is inactive"
+	ErrWarehouseHasStock    = "warehouse still holds stock"
+	ErrInsufficientStock    = "insufficient stock"
+	ErrStockInWarehouses    = "stock level below the stock held in warehouses"
+	ErrStockLevelReadOnly   = "stock level can only be changed through stock adjustments"
+	ErrInvalidProductData   = "invalid product data"
+	ErrInvalidCategoryData  = "invalid category data"
+	ErrInvalidDiscountData  = "invalid discount data"
+	ErrInvalidVariantData   = "invalid variant data"
+	ErrInvalidWarehouseData = "invalid warehouse data"
+	ErrInvalidStockData     = "invalid stock data"
+	ErrInvalidStockMovement = "invalid stock movement"
+	ErrInvalidProductSort   = "invalid product sort"
+	ErrInvalidCursor        = "invalid cursor"
+	ErrInvalidCatalogEntity = "invalid catalog entity"
+	ErrInvalidCatalogFormat = "invalid catalog format"
+	ErrInvalidImportMode    = "invalid import mode"
+	ErrImportTooLarge       = "import file too large"
+	ErrAmbiguousName        = "name matches more than one record"
+	ErrDatabaseOperation    = "database operation failed"
 )
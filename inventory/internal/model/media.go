@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MediaKind tells images, which get a thumbnail, apart from other files
+type MediaKind string
+
+const (
+	MediaKindImage MediaKind = "image"
+	MediaKindFile  MediaKind = "file"
+)
+
+// ProductMedia is an image or file attached to a product. The content lives in
+// the blob store under BlobKey, and images also have a thumbnail under
+// ThumbnailKey. Media of a product is ordered by Position, starting at 1.
+type ProductMedia struct {
+	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	ProductID    uuid.UUID `json:"product_id" gorm:"type:uuid;not null;index:idx_product_media_position"`
+	Position     int       `json:"position" gorm:"not null;index:idx_product_media_position"`
+	Kind         MediaKind `json:"kind" gorm:"type:varchar(16);not null"`
+	FileName     string    `json:"file_name" gorm:"type:varchar(255);not null"`
+	ContentType  string    `json:"content_type" gorm:"type:varchar(255);not null"`
+	Size         int64     `json:"size" gorm:"not null"`
+	Width        *int      `json:"width,omitempty"`
+	Height       *int      `json:"height,omitempty"`
+	BlobKey      string    `json:"-" gorm:"type:varchar(512);not null"`
+	ThumbnailKey string    `json:"-" gorm:"type:varchar(512)"`
+	HasThumbnail bool      `json:"has_thumbnail" gorm:"-"`
+	CreatedAt    time.Time `json:"created_at" gorm:"not null;default:now()"`
+}
+
+func (m *ProductMedia) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+func (m *ProductMedia) AfterFind(tx *gorm.DB) error {
+	m.HasThumbnail = m.ThumbnailKey != ""
+	return nil
+}
+
+// ReorderMediaRequest represents the request body for reordering the media of a
+// product. It lists every media ID of the product in the new order.
+type ReorderMediaRequest struct {
+	MediaIDs []uuid.UUID `json:"media_ids" binding:"required,min=1"`
+}
+
+// MediaUpload is a file uploaded for a product
+type MediaUpload struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
@@ -7,17 +7,24 @@ import (
 	"gorm.io/gorm"
 )
 
+// Product is a catalog item. AvailableToSell is computed from the stock of the
+// product and its variants in active warehouses and is not stored. Without its
+// own ReorderThreshold, the default threshold of the category applies.
 type Product struct {
-	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
-	Name        string         `json:"name" gorm:"type:varchar(255);not null"`
-	Description string         `json:"description" gorm:"type:text"`
-	Price       float64        `json:"price" gorm:"type:decimal(10,2);not null"`
-	StockLevel  int            `json:"stock_level" gorm:"not null"`
-	CategoryID  uuid.UUID      `json:"category_id" gorm:"type:uuid;not null"`
-	Category    Category       `json:"category" gorm:"foreignKey:CategoryID"`
-	CreatedAt   time.Time      `json:"created_at" gorm:"not null;default:now()"`
-	UpdatedAt   time.Time      `json:"updated_at" gorm:"not null;default:now()"`
-	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+	ID               uuid.UUID        `json:"id" gorm:"type:uuid;primary_key"`
+	Name             string           `json:"name" gorm:"type:varchar(255);not null"`
+	Description      string           `json:"description" gorm:"type:text"`
+	Price            float64          `json:"price" gorm:"type:decimal(10,2);not null"`
+	StockLevel       int              `json:"stock_level" gorm:"not null"`
+	ReorderThreshold *int             `json:"reorder_threshold,omitempty"`
+	CategoryID       uuid.UUID        `json:"category_id" gorm:"type:uuid;not null"`
+	Category         Category         `json:"category" gorm:"foreignKey:CategoryID"`
+	Variants         []ProductVariant `json:"variants,omitempty" gorm:"foreignKey:ProductID"`
+	Media            []ProductMedia   `json:"media,omitempty" gorm:"foreignKey:ProductID"`
+	AvailableToSell  int              `json:"available_to_sell" gorm:"-"`
+	CreatedAt        time.Time        `json:"created_at" gorm:"not null;default:now()"`
+	UpdatedAt        time.Time        `json:"updated_at" gorm:"not null;default:now()"`
+	DeletedAt        gorm.DeletedAt   `json:"-" gorm:"index"`
 }
 
 func (p *Product) BeforeCreate(tx *gorm.DB) error {
@@ -27,20 +34,95 @@ func (p *Product) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// OnHand returns the stock level of the product together with that of its variants
+func (p *Product) OnHand() int {
+	onHand := p.StockLevel
+	for _, variant := range p.Variants {
+		onHand += variant.StockLevel
+	}
+	return onHand
+}
+
+// EffectiveReorderThreshold returns the reorder threshold of the product or, without
+// one, the default of its category. It is nil when neither is set.
+func (p *Product) EffectiveReorderThreshold() *int {
+	if p.ReorderThreshold != nil {
+		return p.ReorderThreshold
+	}
+	return p.Category.DefaultReorderThreshold
+}
+
+// IsLowStock reports whether the given stock is below the reorder threshold of the product
+func (p *Product) IsLowStock(onHand int) bool {
+	threshold := p.EffectiveReorderThreshold()
+	return threshold != nil && onHand < *threshold
+}
+
 // CreateProductRequest represents the request body for creating a new product
 type CreateProductRequest struct {
-	Name        string    `json:"name" binding:"required"`
-	Description string    `json:"description"`
-	Price       float64   `json:"price" binding:"required,gt=0"`
-	StockLevel  int       `json:"stock_level" binding:"required,gte=0"`
-	CategoryID  uuid.UUID `json:"category_id" binding:"required"`
+	Name             string    `json:"name" binding:"required"`
+	Description      string    `json:"description"`
+	Price            float64   `json:"price" binding:"required,gt=0"`
+	StockLevel       int       `json:"stock_level" binding:"required,gte=0"`
+	CategoryID       uuid.UUID `json:"category_id" binding:"required"`
+	ReorderThreshold *int      `json:"reorder_threshold" binding:"omitempty,gte=0"`
 }
 
-// UpdateProductRequest represents the request body for updating a product
+// UpdateProductRequest represents the request body for updating a product.
+// ClearReorderThreshold removes the threshold so the category default applies again.
+// StockLevel is only read to reject it, since stock changes need a reason in the ledger.
 type UpdateProductRequest struct {
-	Name        *string    `json:"name"`
-	Description *string    `json:"description"`
-	Price       *float64   `json:"price" binding:"omitempty,gt=0"`
-	StockLevel  *int       `json:"stock_level" binding:"omitempty,gte=0"`
-	CategoryID  *uuid.UUID `json:"category_id"`
+	Name                  *string    `json:"name"`
+	Description           *string    `json:"description"`
+	Price                 *float64   `json:"price" binding:"omitempty,gt=0"`
+	StockLevel            *int       `json:"stock_level" binding:"omitempty,gte=0"`
+	CategoryID            *uuid.UUID `json:"category_id"`
+	ReorderThreshold      *int       `json:"reorder_threshold" binding:"omitempty,gte=0"`
+	ClearReorderThreshold bool       `json:"clear_reorder_threshold"`
+}
+
+// ProductSort orders a product listing. Without one, search results are ordered by relevance.
+type ProductSort string
+
+const (
+	ProductSortPriceAsc  ProductSort = "price_asc"
+	ProductSortPriceDesc ProductSort = "price_desc"
+	ProductSortNameAsc   ProductSort = "name_asc"
+	ProductSortNameDesc  ProductSort = "name_desc"
+	ProductSortNewest    ProductSort = "newest"
+	ProductSortStockAsc  ProductSort = "stock_asc"
+	ProductSortStockDesc ProductSort = "stock_desc"
+)
+
+// Valid reports whether the sort is empty or one of the known orders
+func (s ProductSort) Valid() bool {
+	switch s {
+	case "", ProductSortPriceAsc, ProductSortPriceDesc, ProductSortNameAsc, ProductSortNameDesc,
+		ProductSortNewest, ProductSortStockAsc, ProductSortStockDesc:
+		return true
+	}
+	return false
+}
+
+// ProductFacets counts the products of a listing per category and per price range.
+// Each facet ignores its own filter, so the counts show what choosing another
+// category or price range would return.
+type ProductFacets struct {
+	Categories  []CategoryFacet   `json:"categories"`
+	PriceRanges []PriceRangeFacet `json:"price_ranges"`
+}
+
+// CategoryFacet is the number of matching products in a category
+type CategoryFacet struct {
+	CategoryID uuid.UUID `json:"category_id"`
+	Name       string    `json:"name"`
+	Count      int64     `json:"count"`
+}
+
+// PriceRangeFacet is the number of matching products priced from Min up to, but
+// not including, Max. The highest range has no Max.
+type PriceRangeFacet struct {
+	Min   float64  `json:"min"`
+	Max   *float64 `json:"max,omitempty"`
+	Count int64    `json:"count"`
 }
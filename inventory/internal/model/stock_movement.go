@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StockMovementReason explains why the stock level of a product or variant changed
+type StockMovementReason string
+
+const (
+	StockMovementSale       StockMovementReason = "sale"
+	StockMovementReturn     StockMovementReason = "return"
+	StockMovementAdjustment StockMovementReason = "adjustment"
+	StockMovementRestock    StockMovementReason = "restock"
+	StockMovementTransfer   StockMovementReason = "transfer"
+)
+
+// StockMovementActorSystem is recorded as the actor of changes made without a known user
+const StockMovementActorSystem = "system"
+
+// StockMovement is an immutable ledger entry for a change of the stock level of a
+// product, or of one of its variants. BalanceAfter is the stock level after the change.
+// Movements of stock held in a warehouse name it in WarehouseID and change the
+// warehouse quantity by the same delta.
+type StockMovement struct {
+	ID           uuid.UUID           `json:"id" gorm:"type:uuid;primary_key"`
+	ProductID    uuid.UUID           `json:"product_id" gorm:"type:uuid;not null;index:idx_stock_movements_product,priority:1"`
+	VariantID    *uuid.UUID          `json:"variant_id,omitempty" gorm:"type:uuid;index"`
+	WarehouseID  *uuid.UUID          `json:"warehouse_id,omitempty" gorm:"type:uuid;index"`
+	Delta        int                 `json:"delta" gorm:"not null"`
+	BalanceAfter int                 `json:"balance_after" gorm:"not null"`
+	Reason       StockMovementReason `json:"reason" gorm:"type:varchar(20);not null"`
+	Actor        string              `json:"actor" gorm:"type:varchar(128);not null"`
+	OrderID      *uuid.UUID          `json:"order_id,omitempty" gorm:"type:uuid;index"`
+	Note         string              `json:"note,omitempty" gorm:"type:text"`
+	CreatedAt    time.Time           `json:"created_at" gorm:"not null;default:now();index:idx_stock_movements_product,priority:2"`
+}
+
+func (m *StockMovement) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// Valid reports whether the delta goes in the direction the reason implies.
+// Sales remove stock, returns and restocks add it and adjustments may do either.
+// Transfers move stock into or out of a warehouse.
+func (m *StockMovement) Valid() bool {
+	switch m.Reason {
+	case StockMovementSale:
+		return m.Delta < 0
+	case StockMovementReturn, StockMovementRestock:
+		return m.Delta > 0
+	case StockMovementAdjustment:
+		return m.Delta != 0
+	case StockMovementTransfer:
+		return m.Delta != 0 && m.WarehouseID != nil
+	default:
+		return false
+	}
+}
+
+// AdjustStockRequest represents the request body for changing the stock level of a product or variant.
+// With a WarehouseID the change is booked against the stock held in that warehouse.
+type AdjustStockRequest struct {
+	VariantID   *uuid.UUID          `json:"variant_id"`
+	WarehouseID *uuid.UUID          `json:"warehouse_id"`
+	Delta       int                 `json:"delta" binding:"required"`
+	Reason      StockMovementReason `json:"reason" binding:"required,oneof=sale return adjustment restock"`
+	OrderID     *uuid.UUID          `json:"order_id"`
+	Note        string              `json:"note"`
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// VariantOptions holds the option values of a variant, e.g. size and colour, in JSONB format
+type VariantOptions map[string]string
+
+// Value implements the driver.Valuer interface
+func (o VariantOptions) Value() (driver.Value, error) {
+	if len(o) == 0 {
+		return "{}", nil
+	}
+	return json.Marshal(o)
+}
+
+// Scan implements the sql.Scanner interface
+func (o *VariantOptions) Scan(value interface{}) error {
+	if value == nil {
+		*o = VariantOptions{}
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, o)
+}
+
+// ProductVariant is a purchasable version of a product with its own SKU and stock.
+// A nil Price means the variant is sold at the price of its product. AvailableToSell
+// is computed from the stock of the variant in active warehouses.
+type ProductVariant struct {
+	ID              uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	ProductID       uuid.UUID      `json:"product_id" gorm:"type:uuid;not null;index"`
+	SKU             string         `json:"sku" gorm:"type:varchar(64);not null;uniqueIndex:idx_product_variants_sku,where:deleted_at IS NULL"`
+	Options         VariantOptions `json:"options" gorm:"type:jsonb;not null;default:'{}'"`
+	Price           *float64       `json:"price,omitempty" gorm:"type:decimal(10,2)"`
+	StockLevel      int            `json:"stock_level" gorm:"not null"`
+	AvailableToSell int            `json:"available_to_sell" gorm:"-"`
+	CreatedAt       time.Time      `json:"created_at" gorm:"not null;default:now()"`
+	UpdatedAt       time.Time      `json:"updated_at" gorm:"not null;default:now()"`
+	DeletedAt       gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (v *ProductVariant) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
+
+// EffectivePrice returns the price override of the variant or, without one, the product price
+func (v *ProductVariant) EffectivePrice(productPrice float64) float64 {
+	if v.Price != nil {
+		return *v.Price
+	}
+	return productPrice
+}
+
+// CreateVariantRequest represents the request body for adding a variant to a product
+type CreateVariantRequest struct {
+	SKU        string            `json:"sku" binding:"required,max=64"`
+	Options    map[string]string `json:"options"`
+	Price      *float64          `json:"price" binding:"omitempty,gt=0"`
+	StockLevel int               `json:"stock_level" binding:"gte=0"`
+}
+
+// UpdateVariantRequest represents the request body for updating a variant.
+// ClearPrice removes the price override so the product price applies again.
+// StockLevel is rejected like in UpdateProductRequest.
+type UpdateVariantRequest struct {
+	SKU        *string           `json:"sku" binding:"omitempty,min=1,max=64"`
+	Options    map[string]string `json:"options"`
+	Price      *float64          `json:"price" binding:"omitempty,gt=0"`
+	ClearPrice bool              `json:"clear_price"`
+	StockLevel *int              `json:"stock_level" binding:"omitempty,gte=0"`
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Warehouse is a stock location. Stock in inactive warehouses is not available to sell.
+type Warehouse struct {
+	ID        uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	Code      string         `json:"code" gorm:"type:varchar(32);not null;uniqueIndex:idx_warehouses_code,where:deleted_at IS NULL"`
+	Name      string         `json:"name" gorm:"type:varchar(255);not null"`
+	Address   string         `json:"address" gorm:"type:text"`
+	IsActive  bool           `json:"is_active" gorm:"not null;default:true"`
+	CreatedAt time.Time      `json:"created_at" gorm:"not null;default:now()"`
+	UpdatedAt time.Time      `json:"updated_at" gorm:"not null;default:now()"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (w *Warehouse) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.New()
+	}
+	return nil
+}
+
+// WarehouseStock is the quantity of a product, or of one of its variants, held in a warehouse.
+// Stock of products without variants has no VariantID. Quantities only change through
+// the stock ledger, so the stock level of the product or variant includes them.
+type WarehouseStock struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	WarehouseID uuid.UUID  `json:"warehouse_id" gorm:"type:uuid;not null;uniqueIndex:idx_warehouse_stocks_product,where:variant_id IS NULL;uniqueIndex:idx_warehouse_stocks_variant,where:variant_id IS NOT NULL"`
+	ProductID   uuid.UUID  `json:"product_id" gorm:"type:uuid;not null;index;uniqueIndex:idx_warehouse_stocks_product,where:variant_id IS NULL"`
+	VariantID   *uuid.UUID `json:"variant_id,omitempty" gorm:"type:uuid;uniqueIndex:idx_warehouse_stocks_variant,where:variant_id IS NOT NULL"`
+	Quantity    int        `json:"quantity" gorm:"not null;default:0"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"not null;default:now()"`
+	UpdatedAt   time.Time  `json:"updated_at" gorm:"not null;default:now()"`
+}
+
+func (s *WarehouseStock) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// StockTransfer records stock moved from one warehouse to another
+type StockTransfer struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	FromWarehouseID uuid.UUID  `json:"from_warehouse_id" gorm:"type:uuid;not null;index"`
+	ToWarehouseID   uuid.UUID  `json:"to_warehouse_id" gorm:"type:uuid;not null;index"`
+	ProductID       uuid.UUID  `json:"product_id" gorm:"type:uuid;not null;index"`
+	VariantID       *uuid.UUID `json:"variant_id,omitempty" gorm:"type:uuid"`
+	Quantity        int        `json:"quantity" gorm:"not null"`
+	Note            string     `json:"note,omitempty" gorm:"type:text"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"not null;default:now()"`
+}
+
+func (t *StockTransfer) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// CreateWarehouseRequest represents the request body for creating a new warehouse
+type CreateWarehouseRequest struct {
+	Code    string `json:"code" binding:"required,max=32"`
+	Name    string `json:"name" binding:"required"`
+	Address string `json:"address"`
+}
+
+// UpdateWarehouseRequest represents the request body for updating a warehouse
+type UpdateWarehouseRequest struct {
+	Name     *string `json:"name" binding:"omitempty,min=1"`
+	Address  *string `json:"address"`
+	IsActive *bool   `json:"is_active"`
+}
+
+// SetStockRequest represents the request body for setting the stock of a product in a warehouse
+type SetStockRequest struct {
+	ProductID uuid.UUID  `json:"product_id" binding:"required"`
+	VariantID *uuid.UUID `json:"variant_id"`
+	Quantity  int        `json:"quantity" binding:"gte=0"`
+}
+
+// TransferStockRequest represents the request body for moving stock between warehouses
+type TransferStockRequest struct {
+	FromWarehouseID uuid.UUID  `json:"from_warehouse_id" binding:"required"`
+	ToWarehouseID   uuid.UUID  `json:"to_warehouse_id" binding:"required"`
+	ProductID       uuid.UUID  `json:"product_id" binding:"required"`
+	VariantID       *uuid.UUID `json:"variant_id"`
+	Quantity        int        `json:"quantity" binding:"required,gt=0"`
+	Note            string     `json:"note"`
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/cache"
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+)
+
+// CachedCatalogRepository implements CatalogRepository and clears the product cache
+// after an import commits, since an import may touch any number of products
+type CachedCatalogRepository struct {
+	repo  CatalogRepository
+	cache cache.ProductCache
+}
+
+// NewCachedCatalogRepository creates a new cached catalog repository
+func NewCachedCatalogRepository(repo CatalogRepository, cache cache.ProductCache) CatalogRepository {
+	return &CachedCatalogRepository{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Transaction runs fn in a transaction and clears the product cache once it commits
+func (r *CachedCatalogRepository) Transaction(ctx context.Context, fn func(repo CatalogRepository) error) error {
+	if err := r.repo.Transaction(ctx, fn); err != nil {
+		return err
+	}
+
+	r.cache.Clear()
+	return nil
+}
+
+// FindCategoryByName retrieves a category by name
+func (r *CachedCatalogRepository) FindCategoryByName(ctx context.Context, name string) (*model.Category, error) {
+	return r.repo.FindCategoryByName(ctx, name)
+}
+
+// FindProductsByName retrieves the products with the name
+func (r *CachedCatalogRepository) FindProductsByName(ctx context.Context, name string) ([]model.Product, error) {
+	return r.repo.FindProductsByName(ctx, name)
+}
+
+// FindDiscountsByName retrieves the discounts with the name
+func (r *CachedCatalogRepository) FindDiscountsByName(ctx context.Context, name string) ([]model.Discount, error) {
+	return r.repo.FindDiscountsByName(ctx, name)
+}
+
+// FindVariantBySKU retrieves a variant by SKU
+func (r *CachedCatalogRepository) FindVariantBySKU(ctx context.Context, sku string) (*model.ProductVariant, error) {
+	return r.repo.FindVariantBySKU(ctx, sku)
+}
+
+// SaveCategory saves a category and clears the cached product lists, since category filters include descendants
+func (r *CachedCatalogRepository) SaveCategory(ctx context.Context, category *model.Category) error {
+	if err := r.repo.SaveCategory(ctx, category); err != nil {
+		return err
+	}
+
+	r.cache.ClearProductLists()
+	return nil
+}
+
+// SaveProduct saves a product and evicts it from the cache
+func (r *CachedCatalogRepository) SaveProduct(ctx context.Context, product *model.Product) error {
+	if err := r.repo.SaveProduct(ctx, product); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, product.ID)
+	return nil
+}
+
+// SaveVariant saves a variant and evicts its product from the cache, since variants are part of it
+func (r *CachedCatalogRepository) SaveVariant(ctx context.Context, variant *model.ProductVariant) error {
+	if err := r.repo.SaveVariant(ctx, variant); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, variant.ProductID)
+	return nil
+}
+
+// SetStockLevel sets a stock level and evicts the product from the cache
+func (r *CachedCatalogRepository) SetStockLevel(ctx context.Context, movement *model.StockMovement, level int) error {
+	if err := r.repo.SetStockLevel(ctx, movement, level); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, movement.ProductID)
+	return nil
+}
+
+// SaveDiscount saves a discount and clears the cached product lists, which include promotions
+func (r *CachedCatalogRepository) SaveDiscount(ctx context.Context, discount *model.Discount) error {
+	if err := r.repo.SaveDiscount(ctx, discount); err != nil {
+		return err
+	}
+
+	r.cache.ClearProductLists()
+	return nil
+}
+
+// ExportCategories passes all categories to fn in batches
+func (r *CachedCatalogRepository) ExportCategories(ctx context.Context, fn func(categories []model.Category) error) error {
+	return r.repo.ExportCategories(ctx, fn)
+}
+
+// ExportProducts passes all products to fn in batches
+func (r *CachedCatalogRepository) ExportProducts(ctx context.Context, fn func(products []model.Product) error) error {
+	return r.repo.ExportProducts(ctx, fn)
+}
+
+// ExportDiscounts passes all discounts to fn in batches
+func (r *CachedCatalogRepository) ExportDiscounts(ctx context.Context, fn func(discounts []model.Discount, productNames map[uuid.UUID]string) error) error {
+	return r.repo.ExportDiscounts(ctx, fn)
+}
+
+// ExportVariants passes all variants to fn in batches
+func (r *CachedCatalogRepository) ExportVariants(ctx context.Context, fn func(variants []model.ProductVariant, productNames map[uuid.UUID]string) error) error {
+	return r.repo.ExportVariants(ctx, fn)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/cache"
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+)
+
+// CachedCategoryRepository implements CategoryRepository and clears the cached
+// product lists when a category moves, since category filters include descendants
+type CachedCategoryRepository struct {
+	repo  CategoryRepository
+	cache cache.ProductCache
+}
+
+// NewCachedCategoryRepository creates a new cached category repository
+func NewCachedCategoryRepository(repo CategoryRepository, cache cache.ProductCache) CategoryRepository {
+	return &CachedCategoryRepository{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Create creates a new category
+func (r *CachedCategoryRepository) Create(ctx context.Context, category *model.Category) error {
+	return r.repo.Create(ctx, category)
+}
+
+// FindByID retrieves a category by ID
+func (r *CachedCategoryRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	return r.repo.FindByID(ctx, id)
+}
+
+// Update updates a category
+func (r *CachedCategoryRepository) Update(ctx context.Context, category *model.Category) error {
+	return r.repo.Update(ctx, category)
+}
+
+// Delete deletes a category
+func (r *CachedCategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.repo.Delete(ctx, id)
+}
+
+// FindAll retrieves all categories
+func (r *CachedCategoryRepository) FindAll(ctx context.Context) ([]model.Category, error) {
+	return r.repo.FindAll(ctx)
+}
+
+// HasProducts reports whether products belong to the category
+func (r *CachedCategoryRepository) HasProducts(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.repo.HasProducts(ctx, id)
+}
+
+// HasChildren reports whether the category has subcategories
+func (r *CachedCategoryRepository) HasChildren(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.repo.HasChildren(ctx, id)
+}
+
+// FindAncestors retrieves the path from the root down to the category
+func (r *CachedCategoryRepository) FindAncestors(ctx context.Context, id uuid.UUID) ([]model.Category, error) {
+	return r.repo.FindAncestors(ctx, id)
+}
+
+// Move moves a category with its subtree and clears the cached product lists
+func (r *CachedCategoryRepository) Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error {
+	if err := r.repo.Move(ctx, id, parentID); err != nil {
+		return err
+	}
+
+	r.cache.ClearProductLists()
+	return nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/cache"
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+)
+
+// CachedMediaRepository implements MediaRepository and evicts the parent
+// product and the product lists from the product cache whenever its media
+// changes, since cached products embed their media
+type CachedMediaRepository struct {
+	repo  MediaRepository
+	cache cache.ProductCache
+}
+
+// NewCachedMediaRepository creates a new cached media repository
+func NewCachedMediaRepository(repo MediaRepository, cache cache.ProductCache) MediaRepository {
+	return &CachedMediaRepository{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Create adds media to a product and evicts the product from the cache
+func (r *CachedMediaRepository) Create(ctx context.Context, media *model.ProductMedia) error {
+	if err := r.repo.Create(ctx, media); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, media.ProductID)
+	return nil
+}
+
+// FindByID retrieves media by ID
+func (r *CachedMediaRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ProductMedia, error) {
+	return r.repo.FindByID(ctx, id)
+}
+
+// ListByProduct retrieves the media of a product
+func (r *CachedMediaRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductMedia, error) {
+	return r.repo.ListByProduct(ctx, productID)
+}
+
+// Reorder reorders the media of a product and evicts the product from the cache
+func (r *CachedMediaRepository) Reorder(ctx context.Context, productID uuid.UUID, mediaIDs []uuid.UUID) error {
+	if err := r.repo.Reorder(ctx, productID, mediaIDs); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, productID)
+	return nil
+}
+
+// Delete deletes media and evicts its product from the cache
+func (r *CachedMediaRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	media, err := r.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if media != nil {
+		evictProduct(r.cache, media.ProductID)
+	}
+	return nil
+}
+
+// DeleteByProduct deletes the media of a product and evicts the product from the cache
+func (r *CachedMediaRepository) DeleteByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductMedia, error) {
+	media, err := r.repo.DeleteByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	evictProduct(r.cache, productID)
+	return media, nil
+}
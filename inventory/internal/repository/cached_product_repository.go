@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
@@ -26,9 +27,9 @@ func NewCachedProductRepository(repo ProductRepository, cache cache.ProductCache
 }
 
 // Create creates a new product and adds it to the cache
-func (r *CachedProductRepository) Create(product *model.Product) error {
+func (r *CachedProductRepository) Create(ctx context.Context, product *model.Product, stock *model.StockMovement) error {
 	// Create the product in the database
-	err := r.repo.Create(product)
+	err := r.repo.Create(ctx, product, stock)
 	if err != nil {
 		return err
 	}
@@ -40,14 +41,14 @@ func (r *CachedProductRepository) Create(product *model.Product) error {
 }
 
 // FindByID retrieves a product by ID, using the cache if available
-func (r *CachedProductRepository) FindByID(id uuid.UUID) (*model.Product, error) {
+func (r *CachedProductRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Product, error) {
 	// Try to get the product from the cache
 	if product, found := r.cache.GetProduct(id); found {
 		return product, nil
 	}
 
 	// If not in cache, get from the database
-	product, err := r.repo.FindByID(id)
+	product, err := r.repo.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +61,38 @@ func (r *CachedProductRepository) FindByID(id uuid.UUID) (*model.Product, error)
 	return product, nil
 }
 
+// FindByIDs retrieves products by ID, reading from the database only the ones missing from the cache
+func (r *CachedProductRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Product, error) {
+	products := make([]model.Product, 0, len(ids))
+	var missing []uuid.UUID
+	for _, id := range ids {
+		if product, found := r.cache.GetProduct(id); found {
+			products = append(products, *product)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) == 0 {
+		return products, nil
+	}
+
+	found, err := r.repo.FindByIDs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range found {
+		r.cache.SetProduct(&found[i])
+	}
+
+	return append(products, found...), nil
+}
+
 // Update updates a product and updates it in the cache
-func (r *CachedProductRepository) Update(product *model.Product) error {
+func (r *CachedProductRepository) Update(ctx context.Context, product *model.Product) error {
 	// Update the product in the database
-	err := r.repo.Update(product)
+	err := r.repo.Update(ctx, product)
 	if err != nil {
 		return err
 	}
@@ -75,9 +104,9 @@ func (r *CachedProductRepository) Update(product *model.Product) error {
 }
 
 // Delete deletes a product and removes it from the cache
-func (r *CachedProductRepository) Delete(id uuid.UUID) error {
+func (r *CachedProductRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	// Delete the product from the database
-	err := r.repo.Delete(id)
+	err := r.repo.Delete(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -88,13 +117,19 @@ func (r *CachedProductRepository) Delete(id uuid.UUID) error {
 	return nil
 }
 
-// List retrieves a list of products, using the cache if available
-func (r *CachedProductRepository) List(params ListProductParams) ([]model.Product, int64, error) {
+// List retrieves a list of products, using the cache if available. Listings of
+// products on promotion are not cached, since discounts start and end without
+// evicting the product cache.
+func (r *CachedProductRepository) List(ctx context.Context, params ListProductParams) ([]model.Product, int64, error) {
+	if params.OnPromotion {
+		return r.repo.List(ctx, params)
+	}
+
 	// Generate a cache key based on the params
 	cacheKey, err := generateCacheKey(params)
 	if err != nil {
 		// If we can't generate a cache key, just use the repository directly
-		return r.repo.List(params)
+		return r.repo.List(ctx, params)
 	}
 
 	// Try to get the product list from the cache
@@ -103,7 +138,7 @@ func (r *CachedProductRepository) List(params ListProductParams) ([]model.Produc
 	}
 
 	// If not in cache, get from the database
-	products, total, err := r.repo.List(params)
+	products, total, err := r.repo.List(ctx, params)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -114,13 +149,29 @@ func (r *CachedProductRepository) List(params ListProductParams) ([]model.Produc
 	return products, total, nil
 }
 
+// ListLowStock retrieves the products below their reorder threshold. The listing is
+// not cached, since category thresholds affect it and do not evict the product cache.
+func (r *CachedProductRepository) ListLowStock(ctx context.Context, page, pageSize int) ([]model.Product, int64, error) {
+	return r.repo.ListLowStock(ctx, page, pageSize)
+}
+
+// ListFacets counts the products of a listing per category and price range. Facets are not cached.
+func (r *CachedProductRepository) ListFacets(ctx context.Context, params ListProductParams) (*model.ProductFacets, error) {
+	return r.repo.ListFacets(ctx, params)
+}
+
+// ListAfter retrieves the page of products following a cursor. Cursor pages are not cached.
+func (r *CachedProductRepository) ListAfter(ctx context.Context, params ListProductParams, cursor string) ([]model.Product, string, error) {
+	return r.repo.ListAfter(ctx, params, cursor)
+}
+
 // RefreshCache refreshes the cache with all products
 func (r *CachedProductRepository) RefreshCache() error {
 	// Clear the cache
 	r.cache.Clear()
 
 	// Get all products
-	products, total, err := r.repo.List(ListProductParams{
+	products, total, err := r.repo.List(context.Background(), ListProductParams{
 		Page:     1,
 		PageSize: 1000, // Use a large page size to get all products
 	})
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/cache"
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+)
+
+// CachedStockMovementRepository implements StockMovementRepository and evicts the
+// product and the product lists from the product cache whenever a stock level changes
+type CachedStockMovementRepository struct {
+	repo  StockMovementRepository
+	cache cache.ProductCache
+}
+
+// NewCachedStockMovementRepository creates a new cached stock movement repository
+func NewCachedStockMovementRepository(repo StockMovementRepository, cache cache.ProductCache) StockMovementRepository {
+	return &CachedStockMovementRepository{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Adjust changes a stock level and evicts the product from the cache
+func (r *CachedStockMovementRepository) Adjust(ctx context.Context, movement *model.StockMovement) error {
+	if err := r.repo.Adjust(ctx, movement); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, movement.ProductID)
+	return nil
+}
+
+// SetLevel sets a stock level and evicts the product from the cache
+func (r *CachedStockMovementRepository) SetLevel(ctx context.Context, movement *model.StockMovement, level int) error {
+	if err := r.repo.SetLevel(ctx, movement, level); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, movement.ProductID)
+	return nil
+}
+
+// ListByProduct retrieves the stock movements of a product
+func (r *CachedStockMovementRepository) ListByProduct(ctx context.Context, params ListStockMovementParams) ([]model.StockMovement, int64, error) {
+	return r.repo.ListByProduct(ctx, params)
+}
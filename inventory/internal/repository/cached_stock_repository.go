@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/cache"
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+)
+
+// CachedStockRepository implements StockRepository and evicts the stocked
+// product and the product lists from the product cache whenever stock changes,
+// since cached products carry their available-to-sell quantity
+type CachedStockRepository struct {
+	repo  StockRepository
+	cache cache.ProductCache
+}
+
+// NewCachedStockRepository creates a new cached stock repository
+func NewCachedStockRepository(repo StockRepository, cache cache.ProductCache) StockRepository {
+	return &CachedStockRepository{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// FindStock retrieves the stock of a product or variant in a warehouse
+func (r *CachedStockRepository) FindStock(ctx context.Context, warehouseID, productID uuid.UUID, variantID *uuid.UUID) (*model.WarehouseStock, error) {
+	return r.repo.FindStock(ctx, warehouseID, productID, variantID)
+}
+
+// ListByWarehouse retrieves the stock held in a warehouse
+func (r *CachedStockRepository) ListByWarehouse(ctx context.Context, warehouseID uuid.UUID) ([]model.WarehouseStock, error) {
+	return r.repo.ListByWarehouse(ctx, warehouseID)
+}
+
+// ListByProduct retrieves the stock of a product in every warehouse
+func (r *CachedStockRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.WarehouseStock, error) {
+	return r.repo.ListByProduct(ctx, productID)
+}
+
+// SetQuantity sets the stock of a product or variant and evicts the product from the cache
+func (r *CachedStockRepository) SetQuantity(ctx context.Context, movement *model.StockMovement, quantity int) error {
+	if err := r.repo.SetQuantity(ctx, movement, quantity); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, movement.ProductID)
+	return nil
+}
+
+// Transfer moves stock between warehouses and evicts the product from the cache
+func (r *CachedStockRepository) Transfer(ctx context.Context, transfer *model.StockTransfer, withdrawal, deposit *model.StockMovement) error {
+	if err := r.repo.Transfer(ctx, transfer, withdrawal, deposit); err != nil {
+		return err
+	}
+
+	// The available quantity changes when stock moves into or out of an inactive warehouse
+	evictProduct(r.cache, transfer.ProductID)
+	return nil
+}
+
+// ListTransfers retrieves the transfers of a warehouse
+func (r *CachedStockRepository) ListTransfers(ctx context.Context, warehouseID uuid.UUID) ([]model.StockTransfer, error) {
+	return r.repo.ListTransfers(ctx, warehouseID)
+}
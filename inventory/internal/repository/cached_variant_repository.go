@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/cache"
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+)
+
+// CachedVariantRepository implements VariantRepository and evicts the parent
+// product and the product lists from the product cache whenever a variant
+// changes, since cached products embed their variants
+type CachedVariantRepository struct {
+	repo  VariantRepository
+	cache cache.ProductCache
+}
+
+// NewCachedVariantRepository creates a new cached variant repository
+func NewCachedVariantRepository(repo VariantRepository, cache cache.ProductCache) VariantRepository {
+	return &CachedVariantRepository{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Create creates a new variant and evicts its product from the cache
+func (r *CachedVariantRepository) Create(ctx context.Context, variant *model.ProductVariant, stock *model.StockMovement) error {
+	if err := r.repo.Create(ctx, variant, stock); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, variant.ProductID)
+	return nil
+}
+
+// FindByID retrieves a variant by ID
+func (r *CachedVariantRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ProductVariant, error) {
+	return r.repo.FindByID(ctx, id)
+}
+
+// FindBySKU retrieves a variant by SKU
+func (r *CachedVariantRepository) FindBySKU(ctx context.Context, sku string) (*model.ProductVariant, error) {
+	return r.repo.FindBySKU(ctx, sku)
+}
+
+// ListByProduct retrieves the variants of a product
+func (r *CachedVariantRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductVariant, error) {
+	return r.repo.ListByProduct(ctx, productID)
+}
+
+// Update updates a variant and evicts its product from the cache
+func (r *CachedVariantRepository) Update(ctx context.Context, variant *model.ProductVariant) error {
+	if err := r.repo.Update(ctx, variant); err != nil {
+		return err
+	}
+
+	evictProduct(r.cache, variant.ProductID)
+	return nil
+}
+
+// Delete deletes a variant and evicts its product from the cache
+func (r *CachedVariantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	variant, err := r.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if variant != nil {
+		evictProduct(r.cache, variant.ProductID)
+	}
+	return nil
+}
+
+// evictProduct removes a product and every product list, which may include it, from the cache
+func evictProduct(productCache cache.ProductCache, productID uuid.UUID) {
+	productCache.DeleteProduct(productID)
+	productCache.ClearProductLists()
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/cache"
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+)
+
+// CachedWarehouseRepository implements WarehouseRepository and clears the
+// product cache whenever a warehouse changes, since activating or deactivating
+// a warehouse changes the available-to-sell quantity of every product it stocks
+type CachedWarehouseRepository struct {
+	repo  WarehouseRepository
+	cache cache.ProductCache
+}
+
+// NewCachedWarehouseRepository creates a new cached warehouse repository
+func NewCachedWarehouseRepository(repo WarehouseRepository, cache cache.ProductCache) WarehouseRepository {
+	return &CachedWarehouseRepository{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Create creates a new warehouse
+func (r *CachedWarehouseRepository) Create(ctx context.Context, warehouse *model.Warehouse) error {
+	return r.repo.Create(ctx, warehouse)
+}
+
+// FindByID retrieves a warehouse by ID
+func (r *CachedWarehouseRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Warehouse, error) {
+	return r.repo.FindByID(ctx, id)
+}
+
+// FindByCode retrieves a warehouse by code
+func (r *CachedWarehouseRepository) FindByCode(ctx context.Context, code string) (*model.Warehouse, error) {
+	return r.repo.FindByCode(ctx, code)
+}
+
+// FindAll retrieves all warehouses
+func (r *CachedWarehouseRepository) FindAll(ctx context.Context) ([]model.Warehouse, error) {
+	return r.repo.FindAll(ctx)
+}
+
+// Update updates a warehouse and clears the product cache
+func (r *CachedWarehouseRepository) Update(ctx context.Context, warehouse *model.Warehouse) error {
+	if err := r.repo.Update(ctx, warehouse); err != nil {
+		return err
+	}
+
+	r.cache.Clear()
+	return nil
+}
+
+// Delete deletes a warehouse and clears the product cache
+func (r *CachedWarehouseRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.cache.Clear()
+	return nil
+}
+
+// HasStock reports whether any product is stocked in the warehouse
+func (r *CachedWarehouseRepository) HasStock(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.repo.HasStock(ctx, id)
+}
@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// exportBatchSize is the number of records read at a time while exporting
+const exportBatchSize = 500
+
+// CatalogRepository reads and writes categories, products, variants and discounts in bulk.
+// Imports run inside Transaction; nesting Transaction opens a savepoint, so a
+// single row can be rolled back without losing the rest.
+type CatalogRepository interface {
+	Transaction(ctx context.Context, fn func(repo CatalogRepository) error) error
+	FindCategoryByName(ctx context.Context, name string) (*model.Category, error)
+	FindProductsByName(ctx context.Context, name string) ([]model.Product, error)
+	FindDiscountsByName(ctx context.Context, name string) ([]model.Discount, error)
+	FindVariantBySKU(ctx context.Context, sku string) (*model.ProductVariant, error)
+	SaveCategory(ctx context.Context, category *model.Category) error
+	SaveProduct(ctx context.Context, product *model.Product) error
+	SaveVariant(ctx context.Context, variant *model.ProductVariant) error
+	SetStockLevel(ctx context.Context, movement *model.StockMovement, level int) error
+	SaveDiscount(ctx context.Context, discount *model.Discount) error
+	ExportCategories(ctx context.Context, fn func(categories []model.Category) error) error
+	ExportProducts(ctx context.Context, fn func(products []model.Product) error) error
+	ExportDiscounts(ctx context.Context, fn func(discounts []model.Discount, productNames map[uuid.UUID]string) error) error
+	ExportVariants(ctx context.Context, fn func(variants []model.ProductVariant, productNames map[uuid.UUID]string) error) error
+}
+
+type catalogRepository struct {
+	db *gorm.DB
+}
+
+func NewCatalogRepository(db *gorm.DB) CatalogRepository {
+	return &catalogRepository{db: db}
+}
+
+// Transaction runs fn on a repository bound to a transaction, which is committed
+// when fn returns nil and rolled back otherwise
+func (r *catalogRepository) Transaction(ctx context.Context, fn func(repo CatalogRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&catalogRepository{db: tx})
+	})
+}
+
+func (r *catalogRepository) FindCategoryByName(ctx context.Context, name string) (*model.Category, error) {
+	var category model.Category
+
+	if err := r.db.WithContext(ctx).First(&category, "name = ?", name).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// FindProductsByName returns every product with the name, since product names are not unique
+func (r *catalogRepository) FindProductsByName(ctx context.Context, name string) ([]model.Product, error) {
+	var products []model.Product
+
+	if err := r.db.WithContext(ctx).Where("name = ?", name).Order("id").Find(&products).Error; err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// FindDiscountsByName returns every discount with the name, since discount names are not unique
+func (r *catalogRepository) FindDiscountsByName(ctx context.Context, name string) ([]model.Discount, error) {
+	var discounts []model.Discount
+
+	if err := r.db.WithContext(ctx).Where("name = ?", name).Order("id").Find(&discounts).Error; err != nil {
+		return nil, err
+	}
+
+	return discounts, nil
+}
+
+func (r *catalogRepository) FindVariantBySKU(ctx context.Context, sku string) (*model.ProductVariant, error) {
+	var variant model.ProductVariant
+
+	if err := r.db.WithContext(ctx).First(&variant, "sku = ?", sku).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &variant, nil
+}
+
+// SaveCategory creates a new category or updates an existing one, including its
+// parent. A new parent must not lie in the subtree of the category.
+func (r *catalogRepository) SaveCategory(ctx context.Context, category *model.Category) error {
+	db := r.db.WithContext(ctx)
+
+	if category.ID == uuid.Nil {
+		return db.Create(category).Error
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if category.ParentID != nil {
+			if err := lockCategoryTree(tx); err != nil {
+				return err
+			}
+
+			if err := checkCategoryCycle(tx, category.ID, *category.ParentID); err != nil {
+				return err
+			}
+		}
+
+		return tx.Omit(clause.Associations).Save(category).Error
+	})
+}
+
+// SaveProduct creates a new product or updates an existing one. Stock levels are
+// written through SetStockLevel, so new products start without stock.
+func (r *catalogRepository) SaveProduct(ctx context.Context, product *model.Product) error {
+	db := r.db.WithContext(ctx).Omit(clause.Associations)
+
+	if product.ID == uuid.Nil {
+		product.StockLevel = 0
+		return db.Create(product).Error
+	}
+
+	return db.Omit("StockLevel").Save(product).Error
+}
+
+// SaveVariant creates a new variant or updates an existing one. Like products, new
+// variants start without stock.
+func (r *catalogRepository) SaveVariant(ctx context.Context, variant *model.ProductVariant) error {
+	db := r.db.WithContext(ctx)
+
+	if variant.ID == uuid.Nil {
+		variant.StockLevel = 0
+		return db.Create(variant).Error
+	}
+
+	return db.Omit("StockLevel").Save(variant).Error
+}
+
+// SetStockLevel sets the stock level of a product or variant through the stock ledger
+func (r *catalogRepository) SetStockLevel(ctx context.Context, movement *model.StockMovement, level int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return applyStockMovement(tx, movement, func(int) int {
+			return level
+		})
+	})
+}
+
+// SaveDiscount creates a new discount or updates an existing one
+func (r *catalogRepository) SaveDiscount(ctx context.Context, discount *model.Discount) error {
+	db := r.db.WithContext(ctx)
+
+	if discount.ID == uuid.Nil {
+		return db.Create(discount).Error
+	}
+
+	return db.Save(discount).Error
+}
+
+// ExportCategories passes all categories to fn in batches, ordered by name
+func (r *catalogRepository) ExportCategories(ctx context.Context, fn func(categories []model.Category) error) error {
+	return r.snapshot(ctx, func(tx *gorm.DB) error {
+		var batch []model.Category
+		return exportInBatches(tx.Order("name, id"), &batch, func() error {
+			return fn(batch)
+		})
+	})
+}
+
+// ExportProducts passes all products with their category to fn in batches, ordered by name
+func (r *catalogRepository) ExportProducts(ctx context.Context, fn func(products []model.Product) error) error {
+	return r.snapshot(ctx, func(tx *gorm.DB) error {
+		var batch []model.Product
+		return exportInBatches(tx.Preload("Category").Order("name, id"), &batch, func() error {
+			return fn(batch)
+		})
+	})
+}
+
+// ExportDiscounts passes all discounts to fn in batches, ordered by name, together
+// with the names of the products the batch applies to
+func (r *catalogRepository) ExportDiscounts(ctx context.Context, fn func(discounts []model.Discount, productNames map[uuid.UUID]string) error) error {
+	return r.snapshot(ctx, func(tx *gorm.DB) error {
+		var batch []model.Discount
+		return exportInBatches(tx.Order("name, id"), &batch, func() error {
+			var ids []uuid.UUID
+			for _, discount := range batch {
+				ids = append(ids, discount.ApplicableProducts...)
+			}
+
+			names := make(map[uuid.UUID]string, len(ids))
+			if len(ids) > 0 {
+				var products []model.Product
+				if err := tx.Select("id", "name").Where("id IN ?", ids).Find(&products).Error; err != nil {
+					return err
+				}
+				for _, product := range products {
+					names[product.ID] = product.Name
+				}
+			}
+
+			return fn(batch, names)
+		})
+	})
+}
+
+// ExportVariants passes the variants of all products to fn in batches, ordered by
+// SKU, together with the names of their products
+func (r *catalogRepository) ExportVariants(ctx context.Context, fn func(variants []model.ProductVariant, productNames map[uuid.UUID]string) error) error {
+	return r.snapshot(ctx, func(tx *gorm.DB) error {
+		query := tx.Joins("JOIN products ON products.id = product_variants.product_id AND products.deleted_at IS NULL").
+			Order("product_variants.sku, product_variants.id")
+
+		var batch []model.ProductVariant
+		return exportInBatches(query, &batch, func() error {
+			ids := make([]uuid.UUID, 0, len(batch))
+			for _, variant := range batch {
+				ids = append(ids, variant.ProductID)
+			}
+
+			var products []model.Product
+			if err := tx.Select("id", "name").Where("id IN ?", ids).Find(&products).Error; err != nil {
+				return err
+			}
+
+			names := make(map[uuid.UUID]string, len(products))
+			for _, product := range products {
+				names[product.ID] = product.Name
+			}
+
+			return fn(batch, names)
+		})
+	})
+}
+
+// snapshot runs fn in a read-only transaction that sees the catalog as of its
+// start, so exports read in batches stay consistent
+func (r *catalogRepository) snapshot(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	return r.db.WithContext(ctx).Transaction(fn, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+}
+
+// exportInBatches pages through the ordered query into batch and calls fn for every page.
+// Offsets are safe here because the query runs in a snapshot.
+func exportInBatches[T any](query *gorm.DB, batch *[]T, fn func() error) error {
+	// A new session lets every page start from the same query
+	query = query.Session(&gorm.Session{})
+
+	for offset := 0; ; offset += exportBatchSize {
+		*batch = (*batch)[:0]
+		if err := query.Offset(offset).Limit(exportBatchSize).Find(batch).Error; err != nil {
+			return err
+		}
+
+		if len(*batch) == 0 {
+			return nil
+		}
+
+		if err := fn(); err != nil {
+			return err
+		}
+
+		if len(*batch) < exportBatchSize {
+			return nil
+		}
+	}
+}
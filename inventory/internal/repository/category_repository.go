@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
 	"github.com/google/uuid"
@@ -9,14 +11,24 @@ import (
 )
 
 type CategoryRepository interface {
-	Create(category *model.Category) error
-	FindByID(id uuid.UUID) (*model.Category, error)
-	Update(category *model.Category) error
-	Delete(id uuid.UUID) error
-	FindAll() ([]model.Category, error)
-	HasProducts(id uuid.UUID) (bool, error)
+	Create(ctx context.Context, category *model.Category) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Category, error)
+	Update(ctx context.Context, category *model.Category) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context) ([]model.Category, error)
+	HasProducts(ctx context.Context, id uuid.UUID) (bool, error)
+	HasChildren(ctx context.Context, id uuid.UUID) (bool, error)
+	FindAncestors(ctx context.Context, id uuid.UUID) ([]model.Category, error)
+	Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error
 }
 
+// categorySubtreeSQL selects the IDs of a category and all its descendants
+const categorySubtreeSQL = `WITH RECURSIVE subtree AS (
+	SELECT id FROM categories WHERE id = ? AND deleted_at IS NULL
+	UNION
+	SELECT categories.id FROM categories JOIN subtree ON categories.parent_id = subtree.id WHERE categories.deleted_at IS NULL
+) SELECT id FROM subtree`
+
 type categoryRepository struct {
 	db *gorm.DB
 }
@@ -25,14 +37,14 @@ func NewCategoryRepository(db *gorm.DB) CategoryRepository {
 	return &categoryRepository{db: db}
 }
 
-func (r *categoryRepository) Create(category *model.Category) error {
-	return r.db.Create(category).Error
+func (r *categoryRepository) Create(ctx context.Context, category *model.Category) error {
+	return r.db.WithContext(ctx).Create(category).Error
 }
 
-func (r *categoryRepository) FindByID(id uuid.UUID) (*model.Category, error) {
+func (r *categoryRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Category, error) {
 	var category model.Category
 
-	if err := r.db.First(&category, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&category, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -42,29 +54,111 @@ func (r *categoryRepository) FindByID(id uuid.UUID) (*model.Category, error) {
 	return &category, nil
 }
 
-func (r *categoryRepository) Update(category *model.Category) error {
-	return r.db.Save(category).Error
+func (r *categoryRepository) Update(ctx context.Context, category *model.Category) error {
+	// Categories change parents through Move only
+	return r.db.WithContext(ctx).Omit("ParentID").Save(category).Error
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.Category{}, "id = ?", id).Error
 }
 
-func (r *categoryRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&model.Category{}, "id = ?", id).Error
+func (r *categoryRepository) HasProducts(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.Product{}).Where("category_id = ?", id).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *categoryRepository) FindAll(ctx context.Context) ([]model.Category, error) {
+	var categories []model.Category
+
+	if err := r.db.WithContext(ctx).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
 }
 
-func (r *categoryRepository) HasProducts(id uuid.UUID) (bool, error) {
+func (r *categoryRepository) HasChildren(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.db.Model(&model.Product{}).Where("category_id = ?", id).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&model.Category{}).Where("parent_id = ?", id).Count(&count).Error; err != nil {
 		return false, err
 	}
 
 	return count > 0, nil
 }
 
-func (r *categoryRepository) FindAll() ([]model.Category, error) {
+// FindAncestors returns the path from the root down to the category, the category
+// included. It is empty when the category does not exist.
+func (r *categoryRepository) FindAncestors(ctx context.Context, id uuid.UUID) ([]model.Category, error) {
 	var categories []model.Category
 
-	if err := r.db.Find(&categories).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(`WITH RECURSIVE ancestors AS (
+		SELECT categories.*, 0 AS depth FROM categories WHERE id = ? AND deleted_at IS NULL
+		UNION ALL
+		SELECT categories.*, ancestors.depth + 1 FROM categories JOIN ancestors ON categories.id = ancestors.parent_id WHERE categories.deleted_at IS NULL
+	) SELECT * FROM ancestors ORDER BY depth DESC`, id).Scan(&categories).Error; err != nil {
 		return nil, err
 	}
 
 	return categories, nil
 }
+
+// Move moves a category with its subtree below another parent, or to the roots
+// without one. Moves are serialized so concurrent moves cannot form a cycle.
+func (r *categoryRepository) Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCategoryTree(tx); err != nil {
+			return err
+		}
+
+		var category model.Category
+		if err := tx.Select("id").First(&category, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New(model.ErrCategoryNotFound)
+			}
+			return err
+		}
+
+		if parentID != nil {
+			var parent model.Category
+			if err := tx.Select("id").First(&parent, "id = ?", *parentID).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return errors.New(model.ErrParentNotFound)
+				}
+				return err
+			}
+
+			if err := checkCategoryCycle(tx, id, *parentID); err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.Category{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"parent_id":  parentID,
+			"updated_at": time.Now(),
+		}).Error
+	})
+}
+
+// lockCategoryTree serializes changes to the parents of categories for the rest of
+// the transaction, so concurrent changes cannot form a cycle
+func lockCategoryTree(tx *gorm.DB) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtext('category_tree'))").Error
+}
+
+// checkCategoryCycle fails with ErrCategoryCycle if parentID is the category itself
+// or one of its descendants
+func checkCategoryCycle(tx *gorm.DB, id, parentID uuid.UUID) error {
+	var cycle bool
+	if err := tx.Raw("SELECT ? IN ("+categorySubtreeSQL+")", parentID, id).Scan(&cycle).Error; err != nil {
+		return err
+	}
+	if cycle {
+		return errors.New(model.ErrCategoryCycle)
+	}
+	return nil
+}
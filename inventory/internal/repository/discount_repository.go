@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
@@ -9,13 +10,13 @@ import (
 )
 
 type DiscountRepository interface {
-	Create(discount *model.Discount) error
-	FindByID(id uuid.UUID) (*model.Discount, error)
-	Update(discount *model.Discount) error
-	Delete(id uuid.UUID) error
-	FindAll() ([]model.Discount, error)
-	FindByProductID(productID uuid.UUID) ([]model.Discount, error)
-	FindAllWithProducts() ([]model.Discount, error)
+	Create(ctx context.Context, discount *model.Discount) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Discount, error)
+	Update(ctx context.Context, discount *model.Discount) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context) ([]model.Discount, error)
+	FindByProductID(ctx context.Context, productID uuid.UUID) ([]model.Discount, error)
+	FindAllWithProducts(ctx context.Context) ([]model.Discount, error)
 }
 
 type discountRepository struct {
@@ -26,14 +27,14 @@ func NewDiscountRepository(db *gorm.DB) DiscountRepository {
 	return &discountRepository{db: db}
 }
 
-func (r *discountRepository) Create(discount *model.Discount) error {
-	return r.db.Create(discount).Error
+func (r *discountRepository) Create(ctx context.Context, discount *model.Discount) error {
+	return r.db.WithContext(ctx).Create(discount).Error
 }
 
-func (r *discountRepository) FindByID(id uuid.UUID) (*model.Discount, error) {
+func (r *discountRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Discount, error) {
 	var discount model.Discount
 
-	if err := r.db.First(&discount, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&discount, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -43,28 +44,28 @@ func (r *discountRepository) FindByID(id uuid.UUID) (*model.Discount, error) {
 	return &discount, nil
 }
 
-func (r *discountRepository) Update(discount *model.Discount) error {
-	return r.db.Save(discount).Error
+func (r *discountRepository) Update(ctx context.Context, discount *model.Discount) error {
+	return r.db.WithContext(ctx).Save(discount).Error
 }
 
-func (r *discountRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&model.Discount{}, "id = ?", id).Error
+func (r *discountRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.Discount{}, "id = ?", id).Error
 }
 
-func (r *discountRepository) FindAll() ([]model.Discount, error) {
+func (r *discountRepository) FindAll(ctx context.Context) ([]model.Discount, error) {
 	var discounts []model.Discount
 
-	if err := r.db.Find(&discounts).Error; err != nil {
+	if err := r.db.WithContext(ctx).Find(&discounts).Error; err != nil {
 		return nil, err
 	}
 
 	return discounts, nil
 }
 
-func (r *discountRepository) FindByProductID(productID uuid.UUID) ([]model.Discount, error) {
+func (r *discountRepository) FindByProductID(ctx context.Context, productID uuid.UUID) ([]model.Discount, error) {
 	var discounts []model.Discount
 
-	if err := r.db.Where("? = ANY(applicable_products)", productID).
+	if err := r.db.WithContext(ctx).Where("? = ANY(applicable_products)", productID).
 		Or("applicable_products @> ?", []byte(uuid.Nil.String())).
 		Find(&discounts).Error; err != nil {
 		return nil, err
@@ -73,11 +74,11 @@ func (r *discountRepository) FindByProductID(productID uuid.UUID) ([]model.Disco
 	return discounts, nil
 }
 
-func (r *discountRepository) FindAllWithProducts() ([]model.Discount, error) {
+func (r *discountRepository) FindAllWithProducts(ctx context.Context) ([]model.Discount, error) {
 	var discounts []model.Discount
 
 	// Find active discounts with start_date <= current time <= end_date
-	if err := r.db.Where("is_active = ? AND start_date <= NOW() AND end_date >= NOW()", true).
+	if err := r.db.WithContext(ctx).Where("is_active = ? AND start_date <= NOW() AND end_date >= NOW()", true).
 		Find(&discounts).Error; err != nil {
 		return nil, err
 	}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MediaRepository interface {
+	Create(ctx context.Context, media *model.ProductMedia) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.ProductMedia, error)
+	ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductMedia, error)
+	Reorder(ctx context.Context, productID uuid.UUID, mediaIDs []uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	DeleteByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductMedia, error)
+}
+
+type mediaRepository struct {
+	db *gorm.DB
+}
+
+func NewMediaRepository(db *gorm.DB) MediaRepository {
+	return &mediaRepository{db: db}
+}
+
+// Create appends the media after the existing media of its product
+func (r *mediaRepository) Create(ctx context.Context, media *model.ProductMedia) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockProduct(tx, media.ProductID); err != nil {
+			return err
+		}
+
+		var last int
+		if err := tx.Model(&model.ProductMedia{}).Where("product_id = ?", media.ProductID).
+			Select("COALESCE(MAX(position), 0)").Scan(&last).Error; err != nil {
+			return err
+		}
+
+		media.Position = last + 1
+		return tx.Create(media).Error
+	})
+}
+
+func (r *mediaRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ProductMedia, error) {
+	var media model.ProductMedia
+
+	if err := r.db.WithContext(ctx).First(&media, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &media, nil
+}
+
+// ListByProduct returns the media of a product ordered by position
+func (r *mediaRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductMedia, error) {
+	var media []model.ProductMedia
+
+	if err := r.db.WithContext(ctx).Where("product_id = ?", productID).Order("position").Find(&media).Error; err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// Reorder moves the media of a product into the given order. The IDs must list
+// every media of the product exactly once.
+func (r *mediaRepository) Reorder(ctx context.Context, productID uuid.UUID, mediaIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockProduct(tx, productID); err != nil {
+			return err
+		}
+
+		var existing []uuid.UUID
+		if err := tx.Model(&model.ProductMedia{}).Where("product_id = ?", productID).Pluck("id", &existing).Error; err != nil {
+			return err
+		}
+
+		if len(existing) != len(mediaIDs) {
+			return errors.New(model.ErrInvalidMediaOrder)
+		}
+
+		remaining := make(map[uuid.UUID]bool, len(existing))
+		for _, id := range existing {
+			remaining[id] = true
+		}
+		for _, id := range mediaIDs {
+			if !remaining[id] {
+				return errors.New(model.ErrInvalidMediaOrder)
+			}
+			delete(remaining, id)
+		}
+
+		for i, id := range mediaIDs {
+			if err := tx.Model(&model.ProductMedia{}).Where("id = ?", id).Update("position", i+1).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Delete removes the media and closes the gap it leaves in the positions of its product
+func (r *mediaRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var media model.ProductMedia
+		if err := tx.First(&media, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if err := lockProduct(tx, media.ProductID); err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&model.ProductMedia{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.ProductMedia{}).
+			Where("product_id = ? AND position > ?", media.ProductID, media.Position).
+			Update("position", gorm.Expr("position - 1")).Error
+	})
+}
+
+// DeleteByProduct removes every media of a product and returns the removed media
+func (r *mediaRepository) DeleteByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductMedia, error) {
+	var media []model.ProductMedia
+
+	if err := r.db.WithContext(ctx).Clauses(clause.Returning{}).
+		Where("product_id = ?", productID).Delete(&media).Error; err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// lockProduct locks the row of a product for the rest of the transaction, so
+// concurrent changes to the positions of its media are serialized. Soft-deleted
+// products are locked as well, so their media can still be cleaned up.
+func lockProduct(tx *gorm.DB, productID uuid.UUID) error {
+	var product model.Product
+	if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").
+		First(&product, "id = ?", productID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New(model.ErrProductNotFound)
+		}
+		return err
+	}
+	return nil
+}
@@ -1,84 +1,141 @@
 package repository
 
 import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProductRepository interface {
-	Create(product *model.Product) error
-	FindByID(id uuid.UUID) (*model.Product, error)
-	Update(product *model.Product) error
-	Delete(id uuid.UUID) error
-	List(params ListProductParams) ([]model.Product, int64, error)
+	Create(ctx context.Context, product *model.Product, stock *model.StockMovement) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Product, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Product, error)
+	Update(ctx context.Context, product *model.Product) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, params ListProductParams) ([]model.Product, int64, error)
+	ListLowStock(ctx context.Context, page, pageSize int) ([]model.Product, int64, error)
+	ListFacets(ctx context.Context, params ListProductParams) (*model.ProductFacets, error)
+	ListAfter(ctx context.Context, params ListProductParams, cursor string) ([]model.Product, string, error)
 }
 
+// ListProductParams filters a product listing. CategoryID matches products in the
+// category or any of its descendants. SKU and Options match products with at least
+// one variant that has the SKU and all the option values; with either set, the
+// price range applies to the effective price of that variant.
+// Search matches products whose name or description contain words starting with
+// every term of it, best matches first unless Sort is set. InStock keeps products
+// with stock of their own or in a variant, OnPromotion those with an active discount.
 type ListProductParams struct {
-	CategoryID *uuid.UUID
-	MinPrice   *float64
-	MaxPrice   *float64
-	Search     *string
-	Page       int
-	PageSize   int
+	CategoryID  *uuid.UUID
+	MinPrice    *float64
+	MaxPrice    *float64
+	Search      *string
+	SKU         *string
+	Options     map[string]string
+	InStock     bool
+	OnPromotion bool
+	Sort        model.ProductSort
+	Page        int
+	PageSize    int
 }
 
 type productRepository struct {
-	db *gorm.DB
+	db             *gorm.DB
+	searchLanguage string
 }
 
-func NewProductRepository(db *gorm.DB) ProductRepository {
-	return &productRepository{db: db}
+// NewProductRepository creates a product repository searching products with the
+// given text search configuration, which must match the one they are indexed with
+func NewProductRepository(db *gorm.DB, searchLanguage string) ProductRepository {
+	return &productRepository{db: db, searchLanguage: searchLanguage}
 }
 
-func (r *productRepository) Create(product *model.Product) error {
-	return r.db.Create(product).Error
+// Create creates a product. Its stock level is booked through the ledger as the
+// given movement in the same transaction, so a failure leaves no product behind.
+// The movement may be nil for products created without stock.
+func (r *productRepository) Create(ctx context.Context, product *model.Product, stock *model.StockMovement) error {
+	level := product.StockLevel
+	product.StockLevel = 0
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(product).Error; err != nil {
+			return err
+		}
+
+		if stock == nil {
+			return nil
+		}
+
+		stock.ProductID = product.ID
+		stock.VariantID = nil
+		if err := bookInitialStock(tx, stock, level); err != nil {
+			return err
+		}
+		product.StockLevel = stock.BalanceAfter
+		return nil
+	})
 }
 
-func (r *productRepository) FindByID(id uuid.UUID) (*model.Product, error) {
+func (r *productRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Product, error) {
 	var product model.Product
 
-	if err := r.db.Preload("Category").First(&product, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Category").Preload("Variants", orderVariants).Preload("Media", orderMedia).First(&product, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
 		return nil, err
 	}
 
-	return &product, nil
-}
-
-func (r *productRepository) Update(product *model.Product) error {
-	return r.db.Save(product).Error
-}
+	products := []model.Product{product}
+	if err := fillAvailability(ctx, r.db, products); err != nil {
+		return nil, err
+	}
 
-func (r *productRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&model.Product{}, "id = ?", id).Error
+	return &products[0], nil
 }
 
-func (r *productRepository) List(params ListProductParams) ([]model.Product, int64, error) {
+// FindByIDs returns the products with the given IDs; missing products are skipped
+func (r *productRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Product, error) {
 	var products []model.Product
-	var total int64
 
-	query := r.db.Model(&model.Product{}).Preload("Category")
-
-	// Apply filters
-	if params.CategoryID != nil {
-		query = query.Where("category_id = ?", params.CategoryID)
+	if err := r.db.WithContext(ctx).Preload("Category").Preload("Variants", orderVariants).Preload("Media", orderMedia).Where("id IN ?", ids).Find(&products).Error; err != nil {
+		return nil, err
 	}
 
-	if params.MinPrice != nil {
-		query = query.Where("price >= ?", params.MinPrice)
+	if err := fillAvailability(ctx, r.db, products); err != nil {
+		return nil, err
 	}
 
-	if params.MaxPrice != nil {
-		query = query.Where("price <= ?", params.MaxPrice)
-	}
+	return products, nil
+}
+
+func (r *productRepository) Update(ctx context.Context, product *model.Product) error {
+	// Variants are written through the variant repository and stock levels through the stock ledger
+	return r.db.WithContext(ctx).Omit("Variants", "StockLevel").Save(product).Error
+}
+
+// Delete deletes a product together with its variants
+func (r *productRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Select("Variants").Delete(&model.Product{ID: id}).Error
+}
 
-	if params.Search != nil && *params.Search != "" {
-		query = query.Where("name ILIKE ? OR description ILIKE ?", "%"+*params.Search+"%", "%"+*params.Search+"%")
+func (r *productRepository) List(ctx context.Context, params ListProductParams) ([]model.Product, int64, error) {
+	var products []model.Product
+	var total int64
+
+	query, err := r.filter(ctx, params)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	// Count total results
@@ -97,9 +154,413 @@ func (r *productRepository) List(params ListProductParams) ([]model.Product, int
 
 	offset := (params.Page - 1) * params.PageSize
 
-	if err := query.Offset(offset).Limit(params.PageSize).Find(&products).Error; err != nil {
+	if err := r.order(query, params).Preload("Category").Preload("Variants", orderVariants).Preload("Media", orderMedia).
+		Offset(offset).Limit(params.PageSize).Find(&products).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := fillAvailability(ctx, r.db, products); err != nil {
 		return nil, 0, err
 	}
 
 	return products, total, nil
 }
+
+// priceFacetBounds are the lower bounds of the price ranges counted by ListFacets,
+// above the range starting at zero
+var priceFacetBounds = []float64{25, 50, 100, 250, 500}
+
+// ListFacets counts the products matching the params per category and per price range
+func (r *productRepository) ListFacets(ctx context.Context, params ListProductParams) (*model.ProductFacets, error) {
+	facets := &model.ProductFacets{
+		Categories:  []model.CategoryFacet{},
+		PriceRanges: make([]model.PriceRangeFacet, len(priceFacetBounds)+1),
+	}
+
+	withoutCategory := params
+	withoutCategory.CategoryID = nil
+
+	query, err := r.filter(ctx, withoutCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := query.Select("products.category_id, categories.name, COUNT(*) AS count").
+		Joins("JOIN categories ON categories.id = products.category_id AND categories.deleted_at IS NULL").
+		Group("products.category_id, categories.name").
+		Order("count DESC, categories.name").
+		Scan(&facets.Categories).Error; err != nil {
+		return nil, err
+	}
+
+	withoutPrice := params
+	withoutPrice.MinPrice = nil
+	withoutPrice.MaxPrice = nil
+
+	query, err = r.filter(ctx, withoutPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	priceSQL, countSQL := "products.price", "COUNT(*)"
+	if params.SKU != nil || len(params.Options) > 0 {
+		// As in the listing, products have the effective prices of their matching
+		// variants and count once in the range of each
+		query, err = matchVariants(query.Joins("JOIN product_variants ON product_variants.product_id = products.id AND product_variants.deleted_at IS NULL"), withoutPrice)
+		if err != nil {
+			return nil, err
+		}
+		priceSQL, countSQL = effectivePriceSQL, "COUNT(DISTINCT products.id)"
+	}
+
+	bounds := make([]string, len(priceFacetBounds))
+	for i, bound := range priceFacetBounds {
+		bounds[i] = strconv.FormatFloat(bound, 'f', -1, 64)
+	}
+
+	var buckets []struct {
+		Bucket int
+		Count  int64
+	}
+	if err := query.Select("width_bucket(" + priceSQL + ", ARRAY[" + strings.Join(bounds, ", ") + "]::numeric[]) AS bucket, " + countSQL + " AS count").
+		Group("bucket").
+		Scan(&buckets).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range facets.PriceRanges {
+		if i > 0 {
+			facets.PriceRanges[i].Min = priceFacetBounds[i-1]
+		}
+		if i < len(priceFacetBounds) {
+			facets.PriceRanges[i].Max = &priceFacetBounds[i]
+		}
+	}
+
+	for _, bucket := range buckets {
+		facets.PriceRanges[bucket.Bucket].Count = bucket.Count
+	}
+
+	return facets, nil
+}
+
+// onPromotionSQL matches products with an active discount, discounts without
+// applicable products applying to every product
+const onPromotionSQL = `EXISTS (SELECT 1 FROM discounts WHERE discounts.deleted_at IS NULL AND discounts.is_active
+	AND discounts.start_date <= NOW() AND discounts.end_date >= NOW()
+	AND (discounts.applicable_products IS NULL OR discounts.applicable_products = '[]'::jsonb
+		OR discounts.applicable_products @> jsonb_build_array(products.id)))`
+
+// filter builds a query selecting the products that match the params
+func (r *productRepository) filter(ctx context.Context, params ListProductParams) (*gorm.DB, error) {
+	query := r.db.WithContext(ctx).Model(&model.Product{})
+
+	if params.CategoryID != nil {
+		query = query.Where("products.category_id IN ("+categorySubtreeSQL+")", *params.CategoryID)
+	}
+
+	if params.SKU != nil || len(params.Options) > 0 {
+		variants, err := r.matchingVariants(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("EXISTS (?)", variants)
+	} else {
+		if params.MinPrice != nil {
+			query = query.Where("products.price >= ?", params.MinPrice)
+		}
+
+		if params.MaxPrice != nil {
+			query = query.Where("products.price <= ?", params.MaxPrice)
+		}
+	}
+
+	if params.Search != nil {
+		if tsQuery := prefixQuery(*params.Search); tsQuery != "" {
+			query = query.Where("products.search_vector @@ to_tsquery(?::regconfig, ?)", r.searchLanguage, tsQuery)
+		}
+	}
+
+	if params.InStock {
+		query = query.Where(onHandSQL + " > 0")
+	}
+
+	if params.OnPromotion {
+		query = query.Where(onPromotionSQL)
+	}
+
+	return query, nil
+}
+
+// productSortKey is the expression a product listing is ordered by, before the ID
+// that breaks ties in the same direction
+type productSortKey struct {
+	sql  string
+	vars []interface{}
+	// cast is the SQL type cursor keys are converted back to
+	cast string
+	desc bool
+}
+
+// sortKey returns the sort key of the params, falling back to the rank of search
+// results. It is nil when products are only ordered by ID.
+func (r *productRepository) sortKey(params ListProductParams) *productSortKey {
+	switch params.Sort {
+	case model.ProductSortPriceAsc:
+		return &productSortKey{sql: "products.price", cast: "numeric"}
+	case model.ProductSortPriceDesc:
+		return &productSortKey{sql: "products.price", cast: "numeric", desc: true}
+	case model.ProductSortNameAsc:
+		return &productSortKey{sql: "products.name", cast: "text"}
+	case model.ProductSortNameDesc:
+		return &productSortKey{sql: "products.name", cast: "text", desc: true}
+	case model.ProductSortNewest:
+		return &productSortKey{sql: "products.created_at", cast: "timestamptz", desc: true}
+	case model.ProductSortStockAsc:
+		return &productSortKey{sql: onHandSQL, cast: "bigint"}
+	case model.ProductSortStockDesc:
+		return &productSortKey{sql: onHandSQL, cast: "bigint", desc: true}
+	}
+
+	if params.Search == nil {
+		return nil
+	}
+
+	tsQuery := prefixQuery(*params.Search)
+	if tsQuery == "" {
+		return nil
+	}
+
+	return &productSortKey{
+		sql:  "ts_rank(products.search_vector, to_tsquery(?::regconfig, ?))",
+		vars: []interface{}{r.searchLanguage, tsQuery},
+		cast: "real",
+		desc: true,
+	}
+}
+
+// order applies the sort of the params. Ties are broken by ID so pages do not overlap.
+func (r *productRepository) order(query *gorm.DB, params ListProductParams) *gorm.DB {
+	key := r.sortKey(params)
+	if key == nil {
+		return query.Order("products.id")
+	}
+
+	direction := ""
+	if key.desc {
+		direction = " DESC"
+	}
+
+	// Order ignores expressions, so the sort is added as a clause
+	return query.Clauses(clause.OrderBy{Expression: clause.Expr{
+		SQL:  key.sql + direction + ", products.id" + direction,
+		Vars: key.vars,
+	}})
+}
+
+// productCursor is the position after the last product of a page: the sort and the
+// hash of the search and filters it was made for, the sort key of that product as
+// text and its ID
+type productCursor struct {
+	Sort    model.ProductSort `json:"sort,omitempty"`
+	Filters string            `json:"filters"`
+	Key     string            `json:"key,omitempty"`
+	ID      uuid.UUID         `json:"id"`
+}
+
+// listingHash identifies the search and filters of a listing, so a cursor is only
+// used for the listing it was made for
+func listingHash(params ListProductParams) (string, error) {
+	params.Page = 0
+	params.PageSize = 0
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+
+	hash := md5.Sum(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// ListAfter returns the page of products following the cursor, or the first page
+// for an empty cursor, and the cursor of the next page. The next cursor is empty on
+// the last page. Unlike List it neither counts the products nor skips rows, so
+// products added meanwhile do not shift the pages.
+func (r *productRepository) ListAfter(ctx context.Context, params ListProductParams, cursor string) ([]model.Product, string, error) {
+	var products []model.Product
+
+	query, err := r.filter(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := r.sortKey(params)
+
+	filters, err := listingHash(params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if cursor != "" {
+		// Search ranks depend on the search, and keys only exist for sorted listings
+		var after productCursor
+		if err := decodeCursor(cursor, &after); err != nil || after.Sort != params.Sort || after.Filters != filters || (key != nil && after.Key == "") {
+			return nil, "", errors.New(model.ErrInvalidCursor)
+		}
+
+		switch {
+		case key == nil:
+			query = query.Where("products.id > ?", after.ID)
+		case key.desc:
+			query = query.Where("("+key.sql+", products.id) < (CAST(? AS "+key.cast+"), ?)", append(key.vars, after.Key, after.ID)...)
+		default:
+			query = query.Where("("+key.sql+", products.id) > (CAST(? AS "+key.cast+"), ?)", append(key.vars, after.Key, after.ID)...)
+		}
+	}
+
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+
+	// One product more than the page tells whether there is a next page
+	if err := r.order(query, params).Preload("Category").Preload("Variants", orderVariants).Preload("Media", orderMedia).
+		Limit(params.PageSize + 1).Find(&products).Error; err != nil {
+		return nil, "", err
+	}
+
+	if len(products) <= params.PageSize {
+		if err := fillAvailability(ctx, r.db, products); err != nil {
+			return nil, "", err
+		}
+		return products, "", nil
+	}
+
+	products = products[:params.PageSize]
+	if err := fillAvailability(ctx, r.db, products); err != nil {
+		return nil, "", err
+	}
+
+	next := productCursor{Sort: params.Sort, Filters: filters, ID: products[len(products)-1].ID}
+	if key != nil {
+		// The key is read back as text so the cursor compares exactly like the column
+		if err := r.db.WithContext(ctx).Model(&model.Product{}).
+			Select("CAST("+key.sql+" AS text)", key.vars...).
+			Where("products.id = ?", next.ID).
+			Scan(&next.Key).Error; err != nil {
+			return nil, "", err
+		}
+	}
+
+	encoded, err := encodeCursor(next)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return products, encoded, nil
+}
+
+// Stock of a product with its variants, and the reorder threshold that applies to it
+const (
+	onHandSQL           = "products.stock_level + COALESCE((SELECT SUM(product_variants.stock_level) FROM product_variants WHERE product_variants.product_id = products.id AND product_variants.deleted_at IS NULL), 0)"
+	reorderThresholdSQL = "COALESCE(products.reorder_threshold, categories.default_reorder_threshold)"
+)
+
+// ListLowStock returns the products whose stock is below their reorder threshold,
+// those furthest below it first
+func (r *productRepository) ListLowStock(ctx context.Context, page, pageSize int) ([]model.Product, int64, error) {
+	var products []model.Product
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Product{}).
+		Joins("LEFT JOIN categories ON categories.id = products.category_id AND categories.deleted_at IS NULL").
+		Where(onHandSQL + " < " + reorderThresholdSQL)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+
+	if err := query.Preload("Category").Preload("Variants", orderVariants).Preload("Media", orderMedia).
+		Order(reorderThresholdSQL + " - (" + onHandSQL + ") DESC").Order("products.name").
+		Offset(offset).Limit(pageSize).Find(&products).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := fillAvailability(ctx, r.db, products); err != nil {
+		return nil, 0, err
+	}
+
+	return products, total, nil
+}
+
+// effectivePriceSQL is the price of a variant, falling back to the price of its product
+const effectivePriceSQL = "COALESCE(product_variants.price, products.price)"
+
+// matchingVariants builds a subquery selecting the variants of the outer product
+// that match the variant filters and price range of the params
+func (r *productRepository) matchingVariants(ctx context.Context, params ListProductParams) (*gorm.DB, error) {
+	return matchVariants(r.db.WithContext(ctx).Model(&model.ProductVariant{}).
+		Select("1").
+		Where("product_variants.product_id = products.id"), params)
+}
+
+// matchVariants restricts a query over product_variants to the variants matching
+// the variant filters and price range of the params
+func matchVariants(query *gorm.DB, params ListProductParams) (*gorm.DB, error) {
+	if params.SKU != nil {
+		query = query.Where("product_variants.sku = ?", *params.SKU)
+	}
+
+	if len(params.Options) > 0 {
+		options, err := json.Marshal(params.Options)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("product_variants.options @> ?::jsonb", string(options))
+	}
+
+	if params.MinPrice != nil {
+		query = query.Where(effectivePriceSQL+" >= ?", *params.MinPrice)
+	}
+
+	if params.MaxPrice != nil {
+		query = query.Where(effectivePriceSQL+" <= ?", *params.MaxPrice)
+	}
+
+	return query, nil
+}
+
+// prefixQuery turns a search into a text search query matching words that start
+// with each of its terms. Anything but letters and digits separates terms, so
+// user input cannot inject query operators.
+func prefixQuery(search string) string {
+	terms := strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+// orderVariants sorts preloaded variants by SKU
+func orderVariants(db *gorm.DB) *gorm.DB {
+	return db.Order("product_variants.sku")
+}
+
+// orderMedia sorts preloaded media by position
+func orderMedia(db *gorm.DB) *gorm.DB {
+	return db.Order("product_media.position")
+}
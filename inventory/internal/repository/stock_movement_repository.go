@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StockMovementRepository keeps the stock ledger. Stock levels of products and
+// variants are only changed through it, so every change leaves an entry.
+type StockMovementRepository interface {
+	Adjust(ctx context.Context, movement *model.StockMovement) error
+	SetLevel(ctx context.Context, movement *model.StockMovement, level int) error
+	ListByProduct(ctx context.Context, params ListStockMovementParams) ([]model.StockMovement, int64, error)
+}
+
+// ListStockMovementParams filters the stock history of a product, optionally to one variant
+type ListStockMovementParams struct {
+	ProductID uuid.UUID
+	VariantID *uuid.UUID
+	Page      int
+	PageSize  int
+}
+
+type stockMovementRepository struct {
+	db *gorm.DB
+}
+
+func NewStockMovementRepository(db *gorm.DB) StockMovementRepository {
+	return &stockMovementRepository{db: db}
+}
+
+// Adjust changes the stock level by the delta of the movement and records it.
+// It fails with ErrInsufficientStock if the stock level would become negative.
+func (r *stockMovementRepository) Adjust(ctx context.Context, movement *model.StockMovement) error {
+	return r.apply(ctx, movement, func(current int) int {
+		return current + movement.Delta
+	})
+}
+
+// SetLevel sets the stock level and records the difference as the delta of the
+// movement. Nothing is recorded when the level does not change.
+func (r *stockMovementRepository) SetLevel(ctx context.Context, movement *model.StockMovement, level int) error {
+	return r.apply(ctx, movement, func(int) int {
+		return level
+	})
+}
+
+// ListByProduct returns the stock movements of a product, newest first
+func (r *stockMovementRepository) ListByProduct(ctx context.Context, params ListStockMovementParams) ([]model.StockMovement, int64, error) {
+	var movements []model.StockMovement
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.StockMovement{}).Where("product_id = ?", params.ProductID)
+	if params.VariantID != nil {
+		query = query.Where("variant_id = ?", *params.VariantID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+
+	if err := query.Order("created_at DESC").Offset(offset).Limit(params.PageSize).Find(&movements).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return movements, total, nil
+}
+
+// apply locks the stock level of the product or variant, moves it to the level
+// computed from the current one and records the movement in one transaction
+func (r *stockMovementRepository) apply(ctx context.Context, movement *model.StockMovement, next func(current int) int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return applyStockMovement(tx, movement, next)
+	})
+}
+
+// bookInitialStock records the stock level a product or variant starts with, within
+// the transaction that creates it
+func bookInitialStock(tx *gorm.DB, movement *model.StockMovement, level int) error {
+	return applyStockMovement(tx, movement, func(int) int {
+		return level
+	})
+}
+
+// applyStockMovement moves the stock level within an open transaction and records the
+// movement. Nothing is recorded when the level does not change. For movements of
+// warehouse stock, next computes the new warehouse quantity instead, and the stock
+// level moves by the same delta.
+func applyStockMovement(tx *gorm.DB, movement *model.StockMovement, next func(current int) int) error {
+	target, current, err := lockStockLevel(tx, movement.ProductID, movement.VariantID)
+	if err != nil {
+		return err
+	}
+
+	if movement.WarehouseID == nil {
+		level := next(current)
+		if level < current {
+			// Stock outside warehouses may not become negative
+			held, err := heldInWarehouses(tx, movement.ProductID, movement.VariantID)
+			if err != nil {
+				return err
+			}
+			if level >= 0 && level < held {
+				return errors.New(model.ErrStockInWarehouses)
+			}
+		}
+		return recordStockMovement(tx, target, movement, current, level)
+	}
+
+	// The stock level is locked first, so the warehouse row is only written by
+	// one transaction at a time, even before it exists
+	stock := model.WarehouseStock{
+		WarehouseID: *movement.WarehouseID,
+		ProductID:   movement.ProductID,
+		VariantID:   movement.VariantID,
+	}
+	if err := stockOf(tx, stock.WarehouseID, stock.ProductID, stock.VariantID).First(&stock).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	quantity := next(stock.Quantity)
+	if quantity < 0 {
+		return errors.New(model.ErrInsufficientStock)
+	}
+
+	if err := recordStockMovement(tx, target, movement, current, current+quantity-stock.Quantity); err != nil {
+		return err
+	}
+
+	// The row is created even without stock, so the warehouse lists the item
+	if stock.ID == uuid.Nil {
+		stock.Quantity = quantity
+		return tx.Create(&stock).Error
+	}
+
+	if movement.Delta == 0 {
+		return nil
+	}
+
+	return tx.Model(&stock).Updates(map[string]interface{}{
+		"quantity":   quantity,
+		"updated_at": time.Now(),
+	}).Error
+}
+
+// recordStockMovement moves the locked stock level to the given level and records the movement
+func recordStockMovement(tx *gorm.DB, target *gorm.DB, movement *model.StockMovement, current, level int) error {
+	if level < 0 {
+		return errors.New(model.ErrInsufficientStock)
+	}
+
+	movement.Delta = level - current
+	movement.BalanceAfter = level
+	if movement.Delta == 0 {
+		return nil
+	}
+
+	if err := target.Updates(map[string]interface{}{
+		"stock_level": level,
+		"updated_at":  time.Now(),
+	}).Error; err != nil {
+		return err
+	}
+
+	return tx.Create(movement).Error
+}
+
+// heldInWarehouses returns the stock of a product or variant held in all warehouses
+func heldInWarehouses(tx *gorm.DB, productID uuid.UUID, variantID *uuid.UUID) (int, error) {
+	var held int64
+
+	query := tx.Model(&model.WarehouseStock{}).Where("product_id = ?", productID)
+	if variantID == nil {
+		query = query.Where("variant_id IS NULL")
+	} else {
+		query = query.Where("variant_id = ?", *variantID)
+	}
+
+	if err := query.Select("COALESCE(SUM(quantity), 0)").Scan(&held).Error; err != nil {
+		return 0, err
+	}
+
+	return int(held), nil
+}
+
+// lockStockLevel locks the row holding the stock level of a product or variant for
+// the rest of the transaction and returns a query targeting it with the current level
+func lockStockLevel(tx *gorm.DB, productID uuid.UUID, variantID *uuid.UUID) (*gorm.DB, int, error) {
+	locking := clause.Locking{Strength: "UPDATE"}
+
+	if variantID == nil {
+		var product model.Product
+		if err := tx.Clauses(locking).Select("id", "stock_level").First(&product, "id = ?", productID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, 0, errors.New(model.ErrProductNotFound)
+			}
+			return nil, 0, err
+		}
+		return tx.Model(&model.Product{}).Where("id = ?", productID), product.StockLevel, nil
+	}
+
+	var variant model.ProductVariant
+	if err := tx.Clauses(locking).Select("id", "stock_level").First(&variant, "id = ? AND product_id = ?", *variantID, productID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, errors.New(model.ErrVariantNotFound)
+		}
+		return nil, 0, err
+	}
+	return tx.Model(&model.ProductVariant{}).Where("id = ?", *variantID), variant.StockLevel, nil
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type StockRepository interface {
+	FindStock(ctx context.Context, warehouseID, productID uuid.UUID, variantID *uuid.UUID) (*model.WarehouseStock, error)
+	ListByWarehouse(ctx context.Context, warehouseID uuid.UUID) ([]model.WarehouseStock, error)
+	ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.WarehouseStock, error)
+	SetQuantity(ctx context.Context, movement *model.StockMovement, quantity int) error
+	Transfer(ctx context.Context, transfer *model.StockTransfer, withdrawal, deposit *model.StockMovement) error
+	ListTransfers(ctx context.Context, warehouseID uuid.UUID) ([]model.StockTransfer, error)
+}
+
+type stockRepository struct {
+	db *gorm.DB
+}
+
+func NewStockRepository(db *gorm.DB) StockRepository {
+	return &stockRepository{db: db}
+}
+
+func (r *stockRepository) FindStock(ctx context.Context, warehouseID, productID uuid.UUID, variantID *uuid.UUID) (*model.WarehouseStock, error) {
+	var stock model.WarehouseStock
+
+	if err := stockOf(r.db.WithContext(ctx), warehouseID, productID, variantID).First(&stock).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &stock, nil
+}
+
+// ListByWarehouse returns the stock held in a warehouse
+func (r *stockRepository) ListByWarehouse(ctx context.Context, warehouseID uuid.UUID) ([]model.WarehouseStock, error) {
+	var stocks []model.WarehouseStock
+
+	if err := r.db.WithContext(ctx).Where("warehouse_id = ?", warehouseID).Order("product_id, variant_id").Find(&stocks).Error; err != nil {
+		return nil, err
+	}
+
+	return stocks, nil
+}
+
+// ListByProduct returns the stock of a product and its variants in every warehouse
+func (r *stockRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.WarehouseStock, error) {
+	var stocks []model.WarehouseStock
+
+	if err := r.db.WithContext(ctx).Where("product_id = ?", productID).Order("warehouse_id, variant_id").Find(&stocks).Error; err != nil {
+		return nil, err
+	}
+
+	return stocks, nil
+}
+
+// SetQuantity sets the stock of a product or variant in the warehouse of the movement.
+// The difference is recorded in the stock ledger and moves the stock level with it.
+func (r *stockRepository) SetQuantity(ctx context.Context, movement *model.StockMovement, quantity int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return applyStockMovement(tx, movement, func(int) int {
+			return quantity
+		})
+	})
+}
+
+// Transfer moves stock between two warehouses and records the transfer in one transaction.
+// The withdrawal and deposit are recorded in the stock ledger against the source and
+// destination warehouse. It fails with ErrInsufficientStock if the source warehouse
+// holds less than the quantity.
+func (r *stockRepository) Transfer(ctx context.Context, transfer *model.StockTransfer, withdrawal, deposit *model.StockMovement) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		withdrawal.WarehouseID = &transfer.FromWarehouseID
+		if err := applyStockMovement(tx, withdrawal, func(current int) int {
+			return current - transfer.Quantity
+		}); err != nil {
+			return err
+		}
+
+		deposit.WarehouseID = &transfer.ToWarehouseID
+		if err := applyStockMovement(tx, deposit, func(current int) int {
+			return current + transfer.Quantity
+		}); err != nil {
+			return err
+		}
+
+		return tx.Create(transfer).Error
+	})
+}
+
+// ListTransfers returns the transfers into or out of a warehouse, newest first
+func (r *stockRepository) ListTransfers(ctx context.Context, warehouseID uuid.UUID) ([]model.StockTransfer, error) {
+	var transfers []model.StockTransfer
+
+	if err := r.db.WithContext(ctx).
+		Where("from_warehouse_id = ? OR to_warehouse_id = ?", warehouseID, warehouseID).
+		Order("created_at DESC").
+		Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// stockOf narrows a query to the stock row of a product or variant in a warehouse
+func stockOf(db *gorm.DB, warehouseID, productID uuid.UUID, variantID *uuid.UUID) *gorm.DB {
+	db = db.Where("warehouse_id = ? AND product_id = ?", warehouseID, productID)
+	if variantID == nil {
+		return db.Where("variant_id IS NULL")
+	}
+	return db.Where("variant_id = ?", *variantID)
+}
+
+// stockTotals sums the stock of the products and of their variants in active warehouses
+func stockTotals(ctx context.Context, db *gorm.DB, productIDs []uuid.UUID) (map[uuid.UUID]int, map[uuid.UUID]int, error) {
+	var rows []struct {
+		ProductID uuid.UUID
+		VariantID *uuid.UUID
+		Quantity  int
+	}
+	if err := db.WithContext(ctx).Model(&model.WarehouseStock{}).
+		Select("warehouse_stocks.product_id, warehouse_stocks.variant_id, SUM(warehouse_stocks.quantity) AS quantity").
+		Joins("JOIN warehouses ON warehouses.id = warehouse_stocks.warehouse_id AND warehouses.is_active AND warehouses.deleted_at IS NULL").
+		Joins("LEFT JOIN product_variants ON product_variants.id = warehouse_stocks.variant_id").
+		Where("warehouse_stocks.product_id IN ?", productIDs).
+		Where("warehouse_stocks.variant_id IS NULL OR product_variants.deleted_at IS NULL").
+		Group("warehouse_stocks.product_id, warehouse_stocks.variant_id").
+		Scan(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+
+	byProduct := make(map[uuid.UUID]int, len(productIDs))
+	byVariant := make(map[uuid.UUID]int)
+	for _, row := range rows {
+		byProduct[row.ProductID] += row.Quantity
+		if row.VariantID != nil {
+			byVariant[*row.VariantID] = row.Quantity
+		}
+	}
+	return byProduct, byVariant, nil
+}
+
+// fillAvailability sets the available-to-sell quantity of the products and their variants
+func fillAvailability(ctx context.Context, db *gorm.DB, products []model.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	productIDs := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+	}
+
+	byProduct, byVariant, err := stockTotals(ctx, db, productIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range products {
+		products[i].AvailableToSell = byProduct[products[i].ID]
+		fillVariantAvailability(products[i].Variants, byVariant)
+	}
+	return nil
+}
+
+// fillVariantAvailability sets the available-to-sell quantity of variants from their stock totals
+func fillVariantAvailability(variants []model.ProductVariant, byVariant map[uuid.UUID]int) {
+	for i := range variants {
+		variants[i].AvailableToSell = byVariant[variants[i].ID]
+	}
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type VariantRepository interface {
+	Create(ctx context.Context, variant *model.ProductVariant, stock *model.StockMovement) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.ProductVariant, error)
+	FindBySKU(ctx context.Context, sku string) (*model.ProductVariant, error)
+	ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductVariant, error)
+	Update(ctx context.Context, variant *model.ProductVariant) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type variantRepository struct {
+	db *gorm.DB
+}
+
+func NewVariantRepository(db *gorm.DB) VariantRepository {
+	return &variantRepository{db: db}
+}
+
+// Create creates a variant and books its stock level like productRepository.Create
+func (r *variantRepository) Create(ctx context.Context, variant *model.ProductVariant, stock *model.StockMovement) error {
+	level := variant.StockLevel
+	variant.StockLevel = 0
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(variant).Error; err != nil {
+			return err
+		}
+
+		if stock == nil {
+			return nil
+		}
+
+		stock.ProductID = variant.ProductID
+		stock.VariantID = &variant.ID
+		if err := bookInitialStock(tx, stock, level); err != nil {
+			return err
+		}
+		variant.StockLevel = stock.BalanceAfter
+		return nil
+	})
+}
+
+func (r *variantRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ProductVariant, error) {
+	var variant model.ProductVariant
+
+	if err := r.db.WithContext(ctx).First(&variant, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	variants := []model.ProductVariant{variant}
+	if err := r.fillAvailability(ctx, variant.ProductID, variants); err != nil {
+		return nil, err
+	}
+
+	return &variants[0], nil
+}
+
+func (r *variantRepository) FindBySKU(ctx context.Context, sku string) (*model.ProductVariant, error) {
+	var variant model.ProductVariant
+
+	if err := r.db.WithContext(ctx).First(&variant, "sku = ?", sku).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &variant, nil
+}
+
+// ListByProduct returns the variants of a product ordered by SKU
+func (r *variantRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]model.ProductVariant, error) {
+	var variants []model.ProductVariant
+
+	if err := r.db.WithContext(ctx).Where("product_id = ?", productID).Order("sku").Find(&variants).Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.fillAvailability(ctx, productID, variants); err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
+
+func (r *variantRepository) Update(ctx context.Context, variant *model.ProductVariant) error {
+	// Stock levels are written through the stock ledger
+	return r.db.WithContext(ctx).Omit("StockLevel").Save(variant).Error
+}
+
+func (r *variantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.ProductVariant{}, "id = ?", id).Error
+}
+
+// fillAvailability sets the available-to-sell quantity of variants of the product
+func (r *variantRepository) fillAvailability(ctx context.Context, productID uuid.UUID, variants []model.ProductVariant) error {
+	if len(variants) == 0 {
+		return nil
+	}
+
+	_, byVariant, err := stockTotals(ctx, r.db, []uuid.UUID{productID})
+	if err != nil {
+		return err
+	}
+
+	fillVariantAvailability(variants, byVariant)
+	return nil
+}
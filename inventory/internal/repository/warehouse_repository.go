@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WarehouseRepository interface {
+	Create(ctx context.Context, warehouse *model.Warehouse) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Warehouse, error)
+	FindByCode(ctx context.Context, code string) (*model.Warehouse, error)
+	FindAll(ctx context.Context) ([]model.Warehouse, error)
+	Update(ctx context.Context, warehouse *model.Warehouse) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	HasStock(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+type warehouseRepository struct {
+	db *gorm.DB
+}
+
+func NewWarehouseRepository(db *gorm.DB) WarehouseRepository {
+	return &warehouseRepository{db: db}
+}
+
+func (r *warehouseRepository) Create(ctx context.Context, warehouse *model.Warehouse) error {
+	return r.db.WithContext(ctx).Create(warehouse).Error
+}
+
+func (r *warehouseRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Warehouse, error) {
+	var warehouse model.Warehouse
+
+	if err := r.db.WithContext(ctx).First(&warehouse, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &warehouse, nil
+}
+
+func (r *warehouseRepository) FindByCode(ctx context.Context, code string) (*model.Warehouse, error) {
+	var warehouse model.Warehouse
+
+	if err := r.db.WithContext(ctx).First(&warehouse, "code = ?", code).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &warehouse, nil
+}
+
+// FindAll returns all warehouses ordered by code
+func (r *warehouseRepository) FindAll(ctx context.Context) ([]model.Warehouse, error) {
+	var warehouses []model.Warehouse
+
+	if err := r.db.WithContext(ctx).Order("code").Find(&warehouses).Error; err != nil {
+		return nil, err
+	}
+
+	return warehouses, nil
+}
+
+func (r *warehouseRepository) Update(ctx context.Context, warehouse *model.Warehouse) error {
+	return r.db.WithContext(ctx).Save(warehouse).Error
+}
+
+func (r *warehouseRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.Warehouse{}, "id = ?", id).Error
+}
+
+// HasStock reports whether any product is stocked in the warehouse
+func (r *warehouseRepository) HasStock(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&model.WarehouseStock{}).
+		Where("warehouse_id = ? AND quantity > 0", id).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,438 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+)
+
+// maxJSONLineSize bounds a single line of a JSON lines import
+const maxJSONLineSize = 1 << 20
+
+// csvListSeparator separates the values of list columns in CSV files
+const csvListSeparator = "|"
+
+// csvOptionSeparator separates the name and value of a variant option in CSV files
+const csvOptionSeparator = "="
+
+// Columns of the CSV files of each entity, in export order. Imports accept them in
+// any order. The first column is the key the records are matched by.
+var (
+	categoryColumns = []string{"name", "description", "parent", "default_reorder_threshold"}
+	productColumns  = []string{"name", "description", "price", "stock_level", "category", "reorder_threshold"}
+	discountColumns = []string{"name", "description", "discount_percentage", "start_date", "end_date", "is_active", "products"}
+	variantColumns  = []string{"sku", "product", "options", "price", "stock_level"}
+)
+
+// fileError is a problem with an import file as a whole, such as a malformed CSV
+// header, after which no further rows can be read
+type fileError struct {
+	line int
+	err  error
+}
+
+func (e *fileError) Error() string {
+	return e.err.Error()
+}
+
+// decodeRecords reads the rows of an import and calls fn for each of them, with the
+// row error instead of a record when a row cannot be decoded. It stops at the first
+// error of fn or of r, and returns a *fileError when the content of the file keeps
+// the remaining rows from being read.
+func decodeRecords[T any](r io.Reader, format model.CatalogFormat, columns []string, fromCSV func(row map[string]string) (T, error), fn func(line int, record T, err error) error) error {
+	if format == model.CatalogFormatJSONL {
+		return decodeJSONLines(r, fn)
+	}
+	return decodeCSV(r, columns, fromCSV, fn)
+}
+
+func decodeJSONLines[T any](r io.Reader, fn func(line int, record T, err error) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxJSONLineSize)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var record T
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(&record)
+		if err == nil && decoder.More() {
+			err = errors.New("more than one JSON value on the line")
+		}
+
+		if err := fn(line, record, err); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return &fileError{line: line + 1, err: fmt.Errorf("line longer than %d bytes", maxJSONLineSize)}
+		}
+		return fmt.Errorf("failed to read JSON lines: %w", err)
+	}
+
+	return nil
+}
+
+func decodeCSV[T any](r io.Reader, columns []string, fromCSV func(row map[string]string) (T, error), fn func(line int, record T, err error) error) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		var parseErr *csv.ParseError
+		if !errors.As(err, &parseErr) {
+			return fmt.Errorf("failed to read CSV: %w", err)
+		}
+		return &fileError{line: 1, err: fmt.Errorf("invalid CSV header: %w", parseErr.Err)}
+	}
+
+	if err := checkHeader(header, columns); err != nil {
+		return &fileError{line: 1, err: err}
+	}
+
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		var record T
+		var line int
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return fmt.Errorf("failed to read CSV: %w", err)
+			}
+
+			// Rows with the wrong number of fields are skipped, anything else breaks the file
+			line = parseErr.StartLine
+			if !errors.Is(parseErr.Err, csv.ErrFieldCount) {
+				return &fileError{line: line, err: fmt.Errorf("invalid CSV: %w", parseErr.Err)}
+			}
+		} else {
+			line, _ = reader.FieldPos(0)
+			row := make(map[string]string, len(header))
+			for i, column := range header {
+				row[column] = strings.TrimSpace(values[i])
+			}
+			record, err = fromCSV(row)
+		}
+
+		if err := fn(line, record, err); err != nil {
+			return err
+		}
+	}
+}
+
+// checkHeader normalizes the column names of a CSV header in place and checks them
+// against the known columns, of which the key column is required. Spreadsheet tools
+// often start the file with a byte order mark.
+func checkHeader(header, columns []string) error {
+	known := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		known[column] = true
+	}
+
+	seen := make(map[string]bool, len(header))
+	for i, column := range header {
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff")
+		}
+		column = strings.ToLower(strings.TrimSpace(column))
+
+		if !known[column] {
+			return fmt.Errorf("unknown column %q, expected columns are %s", column, strings.Join(columns, ", "))
+		}
+		if seen[column] {
+			return fmt.Errorf("duplicate column %q", column)
+		}
+
+		seen[column] = true
+		header[i] = column
+	}
+
+	if key := columns[0]; !seen[key] {
+		return fmt.Errorf("missing column %q", key)
+	}
+
+	return nil
+}
+
+func categoryFromCSV(row map[string]string) (model.CategoryRecord, error) {
+	record := model.CategoryRecord{
+		Name:        row["name"],
+		Description: row["description"],
+		Parent:      row["parent"],
+	}
+
+	var err error
+	if record.DefaultReorderThreshold, err = parseOptionalInt(row, "default_reorder_threshold"); err != nil {
+		return record, err
+	}
+
+	return record, nil
+}
+
+func categoryToCSV(record model.CategoryRecord) []string {
+	return []string{record.Name, record.Description, record.Parent, formatOptionalInt(record.DefaultReorderThreshold)}
+}
+
+func productFromCSV(row map[string]string) (model.ProductRecord, error) {
+	record := model.ProductRecord{
+		Name:        row["name"],
+		Description: row["description"],
+		Category:    row["category"],
+	}
+
+	var err error
+	if record.Price, err = parseFloat(row, "price"); err != nil {
+		return record, err
+	}
+	if record.StockLevel, err = parseOptionalInt(row, "stock_level"); err != nil {
+		return record, err
+	}
+	if record.ReorderThreshold, err = parseOptionalInt(row, "reorder_threshold"); err != nil {
+		return record, err
+	}
+
+	return record, nil
+}
+
+func productToCSV(record model.ProductRecord) []string {
+	return []string{
+		record.Name,
+		record.Description,
+		strconv.FormatFloat(record.Price, 'f', -1, 64),
+		formatOptionalInt(record.StockLevel),
+		record.Category,
+		formatOptionalInt(record.ReorderThreshold),
+	}
+}
+
+func discountFromCSV(row map[string]string) (model.DiscountRecord, error) {
+	record := model.DiscountRecord{
+		Name:        row["name"],
+		Description: row["description"],
+	}
+
+	var err error
+	if record.DiscountPercentage, err = parseFloat(row, "discount_percentage"); err != nil {
+		return record, err
+	}
+	if record.StartDate, err = parseTime(row, "start_date"); err != nil {
+		return record, err
+	}
+	if record.EndDate, err = parseTime(row, "end_date"); err != nil {
+		return record, err
+	}
+
+	if value := row["is_active"]; value != "" {
+		active, err := strconv.ParseBool(value)
+		if err != nil {
+			return record, fmt.Errorf("is_active must be true or false, got %q", value)
+		}
+		record.IsActive = &active
+	}
+
+	if value := row["products"]; value != "" {
+		for _, name := range strings.Split(value, csvListSeparator) {
+			if name = strings.TrimSpace(name); name != "" {
+				record.Products = append(record.Products, name)
+			}
+		}
+	}
+
+	return record, nil
+}
+
+func discountToCSV(record model.DiscountRecord) []string {
+	active := ""
+	if record.IsActive != nil {
+		active = strconv.FormatBool(*record.IsActive)
+	}
+
+	return []string{
+		record.Name,
+		record.Description,
+		strconv.FormatFloat(record.DiscountPercentage, 'f', -1, 64),
+		record.StartDate.Format(time.RFC3339),
+		record.EndDate.Format(time.RFC3339),
+		active,
+		strings.Join(record.Products, csvListSeparator),
+	}
+}
+
+func variantFromCSV(row map[string]string) (model.VariantRecord, error) {
+	record := model.VariantRecord{
+		SKU:     row["sku"],
+		Product: row["product"],
+	}
+
+	var err error
+	if record.Price, err = parseOptionalFloat(row, "price"); err != nil {
+		return record, err
+	}
+	if record.StockLevel, err = parseOptionalInt(row, "stock_level"); err != nil {
+		return record, err
+	}
+
+	if value := row["options"]; value != "" {
+		record.Options = make(map[string]string)
+		for _, option := range strings.Split(value, csvListSeparator) {
+			name, optionValue, ok := strings.Cut(option, csvOptionSeparator)
+			if !ok {
+				return record, fmt.Errorf("options must be name=value pairs separated by %q, got %q", csvListSeparator, value)
+			}
+			record.Options[strings.TrimSpace(name)] = strings.TrimSpace(optionValue)
+		}
+	}
+
+	return record, nil
+}
+
+func variantToCSV(record model.VariantRecord) []string {
+	names := make([]string, 0, len(record.Options))
+	for name := range record.Options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	options := make([]string, 0, len(names))
+	for _, name := range names {
+		options = append(options, name+csvOptionSeparator+record.Options[name])
+	}
+
+	price := ""
+	if record.Price != nil {
+		price = strconv.FormatFloat(*record.Price, 'f', -1, 64)
+	}
+
+	return []string{
+		record.SKU,
+		record.Product,
+		strings.Join(options, csvListSeparator),
+		price,
+		formatOptionalInt(record.StockLevel),
+	}
+}
+
+func parseFloat(row map[string]string, column string) (float64, error) {
+	value := row[column]
+	if value == "" {
+		return 0, fmt.Errorf("%s is required", column)
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number, got %q", column, value)
+	}
+	return number, nil
+}
+
+func parseOptionalFloat(row map[string]string, column string) (*float64, error) {
+	if row[column] == "" {
+		return nil, nil
+	}
+
+	number, err := parseFloat(row, column)
+	if err != nil {
+		return nil, err
+	}
+	return &number, nil
+}
+
+func parseOptionalInt(row map[string]string, column string) (*int, error) {
+	value := row[column]
+	if value == "" {
+		return nil, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a whole number, got %q", column, value)
+	}
+	return &number, nil
+}
+
+// parseTime accepts RFC 3339 timestamps and plain dates, which are taken as midnight UTC
+func parseTime(row map[string]string, column string) (time.Time, error) {
+	value := row[column]
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%s is required", column)
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%s must be a date or an RFC 3339 timestamp, got %q", column, value)
+}
+
+func formatOptionalInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+// recordWriter writes the records of an export in CSV or JSON lines
+type recordWriter struct {
+	format model.CatalogFormat
+	csv    *csv.Writer
+	json   *json.Encoder
+}
+
+// newRecordWriter starts an export, writing the CSV header right away
+func newRecordWriter(w io.Writer, format model.CatalogFormat, columns []string) (*recordWriter, error) {
+	if format == model.CatalogFormatJSONL {
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		return &recordWriter{format: format, json: encoder}, nil
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return nil, err
+	}
+	return &recordWriter{format: format, csv: writer}, nil
+}
+
+// Write writes record as a JSON line or its row as a CSV line
+func (w *recordWriter) Write(record interface{}, row []string) error {
+	if w.format == model.CatalogFormatJSONL {
+		return w.json.Encode(record)
+	}
+	return w.csv.Write(row)
+}
+
+// Flush sends buffered CSV lines to the underlying writer
+func (w *recordWriter) Flush() error {
+	if w.csv == nil {
+		return nil
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
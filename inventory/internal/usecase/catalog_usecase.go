@@ -0,0 +1,611 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/google/uuid"
+)
+
+// errRollback rolls back the transaction of an import that must not be committed
+var errRollback = errors.New("rollback import")
+
+type catalogUseCase struct {
+	catalogRepo repository.CatalogRepository
+	alerter     *StockAlerter
+	producer    *kafka.Producer
+}
+
+// NewCatalogUseCase creates a new catalog use case
+func NewCatalogUseCase(catalogRepo repository.CatalogRepository, alerter *StockAlerter, producer *kafka.Producer) CatalogUseCase {
+	return &catalogUseCase{
+		catalogRepo: catalogRepo,
+		alerter:     alerter,
+		producer:    producer,
+	}
+}
+
+// catalogChange is a record written by an import, published once the import is committed
+type catalogChange struct {
+	entity     string
+	action     string
+	id         uuid.UUID
+	stockDelta int
+}
+
+// Import upserts the records of the file by name, or variants by SKU. Every row runs
+// in its own savepoint of one transaction, so failing rows are rolled back on their
+// own and later rows can refer to records created by earlier ones. Atomic imports
+// and dry runs roll back the whole transaction at the end.
+func (u *catalogUseCase) Import(ctx context.Context, entity model.CatalogEntity, r io.Reader, options model.ImportOptions) (*model.ImportReport, error) {
+	if !entity.Valid() {
+		return nil, errors.New(model.ErrInvalidCatalogEntity)
+	}
+	if !options.Format.Valid() {
+		return nil, errors.New(model.ErrInvalidCatalogFormat)
+	}
+	if !options.Mode.Valid() {
+		return nil, errors.New(model.ErrInvalidImportMode)
+	}
+
+	report := &model.ImportReport{
+		Entity: entity,
+		Mode:   options.Mode,
+		DryRun: options.DryRun,
+		Errors: []model.ImportRowError{},
+	}
+
+	var changes []catalogChange
+	err := u.catalogRepo.Transaction(ctx, func(repo repository.CatalogRepository) error {
+		importer := &catalogImporter{repo: repo, report: report}
+
+		var err error
+		switch entity {
+		case model.CatalogCategories:
+			err = decodeRecords(r, options.Format, categoryColumns, categoryFromCSV, func(line int, record model.CategoryRecord, err error) error {
+				return importer.row(ctx, line, record.Name, err, func(repo repository.CatalogRepository) (bool, []catalogChange, error) {
+					return importCategory(ctx, repo, record)
+				})
+			})
+		case model.CatalogProducts:
+			err = decodeRecords(r, options.Format, productColumns, productFromCSV, func(line int, record model.ProductRecord, err error) error {
+				return importer.row(ctx, line, record.Name, err, func(repo repository.CatalogRepository) (bool, []catalogChange, error) {
+					return importProduct(ctx, repo, record)
+				})
+			})
+		case model.CatalogDiscounts:
+			err = decodeRecords(r, options.Format, discountColumns, discountFromCSV, func(line int, record model.DiscountRecord, err error) error {
+				return importer.row(ctx, line, record.Name, err, func(repo repository.CatalogRepository) (bool, []catalogChange, error) {
+					return importDiscount(ctx, repo, record)
+				})
+			})
+		case model.CatalogVariants:
+			err = decodeRecords(r, options.Format, variantColumns, variantFromCSV, func(line int, record model.VariantRecord, err error) error {
+				return importer.row(ctx, line, record.SKU, err, func(repo repository.CatalogRepository) (bool, []catalogChange, error) {
+					return importVariant(ctx, repo, record)
+				})
+			})
+		}
+
+		// A file that cannot be read to the end is never committed
+		var fileErr *fileError
+		if errors.As(err, &fileErr) {
+			report.Errors = append(report.Errors, model.ImportRowError{Line: fileErr.line, Error: fileErr.Error()})
+			return errRollback
+		}
+		if err != nil {
+			return err
+		}
+
+		if options.DryRun || (options.Mode == model.ImportAtomic && report.Failed > 0) {
+			return errRollback
+		}
+
+		changes = importer.changes
+		return nil
+	})
+	if err != nil && !errors.Is(err, errRollback) {
+		return nil, fmt.Errorf("error importing %s: %w", entity, err)
+	}
+
+	report.Committed = err == nil
+	for _, change := range changes {
+		if change.stockDelta != 0 {
+			u.alerter.StockChanged(ctx, change.id, change.stockDelta)
+		}
+		publishCatalogEvent(ctx, u.producer, change.entity, change.action, change.id)
+	}
+
+	return report, nil
+}
+
+// catalogImporter counts the rows of an import and collects what they changed
+type catalogImporter struct {
+	repo    repository.CatalogRepository
+	report  *model.ImportReport
+	changes []catalogChange
+}
+
+// row imports one decoded row in a savepoint and records the outcome in the report.
+// apply reports whether the record of the row was created. Only database failures
+// that are not caused by the row itself abort the import.
+func (i *catalogImporter) row(ctx context.Context, line int, key string, decodeErr error, apply func(repo repository.CatalogRepository) (bool, []catalogChange, error)) error {
+	i.report.Total++
+
+	if decodeErr != nil {
+		i.fail(line, key, decodeErr)
+		return nil
+	}
+
+	var created bool
+	var changes []catalogChange
+	err := i.repo.Transaction(ctx, func(repo repository.CatalogRepository) error {
+		var err error
+		created, changes, err = apply(repo)
+		return err
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		i.fail(line, key, err)
+		return nil
+	}
+
+	if created {
+		i.report.Created++
+	} else {
+		i.report.Updated++
+	}
+	i.changes = append(i.changes, changes...)
+	return nil
+}
+
+func (i *catalogImporter) fail(line int, key string, err error) {
+	i.report.Failed++
+	i.report.Errors = append(i.report.Errors, model.ImportRowError{Line: line, Key: key, Error: err.Error()})
+}
+
+// importCategory creates the category or updates the one with the same name
+func importCategory(ctx context.Context, repo repository.CatalogRepository, record model.CategoryRecord) (bool, []catalogChange, error) {
+	name := strings.TrimSpace(record.Name)
+	if name == "" {
+		return false, nil, errors.New("name is required")
+	}
+	if record.DefaultReorderThreshold != nil && *record.DefaultReorderThreshold < 0 {
+		return false, nil, errors.New("default_reorder_threshold must not be negative")
+	}
+
+	category, err := repo.FindCategoryByName(ctx, name)
+	if err != nil {
+		return false, nil, err
+	}
+
+	action := events.ActionUpdated
+	if category == nil {
+		category = &model.Category{Name: name}
+		action = events.ActionCreated
+	}
+
+	category.Description = record.Description
+	category.DefaultReorderThreshold = record.DefaultReorderThreshold
+	category.ParentID = nil
+
+	if parentName := strings.TrimSpace(record.Parent); parentName != "" {
+		parent, err := repo.FindCategoryByName(ctx, parentName)
+		if err != nil {
+			return false, nil, err
+		}
+		if parent == nil {
+			return false, nil, fmt.Errorf("parent category %q not found", parentName)
+		}
+		category.ParentID = &parent.ID
+	}
+
+	if err := repo.SaveCategory(ctx, category); err != nil {
+		return false, nil, err
+	}
+
+	return action == events.ActionCreated, []catalogChange{{entity: events.EntityCategory, action: action, id: category.ID}}, nil
+}
+
+// importProduct creates the product or updates the one with the same name. The
+// stock level, when given, is set through the stock ledger.
+func importProduct(ctx context.Context, repo repository.CatalogRepository, record model.ProductRecord) (bool, []catalogChange, error) {
+	name := strings.TrimSpace(record.Name)
+	switch {
+	case name == "":
+		return false, nil, errors.New("name is required")
+	case record.Price <= 0:
+		return false, nil, errors.New("price must be greater than 0")
+	case record.StockLevel != nil && *record.StockLevel < 0:
+		return false, nil, errors.New("stock_level must not be negative")
+	case record.ReorderThreshold != nil && *record.ReorderThreshold < 0:
+		return false, nil, errors.New("reorder_threshold must not be negative")
+	}
+
+	categoryName := strings.TrimSpace(record.Category)
+	if categoryName == "" {
+		return false, nil, errors.New("category is required")
+	}
+
+	category, err := repo.FindCategoryByName(ctx, categoryName)
+	if err != nil {
+		return false, nil, err
+	}
+	if category == nil {
+		return false, nil, fmt.Errorf("category %q not found", categoryName)
+	}
+
+	products, err := repo.FindProductsByName(ctx, name)
+	if err != nil {
+		return false, nil, err
+	}
+	if len(products) > 1 {
+		return false, nil, errors.New(model.ErrAmbiguousName)
+	}
+
+	product := &model.Product{Name: name}
+	action := events.ActionCreated
+	if len(products) == 1 {
+		product = &products[0]
+		action = events.ActionUpdated
+	}
+
+	product.Description = record.Description
+	product.Price = record.Price
+	product.CategoryID = category.ID
+	product.ReorderThreshold = record.ReorderThreshold
+
+	if err := repo.SaveProduct(ctx, product); err != nil {
+		return false, nil, err
+	}
+
+	change := catalogChange{entity: events.EntityProduct, action: action, id: product.ID}
+	if record.StockLevel != nil {
+		reason := model.StockMovementAdjustment
+		if action == events.ActionCreated {
+			reason = model.StockMovementRestock
+		}
+
+		movement := newStockMovement(ctx, product.ID, nil, reason)
+		movement.Note = "catalog import"
+		if err := repo.SetStockLevel(ctx, movement, *record.StockLevel); err != nil {
+			return false, nil, err
+		}
+		change.stockDelta = movement.Delta
+	}
+
+	return action == events.ActionCreated, []catalogChange{change}, nil
+}
+
+// importDiscount creates the discount or updates the one with the same name
+func importDiscount(ctx context.Context, repo repository.CatalogRepository, record model.DiscountRecord) (bool, []catalogChange, error) {
+	name := strings.TrimSpace(record.Name)
+	switch {
+	case name == "":
+		return false, nil, errors.New("name is required")
+	case record.DiscountPercentage <= 0 || record.DiscountPercentage > 100:
+		return false, nil, errors.New("discount_percentage must be greater than 0 and at most 100")
+	case record.StartDate.IsZero() || record.EndDate.IsZero():
+		return false, nil, errors.New("start_date and end_date are required")
+	case !record.EndDate.After(record.StartDate):
+		return false, nil, errors.New("end_date must be after start_date")
+	}
+
+	productIDs := make(model.UUIDArray, 0, len(record.Products))
+	for _, productName := range record.Products {
+		productName = strings.TrimSpace(productName)
+
+		products, err := repo.FindProductsByName(ctx, productName)
+		if err != nil {
+			return false, nil, err
+		}
+		switch len(products) {
+		case 0:
+			return false, nil, fmt.Errorf("product %q not found", productName)
+		case 1:
+			productIDs = append(productIDs, products[0].ID)
+		default:
+			return false, nil, fmt.Errorf("product %q: %s", productName, model.ErrAmbiguousName)
+		}
+	}
+
+	discounts, err := repo.FindDiscountsByName(ctx, name)
+	if err != nil {
+		return false, nil, err
+	}
+	if len(discounts) > 1 {
+		return false, nil, errors.New(model.ErrAmbiguousName)
+	}
+
+	discount := &model.Discount{Name: name, IsActive: true}
+	action := events.ActionCreated
+	if len(discounts) == 1 {
+		discount = &discounts[0]
+		action = events.ActionUpdated
+	}
+
+	discount.Description = record.Description
+	discount.DiscountPercentage = record.DiscountPercentage
+	discount.ApplicableProducts = productIDs
+	discount.StartDate = record.StartDate
+	discount.EndDate = record.EndDate
+	if record.IsActive != nil {
+		discount.IsActive = *record.IsActive
+	}
+
+	if err := repo.SaveDiscount(ctx, discount); err != nil {
+		return false, nil, err
+	}
+
+	return action == events.ActionCreated, []catalogChange{{entity: events.EntityDiscount, action: action, id: discount.ID}}, nil
+}
+
+// importVariant creates the variant or updates the one with the same SKU. Variants
+// are part of the product representation, so the change is published as an update
+// of the product. The stock level, when given, is set through the stock ledger.
+func importVariant(ctx context.Context, repo repository.CatalogRepository, record model.VariantRecord) (bool, []catalogChange, error) {
+	sku := strings.TrimSpace(record.SKU)
+	switch {
+	case sku == "":
+		return false, nil, errors.New("sku is required")
+	case len(sku) > 64:
+		return false, nil, errors.New("sku must be at most 64 characters")
+	case record.Price != nil && *record.Price <= 0:
+		return false, nil, errors.New("price must be greater than 0")
+	case record.StockLevel != nil && *record.StockLevel < 0:
+		return false, nil, errors.New("stock_level must not be negative")
+	}
+
+	options, err := normalizeOptions(record.Options)
+	if err != nil {
+		return false, nil, errors.New("option names and values must not be empty")
+	}
+
+	productName := strings.TrimSpace(record.Product)
+	if productName == "" {
+		return false, nil, errors.New("product is required")
+	}
+
+	products, err := repo.FindProductsByName(ctx, productName)
+	if err != nil {
+		return false, nil, err
+	}
+	switch len(products) {
+	case 0:
+		return false, nil, fmt.Errorf("product %q not found", productName)
+	case 1:
+	default:
+		return false, nil, fmt.Errorf("product %q: %s", productName, model.ErrAmbiguousName)
+	}
+	productID := products[0].ID
+
+	variant, err := repo.FindVariantBySKU(ctx, sku)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// A variant never moves to another product, its SKU stays taken instead
+	created := variant == nil
+	if created {
+		variant = &model.ProductVariant{ProductID: productID, SKU: sku}
+	} else if variant.ProductID != productID {
+		return false, nil, errors.New(model.ErrDuplicateSKU)
+	}
+
+	variant.Options = options
+	variant.Price = record.Price
+
+	if err := repo.SaveVariant(ctx, variant); err != nil {
+		return false, nil, err
+	}
+
+	change := catalogChange{entity: events.EntityProduct, action: events.ActionUpdated, id: productID}
+	if record.StockLevel != nil {
+		reason := model.StockMovementAdjustment
+		if created {
+			reason = model.StockMovementRestock
+		}
+
+		movement := newStockMovement(ctx, productID, &variant.ID, reason)
+		movement.Note = "catalog import"
+		if err := repo.SetStockLevel(ctx, movement, *record.StockLevel); err != nil {
+			return false, nil, err
+		}
+		change.stockDelta = movement.Delta
+	}
+
+	return created, []catalogChange{change}, nil
+}
+
+// Export writes every record of the entity to w as it is read, so the whole
+// catalog never has to be held in memory
+func (u *catalogUseCase) Export(ctx context.Context, entity model.CatalogEntity, format model.CatalogFormat, w io.Writer) error {
+	if !entity.Valid() {
+		return errors.New(model.ErrInvalidCatalogEntity)
+	}
+	if !format.Valid() {
+		return errors.New(model.ErrInvalidCatalogFormat)
+	}
+
+	var err error
+	switch entity {
+	case model.CatalogCategories:
+		err = u.exportCategories(ctx, format, w)
+	case model.CatalogProducts:
+		err = u.exportProducts(ctx, format, w)
+	case model.CatalogDiscounts:
+		err = u.exportDiscounts(ctx, format, w)
+	case model.CatalogVariants:
+		err = u.exportVariants(ctx, format, w)
+	}
+	if err != nil {
+		return fmt.Errorf("error exporting %s: %w", entity, err)
+	}
+
+	return nil
+}
+
+func (u *catalogUseCase) exportCategories(ctx context.Context, format model.CatalogFormat, w io.Writer) error {
+	writer, err := newRecordWriter(w, format, categoryColumns)
+	if err != nil {
+		return err
+	}
+
+	// Parents are exported by name, which needs every category
+	names := make(map[uuid.UUID]string)
+	var categories []model.Category
+	if err := u.catalogRepo.ExportCategories(ctx, func(batch []model.Category) error {
+		for _, category := range batch {
+			names[category.ID] = category.Name
+		}
+		categories = append(categories, batch...)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Parents come before their children, so the file can be imported as it is
+	for _, category := range sortParentsFirst(categories) {
+		record := model.CategoryRecord{
+			Name:                    category.Name,
+			Description:             category.Description,
+			DefaultReorderThreshold: category.DefaultReorderThreshold,
+		}
+		if category.ParentID != nil {
+			record.Parent = names[*category.ParentID]
+		}
+
+		if err := writer.Write(record, categoryToCSV(record)); err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
+
+func (u *catalogUseCase) exportProducts(ctx context.Context, format model.CatalogFormat, w io.Writer) error {
+	writer, err := newRecordWriter(w, format, productColumns)
+	if err != nil {
+		return err
+	}
+
+	return u.catalogRepo.ExportProducts(ctx, func(batch []model.Product) error {
+		for _, product := range batch {
+			stockLevel := product.StockLevel
+			record := model.ProductRecord{
+				Name:             product.Name,
+				Description:      product.Description,
+				Price:            product.Price,
+				StockLevel:       &stockLevel,
+				Category:         product.Category.Name,
+				ReorderThreshold: product.ReorderThreshold,
+			}
+
+			if err := writer.Write(record, productToCSV(record)); err != nil {
+				return err
+			}
+		}
+		return writer.Flush()
+	})
+}
+
+func (u *catalogUseCase) exportDiscounts(ctx context.Context, format model.CatalogFormat, w io.Writer) error {
+	writer, err := newRecordWriter(w, format, discountColumns)
+	if err != nil {
+		return err
+	}
+
+	return u.catalogRepo.ExportDiscounts(ctx, func(batch []model.Discount, productNames map[uuid.UUID]string) error {
+		for _, discount := range batch {
+			active := discount.IsActive
+			record := model.DiscountRecord{
+				Name:               discount.Name,
+				Description:        discount.Description,
+				DiscountPercentage: discount.DiscountPercentage,
+				StartDate:          discount.StartDate,
+				EndDate:            discount.EndDate,
+				IsActive:           &active,
+			}
+
+			// Deleted products are left out, as they no longer match any row
+			for _, id := range discount.ApplicableProducts {
+				if name, ok := productNames[id]; ok {
+					record.Products = append(record.Products, name)
+				}
+			}
+
+			if err := writer.Write(record, discountToCSV(record)); err != nil {
+				return err
+			}
+		}
+		return writer.Flush()
+	})
+}
+
+func (u *catalogUseCase) exportVariants(ctx context.Context, format model.CatalogFormat, w io.Writer) error {
+	writer, err := newRecordWriter(w, format, variantColumns)
+	if err != nil {
+		return err
+	}
+
+	return u.catalogRepo.ExportVariants(ctx, func(batch []model.ProductVariant, productNames map[uuid.UUID]string) error {
+		for _, variant := range batch {
+			stockLevel := variant.StockLevel
+			record := model.VariantRecord{
+				SKU:        variant.SKU,
+				Product:    productNames[variant.ProductID],
+				Options:    variant.Options,
+				Price:      variant.Price,
+				StockLevel: &stockLevel,
+			}
+
+			if err := writer.Write(record, variantToCSV(record)); err != nil {
+				return err
+			}
+		}
+		return writer.Flush()
+	})
+}
+
+// sortParentsFirst orders categories by depth in the tree and by name within a depth
+func sortParentsFirst(categories []model.Category) []model.Category {
+	parents := make(map[uuid.UUID]*uuid.UUID, len(categories))
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+
+	depths := make(map[uuid.UUID]int, len(categories))
+	var depth func(id uuid.UUID) int
+	depth = func(id uuid.UUID) int {
+		if d, ok := depths[id]; ok {
+			return d
+		}
+		d := 0
+		if parent := parents[id]; parent != nil {
+			if _, ok := parents[*parent]; ok {
+				d = depth(*parent) + 1
+			}
+		}
+		depths[id] = d
+		return d
+	}
+
+	sort.SliceStable(categories, func(i, j int) bool {
+		di, dj := depth(categories[i].ID), depth(categories[j].ID)
+		if di != dj {
+			return di < dj
+		}
+		return categories[i].Name < categories[j].Name
+	})
+	return categories
+}
@@ -1,40 +1,60 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
 	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
 	"github.com/google/uuid"
 )
 
 type categoryUseCase struct {
 	categoryRepo repository.CategoryRepository
+	producer     *kafka.Producer
 }
 
 // NewCategoryUseCase creates a new category use case
-func NewCategoryUseCase(categoryRepo repository.CategoryRepository) CategoryUseCase {
+func NewCategoryUseCase(categoryRepo repository.CategoryRepository, producer *kafka.Producer) CategoryUseCase {
 	return &categoryUseCase{
 		categoryRepo: categoryRepo,
+		producer:     producer,
 	}
 }
 
-func (u *categoryUseCase) CreateCategory(request model.CreateCategoryRequest) (*model.Category, error) {
+func (u *categoryUseCase) CreateCategory(ctx context.Context, request model.CreateCategoryRequest) (*model.Category, error) {
+	if request.ParentID != nil {
+		parent, err := u.categoryRepo.FindByID(ctx, *request.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("error finding parent category: %w", err)
+		}
+
+		if parent == nil {
+			return nil, errors.New(model.ErrParentNotFound)
+		}
+	}
+
 	category := &model.Category{
-		Name:        request.Name,
-		Description: request.Description,
+		Name:                    request.Name,
+		Description:             request.Description,
+		ParentID:                request.ParentID,
+		DefaultReorderThreshold: request.DefaultReorderThreshold,
 	}
 
-	if err := u.categoryRepo.Create(category); err != nil {
+	if err := u.categoryRepo.Create(ctx, category); err != nil {
 		return nil, fmt.Errorf("error creating category: %w", err)
 	}
 
+	publishCatalogEvent(ctx, u.producer, events.EntityCategory, events.ActionCreated, category.ID)
 	return category, nil
 }
 
-func (u *categoryUseCase) GetCategoryByID(id uuid.UUID) (*model.Category, error) {
-	category, err := u.categoryRepo.FindByID(id)
+func (u *categoryUseCase) GetCategoryByID(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	category, err := u.categoryRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("error finding category: %w", err)
 	}
@@ -46,8 +66,8 @@ func (u *categoryUseCase) GetCategoryByID(id uuid.UUID) (*model.Category, error)
 	return category, nil
 }
 
-func (u *categoryUseCase) UpdateCategory(id uuid.UUID, request model.UpdateCategoryRequest) (*model.Category, error) {
-	category, err := u.categoryRepo.FindByID(id)
+func (u *categoryUseCase) UpdateCategory(ctx context.Context, id uuid.UUID, request model.UpdateCategoryRequest) (*model.Category, error) {
+	category, err := u.categoryRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("error finding category: %w", err)
 	}
@@ -64,15 +84,22 @@ func (u *categoryUseCase) UpdateCategory(id uuid.UUID, request model.UpdateCateg
 		category.Description = *request.Description
 	}
 
-	if err := u.categoryRepo.Update(category); err != nil {
+	if request.ClearDefaultReorderThreshold {
+		category.DefaultReorderThreshold = nil
+	} else if request.DefaultReorderThreshold != nil {
+		category.DefaultReorderThreshold = request.DefaultReorderThreshold
+	}
+
+	if err := u.categoryRepo.Update(ctx, category); err != nil {
 		return nil, fmt.Errorf("error updating category: %w", err)
 	}
 
+	publishCatalogEvent(ctx, u.producer, events.EntityCategory, events.ActionUpdated, category.ID)
 	return category, nil
 }
 
-func (u *categoryUseCase) DeleteCategory(id uuid.UUID) error {
-	category, err := u.categoryRepo.FindByID(id)
+func (u *categoryUseCase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	category, err := u.categoryRepo.FindByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("error finding category: %w", err)
 	}
@@ -82,7 +109,7 @@ func (u *categoryUseCase) DeleteCategory(id uuid.UUID) error {
 	}
 
 	// Check if there are products using this category
-	hasProducts, err := u.categoryRepo.HasProducts(id)
+	hasProducts, err := u.categoryRepo.HasProducts(ctx, id)
 	if err != nil {
 		return fmt.Errorf("error checking for products: %w", err)
 	}
@@ -91,13 +118,100 @@ func (u *categoryUseCase) DeleteCategory(id uuid.UUID) error {
 		return errors.New("cannot delete category with associated products")
 	}
 
-	if err := u.categoryRepo.Delete(id); err != nil {
+	hasChildren, err := u.categoryRepo.HasChildren(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error checking for subcategories: %w", err)
+	}
+
+	if hasChildren {
+		return errors.New(model.ErrCategoryHasChildren)
+	}
+
+	if err := u.categoryRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("error deleting category: %w", err)
 	}
 
+	publishCatalogEvent(ctx, u.producer, events.EntityCategory, events.ActionDeleted, id)
 	return nil
 }
 
-func (u *categoryUseCase) ListCategories() ([]model.Category, error) {
-	return u.categoryRepo.FindAll()
+func (u *categoryUseCase) ListCategories(ctx context.Context) ([]model.Category, error) {
+	return u.categoryRepo.FindAll(ctx)
+}
+
+// GetCategoryTree returns the root categories with their descendants, or only the
+// given category with its descendants. Siblings are sorted by name.
+func (u *categoryUseCase) GetCategoryTree(ctx context.Context, rootID *uuid.UUID) ([]model.Category, error) {
+	categories, err := u.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error finding categories: %w", err)
+	}
+
+	children := make(map[uuid.UUID][]model.Category)
+	var roots []model.Category
+	for _, category := range categories {
+		switch {
+		case rootID != nil && category.ID == *rootID:
+			roots = append(roots, category)
+		case category.ParentID != nil:
+			children[*category.ParentID] = append(children[*category.ParentID], category)
+		case rootID == nil:
+			roots = append(roots, category)
+		}
+	}
+
+	if rootID != nil && len(roots) == 0 {
+		return nil, errors.New(model.ErrCategoryNotFound)
+	}
+
+	return attachChildren(roots, children), nil
+}
+
+// attachChildren fills in the children of the categories and their descendants
+func attachChildren(categories []model.Category, children map[uuid.UUID][]model.Category) []model.Category {
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Name < categories[j].Name
+	})
+
+	for i := range categories {
+		categories[i].Children = attachChildren(children[categories[i].ID], children)
+	}
+
+	return categories
+}
+
+// GetCategoryBreadcrumbs returns the path from the root down to the category
+func (u *categoryUseCase) GetCategoryBreadcrumbs(ctx context.Context, id uuid.UUID) ([]model.Category, error) {
+	categories, err := u.categoryRepo.FindAncestors(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error finding category ancestors: %w", err)
+	}
+
+	if len(categories) == 0 {
+		return nil, errors.New(model.ErrCategoryNotFound)
+	}
+
+	return categories, nil
+}
+
+func (u *categoryUseCase) MoveCategory(ctx context.Context, id uuid.UUID, request model.MoveCategoryRequest) (*model.Category, error) {
+	if err := u.categoryRepo.Move(ctx, id, request.ParentID); err != nil {
+		switch err.Error() {
+		case model.ErrCategoryNotFound, model.ErrParentNotFound, model.ErrCategoryCycle:
+			return nil, err
+		}
+		return nil, fmt.Errorf("error moving category: %w", err)
+	}
+
+	category, err := u.categoryRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error finding category: %w", err)
+	}
+
+	if category == nil {
+		return nil, errors.New(model.ErrCategoryNotFound)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityCategory, events.ActionUpdated, category.ID)
+	return category, nil
 }
@@ -1,22 +1,26 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
 	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
 	"github.com/google/uuid"
 )
 
 type DiscountUseCase interface {
-	CreateDiscount(request model.CreateDiscountRequest) (*model.Discount, error)
-	GetDiscountByID(id uuid.UUID) (*model.Discount, error)
-	UpdateDiscount(id uuid.UUID, request model.UpdateDiscountRequest) (*model.Discount, error)
-	DeleteDiscount(id uuid.UUID) error
-	ListDiscounts() ([]model.Discount, error)
-	GetAllProductsWithPromotion() ([]ProductWithPromotions, error)
-	GetProductsByDiscountID(discountID uuid.UUID) ([]model.Product, error)
+	CreateDiscount(ctx context.Context, request model.CreateDiscountRequest) (*model.Discount, error)
+	GetDiscountByID(ctx context.Context, id uuid.UUID) (*model.Discount, error)
+	UpdateDiscount(ctx context.Context, id uuid.UUID, request model.UpdateDiscountRequest) (*model.Discount, error)
+	DeleteDiscount(ctx context.Context, id uuid.UUID) error
+	ListDiscounts(ctx context.Context) ([]model.Discount, error)
+	GetAllProductsWithPromotion(ctx context.Context) ([]ProductWithPromotions, error)
+	GetProductsByDiscountID(ctx context.Context, discountID uuid.UUID) ([]model.Product, error)
+	GetActiveDiscountsForProducts(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID][]model.Discount, error)
 }
 
 type ProductWithPromotions struct {
@@ -27,17 +31,19 @@ type ProductWithPromotions struct {
 type discountUseCase struct {
 	discountRepo repository.DiscountRepository
 	productRepo  repository.ProductRepository
+	producer     *kafka.Producer
 }
 
 // NewDiscountUseCase creates a new discount use case
-func NewDiscountUseCase(discountRepo repository.DiscountRepository, productRepo repository.ProductRepository) DiscountUseCase {
+func NewDiscountUseCase(discountRepo repository.DiscountRepository, productRepo repository.ProductRepository, producer *kafka.Producer) DiscountUseCase {
 	return &discountUseCase{
 		discountRepo: discountRepo,
 		productRepo:  productRepo,
+		producer:     producer,
 	}
 }
 
-func (u *discountUseCase) CreateDiscount(request model.CreateDiscountRequest) (*model.Discount, error) {
+func (u *discountUseCase) CreateDiscount(ctx context.Context, request model.CreateDiscountRequest) (*model.Discount, error) {
 	discount := &model.Discount{
 		Name:               request.Name,
 		Description:        request.Description,
@@ -48,15 +54,16 @@ func (u *discountUseCase) CreateDiscount(request model.CreateDiscountRequest) (*
 		IsActive:           true,
 	}
 
-	if err := u.discountRepo.Create(discount); err != nil {
+	if err := u.discountRepo.Create(ctx, discount); err != nil {
 		return nil, fmt.Errorf("error creating discount: %w", err)
 	}
 
+	publishCatalogEvent(ctx, u.producer, events.EntityDiscount, events.ActionCreated, discount.ID)
 	return discount, nil
 }
 
-func (u *discountUseCase) GetDiscountByID(id uuid.UUID) (*model.Discount, error) {
-	discount, err := u.discountRepo.FindByID(id)
+func (u *discountUseCase) GetDiscountByID(ctx context.Context, id uuid.UUID) (*model.Discount, error) {
+	discount, err := u.discountRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("error finding discount: %w", err)
 	}
@@ -68,8 +75,8 @@ func (u *discountUseCase) GetDiscountByID(id uuid.UUID) (*model.Discount, error)
 	return discount, nil
 }
 
-func (u *discountUseCase) UpdateDiscount(id uuid.UUID, request model.UpdateDiscountRequest) (*model.Discount, error) {
-	discount, err := u.discountRepo.FindByID(id)
+func (u *discountUseCase) UpdateDiscount(ctx context.Context, id uuid.UUID, request model.UpdateDiscountRequest) (*model.Discount, error) {
+	discount, err := u.discountRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("error finding discount: %w", err)
 	}
@@ -107,15 +114,16 @@ func (u *discountUseCase) UpdateDiscount(id uuid.UUID, request model.UpdateDisco
 		discount.IsActive = *request.IsActive
 	}
 
-	if err := u.discountRepo.Update(discount); err != nil {
+	if err := u.discountRepo.Update(ctx, discount); err != nil {
 		return nil, fmt.Errorf("error updating discount: %w", err)
 	}
 
+	publishCatalogEvent(ctx, u.producer, events.EntityDiscount, events.ActionUpdated, discount.ID)
 	return discount, nil
 }
 
-func (u *discountUseCase) DeleteDiscount(id uuid.UUID) error {
-	discount, err := u.discountRepo.FindByID(id)
+func (u *discountUseCase) DeleteDiscount(ctx context.Context, id uuid.UUID) error {
+	discount, err := u.discountRepo.FindByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("error finding discount: %w", err)
 	}
@@ -124,26 +132,27 @@ func (u *discountUseCase) DeleteDiscount(id uuid.UUID) error {
 		return errors.New(model.ErrDiscountNotFound)
 	}
 
-	if err := u.discountRepo.Delete(id); err != nil {
+	if err := u.discountRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("error deleting discount: %w", err)
 	}
 
+	publishCatalogEvent(ctx, u.producer, events.EntityDiscount, events.ActionDeleted, id)
 	return nil
 }
 
-func (u *discountUseCase) ListDiscounts() ([]model.Discount, error) {
-	return u.discountRepo.FindAll()
+func (u *discountUseCase) ListDiscounts(ctx context.Context) ([]model.Discount, error) {
+	return u.discountRepo.FindAll(ctx)
 }
 
-func (u *discountUseCase) GetAllProductsWithPromotion() ([]ProductWithPromotions, error) {
+func (u *discountUseCase) GetAllProductsWithPromotion(ctx context.Context) ([]ProductWithPromotions, error) {
 	// Get all active discounts
-	discounts, err := u.discountRepo.FindAllWithProducts()
+	discounts, err := u.discountRepo.FindAllWithProducts(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error finding discounts: %w", err)
 	}
 
 	// Get all products
-	products, _, err := u.productRepo.List(repository.ListProductParams{})
+	products, _, err := u.productRepo.List(ctx, repository.ListProductParams{})
 	if err != nil {
 		return nil, fmt.Errorf("error finding products: %w", err)
 	}
@@ -159,23 +168,7 @@ func (u *discountUseCase) GetAllProductsWithPromotion() ([]ProductWithPromotions
 
 		// Check which discounts apply to this product
 		for _, discount := range discounts {
-			// Check if the discount applies to this product
-			isApplicable := false
-
-			// If there are no applicable products, it applies to all
-			if len(discount.ApplicableProducts) == 0 {
-				isApplicable = true
-			} else {
-				// Check if product ID is in the applicable products list
-				for _, applicableProductID := range discount.ApplicableProducts {
-					if product.ID == applicableProductID {
-						isApplicable = true
-						break
-					}
-				}
-			}
-
-			if isApplicable {
+			if discountAppliesTo(discount, product.ID) {
 				productWithDiscounts.Discounts = append(productWithDiscounts.Discounts, discount)
 			}
 		}
@@ -189,9 +182,9 @@ func (u *discountUseCase) GetAllProductsWithPromotion() ([]ProductWithPromotions
 	return result, nil
 }
 
-func (u *discountUseCase) GetProductsByDiscountID(discountID uuid.UUID) ([]model.Product, error) {
+func (u *discountUseCase) GetProductsByDiscountID(ctx context.Context, discountID uuid.UUID) ([]model.Product, error) {
 	// First, verify the discount exists
-	discount, err := u.discountRepo.FindByID(discountID)
+	discount, err := u.discountRepo.FindByID(ctx, discountID)
 	if err != nil {
 		return nil, fmt.Errorf("error finding discount: %w", err)
 	}
@@ -202,7 +195,7 @@ func (u *discountUseCase) GetProductsByDiscountID(discountID uuid.UUID) ([]model
 
 	// If there are no applicable products, it means the discount applies to all products
 	if len(discount.ApplicableProducts) == 0 {
-		products, _, err := u.productRepo.List(repository.ListProductParams{})
+		products, _, err := u.productRepo.List(ctx, repository.ListProductParams{})
 		if err != nil {
 			return nil, fmt.Errorf("error finding products: %w", err)
 		}
@@ -213,7 +206,7 @@ func (u *discountUseCase) GetProductsByDiscountID(discountID uuid.UUID) ([]model
 	result := []model.Product{}
 
 	for _, productID := range discount.ApplicableProducts {
-		product, err := u.productRepo.FindByID(productID)
+		product, err := u.productRepo.FindByID(ctx, productID)
 		if err != nil {
 			continue // Skip errors for individual products
 		}
@@ -225,3 +218,40 @@ func (u *discountUseCase) GetProductsByDiscountID(discountID uuid.UUID) ([]model
 
 	return result, nil
 }
+
+func (u *discountUseCase) GetActiveDiscountsForProducts(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID][]model.Discount, error) {
+	// Get all active discounts
+	discounts, err := u.discountRepo.FindAllWithProducts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error finding discounts: %w", err)
+	}
+
+	result := make(map[uuid.UUID][]model.Discount, len(productIDs))
+	for _, productID := range productIDs {
+		productDiscounts := []model.Discount{}
+		for _, discount := range discounts {
+			if discountAppliesTo(discount, productID) {
+				productDiscounts = append(productDiscounts, discount)
+			}
+		}
+		result[productID] = productDiscounts
+	}
+
+	return result, nil
+}
+
+// discountAppliesTo checks if a discount applies to a product.
+// A discount without applicable products applies to all of them.
+func discountAppliesTo(discount model.Discount, productID uuid.UUID) bool {
+	if len(discount.ApplicableProducts) == 0 {
+		return true
+	}
+
+	for _, applicableProductID := range discount.ApplicableProducts {
+		if applicableProductID == productID {
+			return true
+		}
+	}
+
+	return false
+}
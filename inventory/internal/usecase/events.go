@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// publishCatalogEvent announces a catalog change. Publishing is best effort:
+// a failure is logged and does not fail the operation that caused it.
+func publishCatalogEvent(ctx context.Context, producer *kafka.Producer, entity, action string, id uuid.UUID) {
+	if producer == nil {
+		return
+	}
+
+	event := events.CatalogEvent{
+		Entity:    entity,
+		Action:    action,
+		ID:        id.String(),
+		Timestamp: time.Now(),
+	}
+
+	value, err := json.Marshal(event)
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to encode %s %s event: %v", entity, action, err)
+		return
+	}
+
+	if err := producer.PublishEvent(ctx, id.String(), value); err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to publish %s %s event: %v", entity, action, err)
+	}
+}
@@ -1,6 +1,9 @@
 package usecase
 
 import (
+	"context"
+	"io"
+
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
 	"github.com/baccala1010/e-commerce/inventory/internal/repository"
 	"github.com/google/uuid"
@@ -8,18 +11,74 @@ import (
 
 // ProductUseCase defines the business logic for product operations
 type ProductUseCase interface {
-	CreateProduct(request model.CreateProductRequest) (*model.Product, error)
-	GetProductByID(id uuid.UUID) (*model.Product, error)
-	UpdateProduct(id uuid.UUID, request model.UpdateProductRequest) (*model.Product, error)
-	DeleteProduct(id uuid.UUID) error
-	ListProducts(params repository.ListProductParams) ([]model.Product, int64, error)
+	CreateProduct(ctx context.Context, request model.CreateProductRequest) (*model.Product, error)
+	GetProductByID(ctx context.Context, id uuid.UUID) (*model.Product, error)
+	GetProductsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Product, error)
+	UpdateProduct(ctx context.Context, id uuid.UUID, request model.UpdateProductRequest) (*model.Product, error)
+	DeleteProduct(ctx context.Context, id uuid.UUID) error
+	ListProducts(ctx context.Context, params repository.ListProductParams) ([]model.Product, int64, error)
+	ListProductsAfter(ctx context.Context, params repository.ListProductParams, cursor string) ([]model.Product, string, error)
+	GetProductFacets(ctx context.Context, params repository.ListProductParams) (*model.ProductFacets, error)
+	ListLowStockProducts(ctx context.Context, page, pageSize int) ([]model.Product, int64, error)
+}
+
+// VariantUseCase defines the business logic for the variants of a product
+type VariantUseCase interface {
+	CreateVariant(ctx context.Context, productID uuid.UUID, request model.CreateVariantRequest) (*model.ProductVariant, error)
+	GetVariant(ctx context.Context, productID, id uuid.UUID) (*model.ProductVariant, error)
+	ListVariants(ctx context.Context, productID uuid.UUID) ([]model.ProductVariant, error)
+	UpdateVariant(ctx context.Context, productID, id uuid.UUID, request model.UpdateVariantRequest) (*model.ProductVariant, error)
+	DeleteVariant(ctx context.Context, productID, id uuid.UUID) error
+}
+
+// MediaUseCase defines the business logic for the images and files of a product
+type MediaUseCase interface {
+	UploadMedia(ctx context.Context, productID uuid.UUID, upload model.MediaUpload) (*model.ProductMedia, error)
+	ListMedia(ctx context.Context, productID uuid.UUID) ([]model.ProductMedia, error)
+	ReorderMedia(ctx context.Context, productID uuid.UUID, request model.ReorderMediaRequest) ([]model.ProductMedia, error)
+	DeleteMedia(ctx context.Context, productID, id uuid.UUID) error
+	OpenMedia(ctx context.Context, productID, id uuid.UUID, thumbnail bool) (*model.ProductMedia, io.ReadCloser, error)
+	DeleteProductMedia(ctx context.Context, productID uuid.UUID) error
+}
+
+// WarehouseUseCase defines the business logic for warehouse operations
+type WarehouseUseCase interface {
+	CreateWarehouse(ctx context.Context, request model.CreateWarehouseRequest) (*model.Warehouse, error)
+	GetWarehouseByID(ctx context.Context, id uuid.UUID) (*model.Warehouse, error)
+	UpdateWarehouse(ctx context.Context, id uuid.UUID, request model.UpdateWarehouseRequest) (*model.Warehouse, error)
+	DeleteWarehouse(ctx context.Context, id uuid.UUID) error
+	ListWarehouses(ctx context.Context) ([]model.Warehouse, error)
+}
+
+// StockUseCase defines the business logic for stock held in warehouses
+type StockUseCase interface {
+	SetStock(ctx context.Context, warehouseID uuid.UUID, request model.SetStockRequest) (*model.WarehouseStock, error)
+	GetWarehouseStock(ctx context.Context, warehouseID uuid.UUID) ([]model.WarehouseStock, error)
+	GetProductStock(ctx context.Context, productID uuid.UUID) ([]model.WarehouseStock, error)
+	TransferStock(ctx context.Context, request model.TransferStockRequest) (*model.StockTransfer, error)
+	ListTransfers(ctx context.Context, warehouseID uuid.UUID) ([]model.StockTransfer, error)
+}
+
+// StockLedgerUseCase defines the business logic for recorded changes of stock levels
+type StockLedgerUseCase interface {
+	AdjustStock(ctx context.Context, productID uuid.UUID, request model.AdjustStockRequest) (*model.StockMovement, error)
+	ListStockMovements(ctx context.Context, params repository.ListStockMovementParams) ([]model.StockMovement, int64, error)
 }
 
 // CategoryUseCase defines the business logic for category operations
 type CategoryUseCase interface {
-	CreateCategory(request model.CreateCategoryRequest) (*model.Category, error)
-	GetCategoryByID(id uuid.UUID) (*model.Category, error)
-	UpdateCategory(id uuid.UUID, request model.UpdateCategoryRequest) (*model.Category, error)
-	DeleteCategory(id uuid.UUID) error
-	ListCategories() ([]model.Category, error)
+	CreateCategory(ctx context.Context, request model.CreateCategoryRequest) (*model.Category, error)
+	GetCategoryByID(ctx context.Context, id uuid.UUID) (*model.Category, error)
+	UpdateCategory(ctx context.Context, id uuid.UUID, request model.UpdateCategoryRequest) (*model.Category, error)
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+	ListCategories(ctx context.Context) ([]model.Category, error)
+	GetCategoryTree(ctx context.Context, rootID *uuid.UUID) ([]model.Category, error)
+	GetCategoryBreadcrumbs(ctx context.Context, id uuid.UUID) ([]model.Category, error)
+	MoveCategory(ctx context.Context, id uuid.UUID, request model.MoveCategoryRequest) (*model.Category, error)
+}
+
+// CatalogUseCase defines the business logic for bulk imports and exports of the catalog
+type CatalogUseCase interface {
+	Import(ctx context.Context, entity model.CatalogEntity, r io.Reader, options model.ImportOptions) (*model.ImportReport, error)
+	Export(ctx context.Context, entity model.CatalogEntity, format model.CatalogFormat, w io.Writer) error
 }
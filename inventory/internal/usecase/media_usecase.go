@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/blob"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/imaging"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// maxThumbnailPixels bounds the images that are decoded for a thumbnail, since a
+// small compressed upload can expand to an enormous bitmap
+const maxThumbnailPixels = 40_000_000
+
+type mediaUseCase struct {
+	mediaRepo     repository.MediaRepository
+	productRepo   repository.ProductRepository
+	store         blob.Store
+	maxSize       int64
+	thumbnailSize int
+	producer      *kafka.Producer
+}
+
+// NewMediaUseCase creates a new media use case
+func NewMediaUseCase(mediaRepo repository.MediaRepository, productRepo repository.ProductRepository, store blob.Store, maxSize int64, thumbnailSize int, producer *kafka.Producer) MediaUseCase {
+	return &mediaUseCase{
+		mediaRepo:     mediaRepo,
+		productRepo:   productRepo,
+		store:         store,
+		maxSize:       maxSize,
+		thumbnailSize: thumbnailSize,
+		producer:      producer,
+	}
+}
+
+func (u *mediaUseCase) UploadMedia(ctx context.Context, productID uuid.UUID, upload model.MediaUpload) (*model.ProductMedia, error) {
+	if err := u.ensureProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	if len(upload.Data) == 0 {
+		return nil, errors.New(model.ErrEmptyMedia)
+	}
+
+	if int64(len(upload.Data)) > u.maxSize {
+		return nil, errors.New(model.ErrMediaTooLarge)
+	}
+
+	media := &model.ProductMedia{
+		ID:          uuid.New(),
+		ProductID:   productID,
+		Kind:        model.MediaKindFile,
+		FileName:    cleanFileName(upload.FileName),
+		ContentType: contentType(upload),
+		Size:        int64(len(upload.Data)),
+	}
+	media.BlobKey = mediaKey(media, "original")
+
+	// Anything that decodes as an image is stored as one, whatever the client claimed
+	var thumbnail *imaging.Thumbnail
+	if info, err := imaging.Inspect(upload.Data); err == nil {
+		media.Kind = model.MediaKindImage
+		media.ContentType = "image/" + info.Format
+		media.Width, media.Height = &info.Width, &info.Height
+
+		if info.Width*info.Height <= maxThumbnailPixels {
+			thumbnail, err = imaging.MakeThumbnail(upload.Data, u.thumbnailSize)
+			if err != nil {
+				logrus.WithContext(ctx).Warnf("Failed to create thumbnail of media %s: %v", media.ID, err)
+			}
+		}
+	}
+
+	if err := u.store.Put(ctx, media.BlobKey, upload.Data, media.ContentType); err != nil {
+		return nil, fmt.Errorf("error storing media: %w", err)
+	}
+
+	if thumbnail != nil {
+		thumbnailKey := mediaKey(media, "thumbnail")
+		if err := u.store.Put(ctx, thumbnailKey, thumbnail.Data, thumbnail.ContentType); err != nil {
+			logrus.WithContext(ctx).Warnf("Failed to store thumbnail of media %s: %v", media.ID, err)
+		} else {
+			media.ThumbnailKey = thumbnailKey
+			media.HasThumbnail = true
+		}
+	}
+
+	if err := u.mediaRepo.Create(ctx, media); err != nil {
+		u.deleteBlobs(ctx, *media)
+		return nil, fmt.Errorf("error creating media: %w", err)
+	}
+
+	// Media is part of the product representation
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, productID)
+	return media, nil
+}
+
+func (u *mediaUseCase) ListMedia(ctx context.Context, productID uuid.UUID) ([]model.ProductMedia, error) {
+	if err := u.ensureProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	media, err := u.mediaRepo.ListByProduct(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing media: %w", err)
+	}
+
+	return media, nil
+}
+
+func (u *mediaUseCase) ReorderMedia(ctx context.Context, productID uuid.UUID, request model.ReorderMediaRequest) ([]model.ProductMedia, error) {
+	if err := u.ensureProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	if err := u.mediaRepo.Reorder(ctx, productID, request.MediaIDs); err != nil {
+		if err.Error() == model.ErrInvalidMediaOrder {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error reordering media: %w", err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, productID)
+	return u.ListMedia(ctx, productID)
+}
+
+func (u *mediaUseCase) DeleteMedia(ctx context.Context, productID, id uuid.UUID) error {
+	media, err := u.findMedia(ctx, productID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.mediaRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("error deleting media: %w", err)
+	}
+
+	u.deleteBlobs(ctx, *media)
+
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, productID)
+	return nil
+}
+
+func (u *mediaUseCase) OpenMedia(ctx context.Context, productID, id uuid.UUID, thumbnail bool) (*model.ProductMedia, io.ReadCloser, error) {
+	media, err := u.findMedia(ctx, productID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := media.BlobKey
+	if thumbnail {
+		if media.ThumbnailKey == "" {
+			return nil, nil, errors.New(model.ErrMediaNotFound)
+		}
+		key = media.ThumbnailKey
+	}
+
+	content, err := u.store.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return nil, nil, errors.New(model.ErrMediaNotFound)
+		}
+		return nil, nil, fmt.Errorf("error opening media: %w", err)
+	}
+
+	return media, content, nil
+}
+
+// DeleteProductMedia removes every media of a product together with its blobs.
+// Blobs that cannot be deleted are logged and left behind, so the metadata never
+// points at content that is gone.
+func (u *mediaUseCase) DeleteProductMedia(ctx context.Context, productID uuid.UUID) error {
+	media, err := u.mediaRepo.DeleteByProduct(ctx, productID)
+	if err != nil {
+		return fmt.Errorf("error deleting media: %w", err)
+	}
+
+	for _, m := range media {
+		u.deleteBlobs(ctx, m)
+	}
+
+	return nil
+}
+
+// deleteBlobs removes the content and the thumbnail of media, logging failures
+func (u *mediaUseCase) deleteBlobs(ctx context.Context, media model.ProductMedia) {
+	for _, key := range []string{media.BlobKey, media.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+
+		if err := u.store.Delete(ctx, key); err != nil {
+			logrus.WithContext(ctx).Errorf("Failed to delete blob %s of media %s: %v", key, media.ID, err)
+		}
+	}
+}
+
+// findMedia returns the media with the given ID if it belongs to the product
+func (u *mediaUseCase) findMedia(ctx context.Context, productID, id uuid.UUID) (*model.ProductMedia, error) {
+	media, err := u.mediaRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error finding media: %w", err)
+	}
+
+	if media == nil || media.ProductID != productID {
+		return nil, errors.New(model.ErrMediaNotFound)
+	}
+
+	return media, nil
+}
+
+// ensureProduct checks that the product exists
+func (u *mediaUseCase) ensureProduct(ctx context.Context, productID uuid.UUID) error {
+	product, err := u.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return fmt.Errorf("error finding product: %w", err)
+	}
+
+	if product == nil {
+		return errors.New(model.ErrProductNotFound)
+	}
+
+	return nil
+}
+
+// mediaKey returns the blob key of a rendition of media
+func mediaKey(media *model.ProductMedia, rendition string) string {
+	return fmt.Sprintf("products/%s/%s/%s", media.ProductID, media.ID, rendition)
+}
+
+// cleanFileName strips any directories from a client supplied file name
+func cleanFileName(name string) string {
+	name = strings.TrimSpace(path.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return "file"
+	}
+	if runes := []rune(name); len(runes) > 255 {
+		name = string(runes[:255])
+	}
+	return name
+}
+
+// contentType returns the declared content type of an upload, or a sniffed one
+// when the client sent none or an unparsable one
+func contentType(upload model.MediaUpload) string {
+	if mediaType, params, err := mime.ParseMediaType(upload.ContentType); err == nil && mediaType != "application/octet-stream" {
+		return mime.FormatMediaType(mediaType, params)
+	}
+	return http.DetectContentType(upload.Data)
+}
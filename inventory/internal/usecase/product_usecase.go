@@ -1,31 +1,40 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
 
 	"github.com/baccala1010/e-commerce/inventory/internal/model"
 	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 )
 
 type productUseCase struct {
 	productRepo  repository.ProductRepository
 	categoryRepo repository.CategoryRepository
+	media        MediaUseCase
+	alerter      *StockAlerter
+	producer     *kafka.Producer
 }
 
 // NewProductUseCase creates a new product use case
-func NewProductUseCase(productRepo repository.ProductRepository, categoryRepo repository.CategoryRepository, producer *kafka.Producer) ProductUseCase {
+func NewProductUseCase(productRepo repository.ProductRepository, categoryRepo repository.CategoryRepository, media MediaUseCase, alerter *StockAlerter, producer *kafka.Producer) ProductUseCase {
 	return &productUseCase{
 		productRepo:  productRepo,
 		categoryRepo: categoryRepo,
+		media:        media,
+		alerter:      alerter,
+		producer:     producer,
 	}
 }
 
-func (u *productUseCase) CreateProduct(request model.CreateProductRequest) (*model.Product, error) {
+func (u *productUseCase) CreateProduct(ctx context.Context, request model.CreateProductRequest) (*model.Product, error) {
 	// Verify category exists
-	category, err := u.categoryRepo.FindByID(request.CategoryID)
+	category, err := u.categoryRepo.FindByID(ctx, request.CategoryID)
 	if err != nil {
 		return nil, fmt.Errorf("error finding category: %w", err)
 	}
@@ -35,22 +44,31 @@ func (u *productUseCase) CreateProduct(request model.CreateProductRequest) (*mod
 	}
 
 	product := &model.Product{
-		Name:        request.Name,
-		Description: request.Description,
-		Price:       request.Price,
-		StockLevel:  request.StockLevel,
-		CategoryID:  request.CategoryID,
+		Name:             request.Name,
+		Description:      request.Description,
+		Price:            request.Price,
+		StockLevel:       request.StockLevel,
+		CategoryID:       request.CategoryID,
+		ReorderThreshold: request.ReorderThreshold,
+	}
+
+	// The initial stock is booked through the ledger like any other change, in
+	// the transaction creating the product
+	stock := initialStockMovement(ctx, request.StockLevel)
+	if err := u.productRepo.Create(ctx, product, stock); err != nil {
+		return nil, fmt.Errorf("error creating product: %w", err)
 	}
 
-	if err := u.productRepo.Create(product); err != nil {
-		return nil, fmt.Errorf("error creating product: %w", err)
+	if stock != nil {
+		u.alerter.StockChanged(ctx, product.ID, stock.Delta)
 	}
 
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionCreated, product.ID)
 	return product, nil
 }
 
-func (u *productUseCase) GetProductByID(id uuid.UUID) (*model.Product, error) {
-	product, err := u.productRepo.FindByID(id)
+func (u *productUseCase) GetProductByID(ctx context.Context, id uuid.UUID) (*model.Product, error) {
+	product, err := u.productRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("error finding product: %w", err)
 	}
@@ -62,8 +80,26 @@ func (u *productUseCase) GetProductByID(id uuid.UUID) (*model.Product, error) {
 	return product, nil
 }
 
-func (u *productUseCase) UpdateProduct(id uuid.UUID, request model.UpdateProductRequest) (*model.Product, error) {
-	product, err := u.productRepo.FindByID(id)
+func (u *productUseCase) GetProductsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Product, error) {
+	if len(ids) == 0 {
+		return []model.Product{}, nil
+	}
+
+	products, err := u.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("error finding products: %w", err)
+	}
+
+	return products, nil
+}
+
+func (u *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, request model.UpdateProductRequest) (*model.Product, error) {
+	// Stock changes are recorded with their reason through stock adjustments
+	if request.StockLevel != nil {
+		return nil, errors.New(model.ErrStockLevelReadOnly)
+	}
+
+	product, err := u.productRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("error finding product: %w", err)
 	}
@@ -85,13 +121,15 @@ func (u *productUseCase) UpdateProduct(id uuid.UUID, request model.UpdateProduct
 		product.Price = *request.Price
 	}
 
-	if request.StockLevel != nil {
-		product.StockLevel = *request.StockLevel
+	if request.ClearReorderThreshold {
+		product.ReorderThreshold = nil
+	} else if request.ReorderThreshold != nil {
+		product.ReorderThreshold = request.ReorderThreshold
 	}
 
 	if request.CategoryID != nil {
 		// Verify category exists
-		category, err := u.categoryRepo.FindByID(*request.CategoryID)
+		category, err := u.categoryRepo.FindByID(ctx, *request.CategoryID)
 		if err != nil {
 			return nil, fmt.Errorf("error finding category: %w", err)
 		}
@@ -103,15 +141,16 @@ func (u *productUseCase) UpdateProduct(id uuid.UUID, request model.UpdateProduct
 		product.CategoryID = *request.CategoryID
 	}
 
-	if err := u.productRepo.Update(product); err != nil {
+	if err := u.productRepo.Update(ctx, product); err != nil {
 		return nil, fmt.Errorf("error updating product: %w", err)
 	}
 
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, product.ID)
 	return product, nil
 }
 
-func (u *productUseCase) DeleteProduct(id uuid.UUID) error {
-	product, err := u.productRepo.FindByID(id)
+func (u *productUseCase) DeleteProduct(ctx context.Context, id uuid.UUID) error {
+	product, err := u.productRepo.FindByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("error finding product: %w", err)
 	}
@@ -120,13 +159,57 @@ func (u *productUseCase) DeleteProduct(id uuid.UUID) error {
 		return errors.New(model.ErrProductNotFound)
 	}
 
-	if err := u.productRepo.Delete(id); err != nil {
+	if err := u.productRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("error deleting product: %w", err)
 	}
 
+	// The product is gone either way, so leftover media is only logged
+	if err := u.media.DeleteProductMedia(ctx, id); err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to delete media of product %s: %v", id, err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionDeleted, id)
 	return nil
 }
 
-func (u *productUseCase) ListProducts(params repository.ListProductParams) ([]model.Product, int64, error) {
-	return u.productRepo.List(params)
+func (u *productUseCase) ListProducts(ctx context.Context, params repository.ListProductParams) ([]model.Product, int64, error) {
+	if !params.Sort.Valid() {
+		return nil, 0, errors.New(model.ErrInvalidProductSort)
+	}
+
+	return u.productRepo.List(ctx, params)
+}
+
+func (u *productUseCase) ListProductsAfter(ctx context.Context, params repository.ListProductParams, cursor string) ([]model.Product, string, error) {
+	if !params.Sort.Valid() {
+		return nil, "", errors.New(model.ErrInvalidProductSort)
+	}
+
+	products, next, err := u.productRepo.ListAfter(ctx, params, cursor)
+	if err != nil {
+		if err.Error() == model.ErrInvalidCursor {
+			return nil, "", err
+		}
+		return nil, "", fmt.Errorf("error listing products: %w", err)
+	}
+
+	return products, next, nil
+}
+
+func (u *productUseCase) GetProductFacets(ctx context.Context, params repository.ListProductParams) (*model.ProductFacets, error) {
+	facets, err := u.productRepo.ListFacets(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error counting product facets: %w", err)
+	}
+
+	return facets, nil
+}
+
+func (u *productUseCase) ListLowStockProducts(ctx context.Context, page, pageSize int) ([]model.Product, int64, error) {
+	products, total, err := u.productRepo.ListLowStock(ctx, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error listing low stock products: %w", err)
+	}
+
+	return products, total, nil
 }
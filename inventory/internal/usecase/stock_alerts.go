@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// StockAlerter publishes a LowStock event when a stock change takes a product
+// below its reorder threshold
+type StockAlerter struct {
+	productRepo repository.ProductRepository
+	producer    *kafka.Producer
+}
+
+// NewStockAlerter creates a stock alerter publishing to the stock alerts topic
+func NewStockAlerter(productRepo repository.ProductRepository, producer *kafka.Producer) *StockAlerter {
+	return &StockAlerter{
+		productRepo: productRepo,
+		producer:    producer,
+	}
+}
+
+// StockChanged checks a product after its stock changed by delta. An event is only
+// published when the change crosses the threshold, so a product that stays below it
+// is reported once. Alerting is best effort and never fails the stock change.
+func (a *StockAlerter) StockChanged(ctx context.Context, productID uuid.UUID, delta int) {
+	if a == nil || a.producer == nil || delta >= 0 {
+		return
+	}
+
+	product, err := a.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		logrus.WithContext(ctx).Warnf("Failed to check stock of product %s for alerts: %v", productID, err)
+		return
+	}
+
+	if product == nil {
+		return
+	}
+
+	onHand := product.OnHand()
+	if !product.IsLowStock(onHand) || product.IsLowStock(onHand-delta) {
+		return
+	}
+
+	event := events.LowStockEvent{
+		Type:             events.TypeLowStock,
+		ProductID:        product.ID.String(),
+		ProductName:      product.Name,
+		StockLevel:       onHand,
+		ReorderThreshold: *product.EffectiveReorderThreshold(),
+		Timestamp:        time.Now(),
+	}
+
+	value, err := json.Marshal(event)
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to encode low stock event: %v", err)
+		return
+	}
+
+	if err := a.producer.PublishEvent(ctx, product.ID.String(), value); err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to publish low stock event: %v", err)
+		return
+	}
+
+	logrus.WithContext(ctx).Infof("Product %s is low on stock: %d left, reorder threshold %d", product.ID, onHand, event.ReorderThreshold)
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/baccala1010/e-commerce/inventory/pkg/logging"
+	"github.com/google/uuid"
+)
+
+type stockLedgerUseCase struct {
+	movementRepo  repository.StockMovementRepository
+	productRepo   repository.ProductRepository
+	warehouseRepo repository.WarehouseRepository
+	alerter       *StockAlerter
+	producer      *kafka.Producer
+}
+
+// NewStockLedgerUseCase creates a new stock ledger use case
+func NewStockLedgerUseCase(movementRepo repository.StockMovementRepository, productRepo repository.ProductRepository, warehouseRepo repository.WarehouseRepository, alerter *StockAlerter, producer *kafka.Producer) StockLedgerUseCase {
+	return &stockLedgerUseCase{
+		movementRepo:  movementRepo,
+		productRepo:   productRepo,
+		warehouseRepo: warehouseRepo,
+		alerter:       alerter,
+		producer:      producer,
+	}
+}
+
+func (u *stockLedgerUseCase) AdjustStock(ctx context.Context, productID uuid.UUID, request model.AdjustStockRequest) (*model.StockMovement, error) {
+	movement := newStockMovement(ctx, productID, request.VariantID, request.Reason)
+	movement.WarehouseID = request.WarehouseID
+	movement.Delta = request.Delta
+	movement.OrderID = request.OrderID
+	movement.Note = request.Note
+
+	if !movement.Valid() {
+		return nil, errors.New(model.ErrInvalidStockMovement)
+	}
+
+	if request.WarehouseID != nil {
+		if err := u.checkWarehouseMovement(ctx, movement); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.movementRepo.Adjust(ctx, movement); err != nil {
+		switch err.Error() {
+		case model.ErrProductNotFound, model.ErrVariantNotFound, model.ErrInsufficientStock, model.ErrStockInWarehouses:
+			return nil, err
+		}
+		return nil, fmt.Errorf("error adjusting stock: %w", err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, productID)
+	u.alerter.StockChanged(ctx, productID, movement.Delta)
+	return movement, nil
+}
+
+func (u *stockLedgerUseCase) ListStockMovements(ctx context.Context, params repository.ListStockMovementParams) ([]model.StockMovement, int64, error) {
+	product, err := u.productRepo.FindByID(ctx, params.ProductID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error finding product: %w", err)
+	}
+
+	if product == nil {
+		return nil, 0, errors.New(model.ErrProductNotFound)
+	}
+
+	movements, total, err := u.movementRepo.ListByProduct(ctx, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error listing stock movements: %w", err)
+	}
+
+	return movements, total, nil
+}
+
+// checkWarehouseMovement checks that a movement can be booked against its warehouse.
+// Products with variants are stocked per variant, and stock may leave an inactive
+// warehouse but not enter one.
+func (u *stockLedgerUseCase) checkWarehouseMovement(ctx context.Context, movement *model.StockMovement) error {
+	warehouse, err := u.warehouseRepo.FindByID(ctx, *movement.WarehouseID)
+	if err != nil {
+		return fmt.Errorf("error finding warehouse: %w", err)
+	}
+
+	if warehouse == nil {
+		return errors.New(model.ErrWarehouseNotFound)
+	}
+
+	if !warehouse.IsActive && movement.Delta > 0 {
+		return errors.New(model.ErrWarehouseInactive)
+	}
+
+	if movement.VariantID == nil {
+		product, err := u.productRepo.FindByID(ctx, movement.ProductID)
+		if err != nil {
+			return fmt.Errorf("error finding product: %w", err)
+		}
+
+		if product != nil && len(product.Variants) > 0 {
+			return errors.New(model.ErrInvalidStockData)
+		}
+	}
+
+	return nil
+}
+
+// newStockMovement starts a ledger entry for a change made on behalf of the user of the request
+func newStockMovement(ctx context.Context, productID uuid.UUID, variantID *uuid.UUID, reason model.StockMovementReason) *model.StockMovement {
+	actor := logging.UserID(ctx)
+	if actor == "" {
+		actor = model.StockMovementActorSystem
+	}
+
+	return &model.StockMovement{
+		ProductID: productID,
+		VariantID: variantID,
+		Reason:    reason,
+		Actor:     actor,
+	}
+}
+
+// initialStockMovement starts the ledger entry of the stock a new product or variant
+// is created with, or returns nil if it starts without stock
+func initialStockMovement(ctx context.Context, level int) *model.StockMovement {
+	if level <= 0 {
+		return nil
+	}
+	return newStockMovement(ctx, uuid.Nil, nil, model.StockMovementRestock)
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/google/uuid"
+)
+
+type stockUseCase struct {
+	stockRepo     repository.StockRepository
+	warehouseRepo repository.WarehouseRepository
+	productRepo   repository.ProductRepository
+	alerter       *StockAlerter
+	producer      *kafka.Producer
+}
+
+// NewStockUseCase creates a new stock use case. Warehouse stock changes are
+// recorded in the stock ledger.
+func NewStockUseCase(stockRepo repository.StockRepository, warehouseRepo repository.WarehouseRepository, productRepo repository.ProductRepository, alerter *StockAlerter, producer *kafka.Producer) StockUseCase {
+	return &stockUseCase{
+		stockRepo:     stockRepo,
+		warehouseRepo: warehouseRepo,
+		productRepo:   productRepo,
+		alerter:       alerter,
+		producer:      producer,
+	}
+}
+
+func (u *stockUseCase) SetStock(ctx context.Context, warehouseID uuid.UUID, request model.SetStockRequest) (*model.WarehouseStock, error) {
+	if _, err := u.findWarehouse(ctx, warehouseID); err != nil {
+		return nil, err
+	}
+
+	if err := u.checkStockItem(ctx, request.ProductID, request.VariantID); err != nil {
+		return nil, err
+	}
+
+	// The difference to the current quantity is recorded as an adjustment
+	movement := newStockMovement(ctx, request.ProductID, request.VariantID, model.StockMovementAdjustment)
+	movement.WarehouseID = &warehouseID
+
+	if err := u.stockRepo.SetQuantity(ctx, movement, request.Quantity); err != nil {
+		if err.Error() == model.ErrInsufficientStock {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error setting stock: %w", err)
+	}
+
+	stock, err := u.stockRepo.FindStock(ctx, warehouseID, request.ProductID, request.VariantID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding stock: %w", err)
+	}
+
+	if stock == nil {
+		return nil, fmt.Errorf("error finding stock: no stock of product %s in warehouse %s", request.ProductID, warehouseID)
+	}
+
+	if movement.Delta != 0 {
+		publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, request.ProductID)
+		u.alerter.StockChanged(ctx, request.ProductID, movement.Delta)
+	}
+	return stock, nil
+}
+
+func (u *stockUseCase) GetWarehouseStock(ctx context.Context, warehouseID uuid.UUID) ([]model.WarehouseStock, error) {
+	if _, err := u.findWarehouse(ctx, warehouseID); err != nil {
+		return nil, err
+	}
+
+	stocks, err := u.stockRepo.ListByWarehouse(ctx, warehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing stock: %w", err)
+	}
+
+	return stocks, nil
+}
+
+func (u *stockUseCase) GetProductStock(ctx context.Context, productID uuid.UUID) ([]model.WarehouseStock, error) {
+	product, err := u.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding product: %w", err)
+	}
+
+	if product == nil {
+		return nil, errors.New(model.ErrProductNotFound)
+	}
+
+	stocks, err := u.stockRepo.ListByProduct(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing stock: %w", err)
+	}
+
+	return stocks, nil
+}
+
+func (u *stockUseCase) TransferStock(ctx context.Context, request model.TransferStockRequest) (*model.StockTransfer, error) {
+	if request.FromWarehouseID == request.ToWarehouseID || request.Quantity <= 0 {
+		return nil, errors.New(model.ErrInvalidStockData)
+	}
+
+	if _, err := u.findWarehouse(ctx, request.FromWarehouseID); err != nil {
+		return nil, err
+	}
+
+	// Stock may leave an inactive warehouse, but not be moved into one
+	destination, err := u.findWarehouse(ctx, request.ToWarehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !destination.IsActive {
+		return nil, errors.New(model.ErrWarehouseInactive)
+	}
+
+	if err := u.checkStockItem(ctx, request.ProductID, request.VariantID); err != nil {
+		return nil, err
+	}
+
+	transfer := &model.StockTransfer{
+		FromWarehouseID: request.FromWarehouseID,
+		ToWarehouseID:   request.ToWarehouseID,
+		ProductID:       request.ProductID,
+		VariantID:       request.VariantID,
+		Quantity:        request.Quantity,
+		Note:            request.Note,
+	}
+
+	withdrawal := newStockMovement(ctx, request.ProductID, request.VariantID, model.StockMovementTransfer)
+	deposit := newStockMovement(ctx, request.ProductID, request.VariantID, model.StockMovementTransfer)
+	withdrawal.Note = request.Note
+	deposit.Note = request.Note
+
+	if err := u.stockRepo.Transfer(ctx, transfer, withdrawal, deposit); err != nil {
+		if err.Error() == model.ErrInsufficientStock {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error transferring stock: %w", err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, request.ProductID)
+	return transfer, nil
+}
+
+func (u *stockUseCase) ListTransfers(ctx context.Context, warehouseID uuid.UUID) ([]model.StockTransfer, error) {
+	if _, err := u.findWarehouse(ctx, warehouseID); err != nil {
+		return nil, err
+	}
+
+	transfers, err := u.stockRepo.ListTransfers(ctx, warehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing transfers: %w", err)
+	}
+
+	return transfers, nil
+}
+
+// findWarehouse returns the warehouse with the given ID
+func (u *stockUseCase) findWarehouse(ctx context.Context, id uuid.UUID) (*model.Warehouse, error) {
+	warehouse, err := u.warehouseRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error finding warehouse: %w", err)
+	}
+
+	if warehouse == nil {
+		return nil, errors.New(model.ErrWarehouseNotFound)
+	}
+
+	return warehouse, nil
+}
+
+// checkStockItem checks that stock can be held for the product or variant.
+// Products with variants are stocked per variant.
+func (u *stockUseCase) checkStockItem(ctx context.Context, productID uuid.UUID, variantID *uuid.UUID) error {
+	product, err := u.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return fmt.Errorf("error finding product: %w", err)
+	}
+
+	if product == nil {
+		return errors.New(model.ErrProductNotFound)
+	}
+
+	if variantID == nil {
+		if len(product.Variants) > 0 {
+			return errors.New(model.ErrInvalidStockData)
+		}
+		return nil
+	}
+
+	for _, variant := range product.Variants {
+		if variant.ID == *variantID {
+			return nil
+		}
+	}
+
+	return errors.New(model.ErrVariantNotFound)
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/google/uuid"
+)
+
+type variantUseCase struct {
+	variantRepo repository.VariantRepository
+	productRepo repository.ProductRepository
+	alerter     *StockAlerter
+	producer    *kafka.Producer
+}
+
+// NewVariantUseCase creates a new variant use case
+func NewVariantUseCase(variantRepo repository.VariantRepository, productRepo repository.ProductRepository, alerter *StockAlerter, producer *kafka.Producer) VariantUseCase {
+	return &variantUseCase{
+		variantRepo: variantRepo,
+		productRepo: productRepo,
+		alerter:     alerter,
+		producer:    producer,
+	}
+}
+
+func (u *variantUseCase) CreateVariant(ctx context.Context, productID uuid.UUID, request model.CreateVariantRequest) (*model.ProductVariant, error) {
+	if err := u.ensureProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	sku := strings.TrimSpace(request.SKU)
+	options, err := normalizeOptions(request.Options)
+	if sku == "" || err != nil {
+		return nil, errors.New(model.ErrInvalidVariantData)
+	}
+
+	if err := u.ensureSKUAvailable(ctx, sku, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	variant := &model.ProductVariant{
+		ProductID:  productID,
+		SKU:        sku,
+		Options:    options,
+		Price:      request.Price,
+		StockLevel: request.StockLevel,
+	}
+
+	// The initial stock is booked through the ledger like any other change, in
+	// the transaction creating the variant
+	stock := initialStockMovement(ctx, request.StockLevel)
+	if err := u.variantRepo.Create(ctx, variant, stock); err != nil {
+		return nil, fmt.Errorf("error creating variant: %w", err)
+	}
+
+	if stock != nil {
+		u.alerter.StockChanged(ctx, productID, stock.Delta)
+	}
+
+	// Variants are part of the product representation
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, productID)
+	return variant, nil
+}
+
+func (u *variantUseCase) GetVariant(ctx context.Context, productID, id uuid.UUID) (*model.ProductVariant, error) {
+	return u.findVariant(ctx, productID, id)
+}
+
+func (u *variantUseCase) ListVariants(ctx context.Context, productID uuid.UUID) ([]model.ProductVariant, error) {
+	if err := u.ensureProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	variants, err := u.variantRepo.ListByProduct(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing variants: %w", err)
+	}
+
+	return variants, nil
+}
+
+func (u *variantUseCase) UpdateVariant(ctx context.Context, productID, id uuid.UUID, request model.UpdateVariantRequest) (*model.ProductVariant, error) {
+	// Stock changes are recorded with their reason through stock adjustments
+	if request.StockLevel != nil {
+		return nil, errors.New(model.ErrStockLevelReadOnly)
+	}
+
+	variant, err := u.findVariant(ctx, productID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update only provided fields
+	if request.SKU != nil {
+		sku := strings.TrimSpace(*request.SKU)
+		if sku == "" {
+			return nil, errors.New(model.ErrInvalidVariantData)
+		}
+
+		if sku != variant.SKU {
+			if err := u.ensureSKUAvailable(ctx, sku, variant.ID); err != nil {
+				return nil, err
+			}
+			variant.SKU = sku
+		}
+	}
+
+	if request.Options != nil {
+		options, err := normalizeOptions(request.Options)
+		if err != nil {
+			return nil, errors.New(model.ErrInvalidVariantData)
+		}
+		variant.Options = options
+	}
+
+	if request.ClearPrice {
+		variant.Price = nil
+	} else if request.Price != nil {
+		variant.Price = request.Price
+	}
+
+	if err := u.variantRepo.Update(ctx, variant); err != nil {
+		return nil, fmt.Errorf("error updating variant: %w", err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, productID)
+	return variant, nil
+}
+
+func (u *variantUseCase) DeleteVariant(ctx context.Context, productID, id uuid.UUID) error {
+	if _, err := u.findVariant(ctx, productID, id); err != nil {
+		return err
+	}
+
+	if err := u.variantRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("error deleting variant: %w", err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityProduct, events.ActionUpdated, productID)
+	return nil
+}
+
+// findVariant returns the variant with the given ID if it belongs to the product
+func (u *variantUseCase) findVariant(ctx context.Context, productID, id uuid.UUID) (*model.ProductVariant, error) {
+	variant, err := u.variantRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error finding variant: %w", err)
+	}
+
+	if variant == nil || variant.ProductID != productID {
+		return nil, errors.New(model.ErrVariantNotFound)
+	}
+
+	return variant, nil
+}
+
+// ensureProduct checks that the product exists
+func (u *variantUseCase) ensureProduct(ctx context.Context, productID uuid.UUID) error {
+	product, err := u.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return fmt.Errorf("error finding product: %w", err)
+	}
+
+	if product == nil {
+		return errors.New(model.ErrProductNotFound)
+	}
+
+	return nil
+}
+
+// ensureSKUAvailable checks that no variant other than the given one uses the SKU
+func (u *variantUseCase) ensureSKUAvailable(ctx context.Context, sku string, variantID uuid.UUID) error {
+	existing, err := u.variantRepo.FindBySKU(ctx, sku)
+	if err != nil {
+		return fmt.Errorf("error finding variant: %w", err)
+	}
+
+	if existing != nil && existing.ID != variantID {
+		return errors.New(model.ErrDuplicateSKU)
+	}
+
+	return nil
+}
+
+// normalizeOptions trims option names and values and rejects empty ones
+func normalizeOptions(options map[string]string) (model.VariantOptions, error) {
+	normalized := make(model.VariantOptions, len(options))
+	for name, value := range options {
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if name == "" || value == "" {
+			return nil, errors.New(model.ErrInvalidVariantData)
+		}
+		normalized[name] = value
+	}
+	return normalized, nil
+}
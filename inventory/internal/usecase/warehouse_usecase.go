@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/baccala1010/e-commerce/inventory/internal/model"
+	"github.com/baccala1010/e-commerce/inventory/internal/repository"
+	"github.com/baccala1010/e-commerce/inventory/pkg/events"
+	"github.com/baccala1010/e-commerce/inventory/pkg/kafka"
+	"github.com/google/uuid"
+)
+
+type warehouseUseCase struct {
+	warehouseRepo repository.WarehouseRepository
+	producer      *kafka.Producer
+}
+
+// NewWarehouseUseCase creates a new warehouse use case
+func NewWarehouseUseCase(warehouseRepo repository.WarehouseRepository, producer *kafka.Producer) WarehouseUseCase {
+	return &warehouseUseCase{
+		warehouseRepo: warehouseRepo,
+		producer:      producer,
+	}
+}
+
+func (u *warehouseUseCase) CreateWarehouse(ctx context.Context, request model.CreateWarehouseRequest) (*model.Warehouse, error) {
+	code := strings.TrimSpace(request.Code)
+	if code == "" {
+		return nil, errors.New(model.ErrInvalidWarehouseData)
+	}
+
+	existing, err := u.warehouseRepo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("error finding warehouse: %w", err)
+	}
+
+	if existing != nil {
+		return nil, errors.New(model.ErrDuplicateWarehouse)
+	}
+
+	warehouse := &model.Warehouse{
+		Code:     code,
+		Name:     request.Name,
+		Address:  request.Address,
+		IsActive: true,
+	}
+
+	if err := u.warehouseRepo.Create(ctx, warehouse); err != nil {
+		return nil, fmt.Errorf("error creating warehouse: %w", err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityWarehouse, events.ActionCreated, warehouse.ID)
+	return warehouse, nil
+}
+
+func (u *warehouseUseCase) GetWarehouseByID(ctx context.Context, id uuid.UUID) (*model.Warehouse, error) {
+	warehouse, err := u.warehouseRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error finding warehouse: %w", err)
+	}
+
+	if warehouse == nil {
+		return nil, errors.New(model.ErrWarehouseNotFound)
+	}
+
+	return warehouse, nil
+}
+
+func (u *warehouseUseCase) UpdateWarehouse(ctx context.Context, id uuid.UUID, request model.UpdateWarehouseRequest) (*model.Warehouse, error) {
+	warehouse, err := u.GetWarehouseByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update only provided fields
+	if request.Name != nil {
+		warehouse.Name = *request.Name
+	}
+
+	if request.Address != nil {
+		warehouse.Address = *request.Address
+	}
+
+	if request.IsActive != nil {
+		warehouse.IsActive = *request.IsActive
+	}
+
+	if err := u.warehouseRepo.Update(ctx, warehouse); err != nil {
+		return nil, fmt.Errorf("error updating warehouse: %w", err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityWarehouse, events.ActionUpdated, warehouse.ID)
+	return warehouse, nil
+}
+
+func (u *warehouseUseCase) DeleteWarehouse(ctx context.Context, id uuid.UUID) error {
+	if _, err := u.GetWarehouseByID(ctx, id); err != nil {
+		return err
+	}
+
+	// Stock must be transferred out before a warehouse can be removed
+	hasStock, err := u.warehouseRepo.HasStock(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error checking for stock: %w", err)
+	}
+
+	if hasStock {
+		return errors.New(model.ErrWarehouseHasStock)
+	}
+
+	if err := u.warehouseRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("error deleting warehouse: %w", err)
+	}
+
+	publishCatalogEvent(ctx, u.producer, events.EntityWarehouse, events.ActionDeleted, id)
+	return nil
+}
+
+func (u *warehouseUseCase) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	warehouses, err := u.warehouseRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing warehouses: %w", err)
+	}
+
+	return warehouses, nil
+}
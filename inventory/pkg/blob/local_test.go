@@ -0,0 +1,48 @@
+package blob
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalStorePath(t *testing.T) {
+	root := t.TempDir()
+	store := &LocalStore{root: root}
+
+	tests := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{name: "nested key", key: "products/a/original.png", want: filepath.Join(root, "products", "a", "original.png")},
+		{name: "single segment", key: "file.pdf", want: filepath.Join(root, "file.pdf")},
+		{name: "empty", key: ""},
+		{name: "parent segment", key: "../outside"},
+		{name: "parent segment inside", key: "products/../../outside"},
+		{name: "trailing parent segment", key: "products/.."},
+		{name: "current segment", key: "products/./file"},
+		{name: "absolute", key: "/etc/passwd"},
+		{name: "empty segment", key: "products//file"},
+		{name: "backslash", key: "products\\..\\..\\outside"},
+		{name: "backslash only", key: "\\outside"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.path(tt.key)
+			if tt.want == "" {
+				if err == nil {
+					t.Fatalf("path(%q) = %q, want an error", tt.key, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("path(%q) failed: %v", tt.key, err)
+			}
+			if got != tt.want {
+				t.Errorf("path(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
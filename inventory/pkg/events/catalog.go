@@ -0,0 +1,29 @@
+package events
+
+import "time"
+
+// Catalog entities
+const (
+	EntityProduct   = "product"
+	EntityCategory  = "category"
+	EntityDiscount  = "discount"
+	EntityWarehouse = "warehouse"
+)
+
+// Catalog actions
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+)
+
+// CatalogEvent is published by the inventory service whenever a product,
+// category, discount or warehouse changes. Stock changes are published as
+// updates of the stocked product. It is encoded as JSON, keyed by the entity ID
+// and published on the catalog events topic.
+type CatalogEvent struct {
+	Entity    string    `json:"entity"`
+	Action    string    `json:"action"`
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+}
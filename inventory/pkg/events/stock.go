@@ -0,0 +1,21 @@
+package events
+
+import "time"
+
+// Stock alert types
+const (
+	TypeLowStock = "LowStock"
+)
+
+// LowStockEvent is published by the inventory service on the stock alerts topic
+// when the stock of a product falls below its reorder threshold. StockLevel
+// includes the stock of the variants of the product. It is encoded as JSON and
+// keyed by the product ID.
+type LowStockEvent struct {
+	Type             string    `json:"type"`
+	ProductID        string    `json:"product_id"`
+	ProductName      string    `json:"product_name"`
+	StockLevel       int       `json:"stock_level"`
+	ReorderThreshold int       `json:"reorder_threshold"`
+	Timestamp        time.Time `json:"timestamp"`
+}
@@ -0,0 +1,181 @@
+package grpcserver
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/baccala1010/e-commerce/inventory/pkg/logging"
+	"github.com/baccala1010/e-commerce/inventory/pkg/metrics"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func unaryInterceptors(opts Options) []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		logging.UnaryServerInterceptor(),
+		unaryRecovery,
+		unaryLogger,
+		metrics.UnaryServerInterceptor(),
+		unaryDeadline(opts.DefaultTimeout),
+		unaryAuth(opts.Authorize),
+	}
+}
+
+func streamInterceptors(opts Options) []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		logging.StreamServerInterceptor(),
+		streamRecovery,
+		streamLogger,
+		metrics.StreamServerInterceptor(),
+		streamDeadline,
+		streamAuth(opts.Authorize),
+	}
+}
+
+// unaryRecovery turns a panic in a handler into an Internal error
+func unaryRecovery(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// streamRecovery turns a panic in a handler into an Internal error
+func streamRecovery(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ss.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func recovered(ctx context.Context, method string, r interface{}) error {
+	logrus.WithContext(ctx).WithFields(logrus.Fields{
+		"method": method,
+		"error":  r,
+		"stack":  string(debug.Stack()),
+	}).Error("Panic recovered")
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// unaryLogger logs every unary call with its outcome and latency
+func unaryLogger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(ctx, info.FullMethod, err, time.Since(start))
+	return resp, err
+}
+
+// streamLogger logs every streaming call with its outcome and duration
+func streamLogger(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logCall(ss.Context(), info.FullMethod, err, time.Since(start))
+	return err
+}
+
+func logCall(ctx context.Context, method string, err error, latency time.Duration) {
+	// Health checks run every few seconds and would drown out the calls that matter
+	if isInfrastructure(method) && err == nil {
+		return
+	}
+
+	code := status.Code(err)
+	level := logrus.InfoLevel
+	switch code {
+	case codes.OK:
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unimplemented:
+		level = logrus.ErrorLevel
+	default:
+		level = logrus.WarnLevel
+	}
+
+	entry := logrus.WithContext(ctx).WithFields(logrus.Fields{
+		"method":  method,
+		"code":    code.String(),
+		"latency": latency,
+	})
+	if err != nil {
+		entry = entry.WithField("error", status.Convert(err).Message())
+	}
+	entry.Log(level, "gRPC call processed")
+}
+
+// unaryDeadline rejects calls whose deadline has already passed and bounds
+// calls that arrive without one
+func unaryDeadline(defaultTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			if defaultTimeout <= 0 {
+				return handler(ctx, req)
+			}
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+			defer cancel()
+		} else if time.Until(deadline) <= 0 {
+			return nil, status.Error(codes.DeadlineExceeded, "deadline exceeded before the call started")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamDeadline rejects streams whose deadline has already passed. Streams
+// may legitimately stay open for long, so none is imposed on them.
+func streamDeadline(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if deadline, ok := ss.Context().Deadline(); ok && time.Until(deadline) <= 0 {
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded before the call started")
+	}
+	return handler(srv, ss)
+}
+
+// unaryAuth consults the authorization hook before unary calls
+func unaryAuth(authorize AuthFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if authorize == nil || isInfrastructure(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamAuth consults the authorization hook before streaming calls
+func streamAuth(authorize AuthFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if authorize == nil || isInfrastructure(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// isInfrastructure reports whether a method belongs to the health or reflection services
+func isInfrastructure(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.") || strings.HasPrefix(method, "/grpc.reflection.")
+}
+
+// contextStream replaces the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the replaced context
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,179 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Statuses reported for the service and its dependencies
+const (
+	StatusUp           = "up"
+	StatusDown         = "down"
+	StatusDegraded     = "degraded"
+	StatusShuttingDown = "shutting_down"
+)
+
+// Check reports whether a dependency is usable
+type Check func(ctx context.Context) error
+
+type dependency struct {
+	name     string
+	check    Check
+	critical bool
+}
+
+// Checker runs the dependency checks behind the liveness and readiness probes
+type Checker struct {
+	service      string
+	timeout      time.Duration
+	mu           sync.RWMutex
+	dependencies []dependency
+	shuttingDown atomic.Bool
+}
+
+// Report is the response of a probe
+type Report struct {
+	Status  string                 `json:"status"`
+	Service string                 `json:"service"`
+	Checks  map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// CheckResult is the outcome of a single dependency check
+type CheckResult struct {
+	Status    string  `json:"status"`
+	Critical  bool    `json:"critical"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// NewChecker creates a checker that gives each check the given time to complete
+func NewChecker(service string, timeout time.Duration) *Checker {
+	return &Checker{
+		service: service,
+		timeout: timeout,
+	}
+}
+
+// Register adds a dependency the service cannot serve requests without
+func (c *Checker) Register(name string, check Check) {
+	c.add(dependency{name: name, check: check, critical: true})
+}
+
+// RegisterOptional adds a dependency the service can serve requests without.
+// While it is down the service is reported as degraded but stays ready.
+func (c *Checker) RegisterOptional(name string, check Check) {
+	c.add(dependency{name: name, check: check})
+}
+
+func (c *Checker) add(dep dependency) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dependencies = append(c.dependencies, dep)
+}
+
+// Shutdown marks the service as shutting down, so it is no longer ready and
+// load balancers stop sending it new requests
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+}
+
+// Live reports whether the process is running; dependencies are not checked
+// so that an outage of one of them does not get the service restarted
+func (c *Checker) Live() Report {
+	return Report{Status: StatusUp, Service: c.service}
+}
+
+// Ready checks every dependency concurrently and reports whether the service
+// can serve requests
+func (c *Checker) Ready(ctx context.Context) Report {
+	c.mu.RLock()
+	dependencies := append([]dependency(nil), c.dependencies...)
+	c.mu.RUnlock()
+
+	results := make([]CheckResult, len(dependencies))
+	var wg sync.WaitGroup
+	for i, dep := range dependencies {
+		wg.Add(1)
+		go func(i int, dep dependency) {
+			defer wg.Done()
+			results[i] = c.run(ctx, dep)
+		}(i, dep)
+	}
+	wg.Wait()
+
+	report := Report{
+		Status:  StatusUp,
+		Service: c.service,
+		Checks:  make(map[string]CheckResult, len(dependencies)),
+	}
+	for i, dep := range dependencies {
+		result := results[i]
+		report.Checks[dep.name] = result
+		if result.Status == StatusUp {
+			continue
+		}
+		if dep.critical {
+			report.Status = StatusDown
+		} else if report.Status == StatusUp {
+			report.Status = StatusDegraded
+		}
+	}
+
+	if c.shuttingDown.Load() {
+		report.Status = StatusShuttingDown
+	}
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, dep dependency) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := dep.check(ctx)
+	result := CheckResult{
+		Status:    StatusUp,
+		Critical:  dep.critical,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Ready reports whether the report allows the service to receive requests
+func (r Report) Ready() bool {
+	return r.Status == StatusUp || r.Status == StatusDegraded
+}
+
+// LiveHandler serves the liveness probe
+func (c *Checker) LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, c.Live())
+	})
+}
+
+// ReadyHandler serves the readiness probe, answering 503 when the service is not ready
+func (c *Checker) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Ready(r.Context())
+		status := http.StatusOK
+		if !report.Ready() {
+			status = http.StatusServiceUnavailable
+		}
+		writeReport(w, status, report)
+	})
+}
+
+func writeReport(w http.ResponseWriter, status int, report Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(report)
+}
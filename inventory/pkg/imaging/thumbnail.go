@@ -0,0 +1,137 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+)
+
+// ErrUnsupportedFormat is returned for data that is not a JPEG, PNG or GIF image
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+// Thumbnail is an encoded, downscaled copy of an image
+type Thumbnail struct {
+	Data        []byte
+	ContentType string
+}
+
+// Info describes a decoded image
+type Info struct {
+	Width  int
+	Height int
+	Format string
+}
+
+// Inspect reads the dimensions and format of an image without decoding its pixels
+func Inspect(data []byte) (*Info, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	return &Info{Width: cfg.Width, Height: cfg.Height, Format: format}, nil
+}
+
+// MakeThumbnail scales the image down so its longer edge is at most maxEdge pixels.
+// Images that already fit are re-encoded at their original size.
+func MakeThumbnail(data []byte, maxEdge int) (*Thumbnail, error) {
+	if maxEdge <= 0 {
+		return nil, fmt.Errorf("invalid thumbnail size: %d", maxEdge)
+	}
+
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	thumb := resize(toNRGBA(src), maxEdge)
+
+	var buf bytes.Buffer
+	contentType := ThumbnailContentType(format)
+	if contentType == "image/png" {
+		err = png.Encode(&buf, thumb)
+	} else {
+		err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 85})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	return &Thumbnail{Data: buf.Bytes(), ContentType: contentType}, nil
+}
+
+// ThumbnailContentType returns the content type of thumbnails made from images
+// of the given format. PNG and GIF sources produce a PNG to keep transparency,
+// anything else a JPEG.
+func ThumbnailContentType(format string) string {
+	switch format {
+	case "png", "gif":
+		return "image/png"
+	default:
+		return "image/jpeg"
+	}
+}
+
+func toNRGBA(src image.Image) *image.NRGBA {
+	if img, ok := src.(*image.NRGBA); ok && img.Rect.Min == (image.Point{}) {
+		return img
+	}
+	bounds := src.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Src)
+	return dst
+}
+
+// resize downscales with a box filter, averaging every source pixel that
+// falls into a destination pixel. Good enough for thumbnails and needs no
+// dependencies beyond the standard library.
+func resize(src *image.NRGBA, maxEdge int) *image.NRGBA {
+	srcW, srcH := src.Rect.Dx(), src.Rect.Dy()
+	if srcW <= maxEdge && srcH <= maxEdge {
+		return src
+	}
+
+	dstW, dstH := maxEdge, maxEdge
+	if srcW >= srcH {
+		dstH = max(1, srcH*maxEdge/srcW)
+	} else {
+		dstW = max(1, srcW*maxEdge/srcH)
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0, y1 := y*srcH/dstH, max((y+1)*srcH/dstH, y*srcH/dstH+1)
+		for x := 0; x < dstW; x++ {
+			x0, x1 := x*srcW/dstW, max((x+1)*srcW/dstW, x*srcW/dstW+1)
+
+			// Colour channels are weighted by alpha so transparent pixels do not darken edges
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					pa := uint64(p[3])
+					r += uint64(p[0]) * pa
+					g += uint64(p[1]) * pa
+					b += uint64(p[2]) * pa
+					a += pa
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			if a > 0 {
+				dst.Pix[i] = uint8(r / a)
+				dst.Pix[i+1] = uint8(g / a)
+				dst.Pix[i+2] = uint8(b / a)
+			}
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,56 @@
+package logging
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryServerInterceptor takes the request ID and user ID of unary calls from
+// the incoming metadata, generating a request ID when the caller sent none
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(fromIncomingMetadata(ctx), req)
+	}
+}
+
+// StreamServerInterceptor takes the request ID and user ID of streaming calls from the incoming metadata
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: fromIncomingMetadata(ss.Context())})
+	}
+}
+
+func fromIncomingMetadata(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := first(md, RequestIDKey)
+	if !ValidID(requestID) {
+		requestID = NewRequestID()
+	}
+	ctx = WithRequestID(ctx, requestID)
+
+	if userID := first(md, UserIDKey); ValidID(userID) {
+		ctx = WithUserID(ctx, userID)
+	}
+	return ctx
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// contextStream replaces the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the replaced context
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -25,16 +25,23 @@ const (
 
 // Product messages
 type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	StockLevel    int32                  `protobuf:"varint,5,opt,name=stock_level,json=stockLevel,proto3" json:"stock_level,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Category      *Category              `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	StockLevel  int32                  `protobuf:"varint,5,opt,name=stock_level,json=stockLevel,proto3" json:"stock_level,omitempty"`
+	CategoryId  string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Category    *Category              `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Variants    []*ProductVariant      `protobuf:"bytes,10,rep,name=variants,proto3" json:"variants,omitempty"`
+	// Stock of the product and its variants in active warehouses
+	AvailableToSell int32 `protobuf:"varint,11,opt,name=available_to_sell,json=availableToSell,proto3" json:"available_to_sell,omitempty"`
+	// Unset when the default threshold of the category applies
+	ReorderThreshold *int32 `protobuf:"varint,12,opt,name=reorder_threshold,json=reorderThreshold,proto3,oneof" json:"reorder_threshold,omitempty"`
+	// Images and files ordered by position, uploaded through the HTTP API
+	Media         []*ProductMedia `protobuf:"bytes,13,rep,name=media,proto3" json:"media,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -132,15 +139,44 @@ func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Product) GetVariants() []*ProductVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Product) GetAvailableToSell() int32 {
+	if x != nil {
+		return x.AvailableToSell
+	}
+	return 0
+}
+
+func (x *Product) GetReorderThreshold() int32 {
+	if x != nil && x.ReorderThreshold != nil {
+		return *x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Product) GetMedia() []*ProductMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
 type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	StockLevel    int32                  `protobuf:"varint,4,opt,name=stock_level,json=stockLevel,proto3" json:"stock_level,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price            float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	StockLevel       int32                  `protobuf:"varint,4,opt,name=stock_level,json=stockLevel,proto3" json:"stock_level,omitempty"`
+	CategoryId       string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ReorderThreshold *int32                 `protobuf:"varint,6,opt,name=reorder_threshold,json=reorderThreshold,proto3,oneof" json:"reorder_threshold,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
@@ -208,6 +244,13 @@ func (x *CreateProductRequest) GetCategoryId() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetReorderThreshold() int32 {
+	if x != nil && x.ReorderThreshold != nil {
+		return *x.ReorderThreshold
+	}
+	return 0
+}
+
 type GetProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -252,21 +295,116 @@ func (x *GetProductRequest) GetId() string {
 	return ""
 }
 
-type UpdateProductRequest struct {
+// Products that do not exist are left out of the response
+type GetProductsByIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Price         *float64               `protobuf:"fixed64,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
-	StockLevel    *int32                 `protobuf:"varint,5,opt,name=stock_level,json=stockLevel,proto3,oneof" json:"stock_level,omitempty"`
-	CategoryId    *string                `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsByIDsRequest) Reset() {
+	*x = GetProductsByIDsRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsByIDsRequest) ProtoMessage() {}
+
+func (x *GetProductsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProductsByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetProductsByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *GetProductsByIDsResponse) Reset() {
+	*x = GetProductsByIDsResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsByIDsResponse) ProtoMessage() {}
+
+func (x *GetProductsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProductsByIDsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Price       *float64               `protobuf:"fixed64,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	// Rejected; stock changes go through AdjustStock, which records their reason
+	//
+	// Deprecated: Marked as deprecated in inventory/inventory.proto.
+	StockLevel       *int32  `protobuf:"varint,5,opt,name=stock_level,json=stockLevel,proto3,oneof" json:"stock_level,omitempty"`
+	CategoryId       *string `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	ReorderThreshold *int32  `protobuf:"varint,7,opt,name=reorder_threshold,json=reorderThreshold,proto3,oneof" json:"reorder_threshold,omitempty"`
+	// Removes the threshold of the product so the category default applies
+	ClearReorderThreshold bool `protobuf:"varint,8,opt,name=clear_reorder_threshold,json=clearReorderThreshold,proto3" json:"clear_reorder_threshold,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[3]
+	mi := &file_inventory_inventory_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +416,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[3]
+	mi := &file_inventory_inventory_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +429,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{3}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateProductRequest) GetId() string {
@@ -322,6 +460,7 @@ func (x *UpdateProductRequest) GetPrice() float64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in inventory/inventory.proto.
 func (x *UpdateProductRequest) GetStockLevel() int32 {
 	if x != nil && x.StockLevel != nil {
 		return *x.StockLevel
@@ -336,6 +475,20 @@ func (x *UpdateProductRequest) GetCategoryId() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetReorderThreshold() int32 {
+	if x != nil && x.ReorderThreshold != nil {
+		return *x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetClearReorderThreshold() bool {
+	if x != nil {
+		return x.ClearReorderThreshold
+	}
+	return false
+}
+
 type DeleteProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -345,7 +498,7 @@ type DeleteProductRequest struct {
 
 func (x *DeleteProductRequest) Reset() {
 	*x = DeleteProductRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[4]
+	mi := &file_inventory_inventory_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +510,7 @@ func (x *DeleteProductRequest) String() string {
 func (*DeleteProductRequest) ProtoMessage() {}
 
 func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[4]
+	mi := &file_inventory_inventory_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +523,7 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{4}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteProductRequest) GetId() string {
@@ -380,18 +533,33 @@ func (x *DeleteProductRequest) GetId() string {
 	return ""
 }
 
+// sku and options match products with a variant that has the SKU and all the
+// option values; the price range then applies to that variant's effective price
 type ListProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Page       int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit      int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	CategoryId string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Sku        string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Options    map[string]string      `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MinPrice   *float64               `protobuf:"fixed64,6,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice   *float64               `protobuf:"fixed64,7,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	// Full-text search on name and description; results are ordered by relevance
+	Search string `protobuf:"bytes,8,opt,name=search,proto3" json:"search,omitempty"`
+	// price_asc, price_desc, name_asc, name_desc, newest, stock_asc or stock_desc
+	Sort        string `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`
+	InStock     bool   `protobuf:"varint,10,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	OnPromotion bool   `protobuf:"varint,11,opt,name=on_promotion,json=onPromotion,proto3" json:"on_promotion,omitempty"`
+	// Set, even to an empty string for the first page, to page with cursors instead
+	// of page numbers. Cursor pages have no total.
+	Cursor        *string `protobuf:"bytes,12,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProductsRequest) Reset() {
 	*x = ListProductsRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[5]
+	mi := &file_inventory_inventory_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +571,7 @@ func (x *ListProductsRequest) String() string {
 func (*ListProductsRequest) ProtoMessage() {}
 
 func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[5]
+	mi := &file_inventory_inventory_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +584,7 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListProductsRequest) GetPage() int32 {
@@ -440,80 +608,93 @@ func (x *ListProductsRequest) GetCategoryId() string {
 	return ""
 }
 
-type ListProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListProductsRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_inventory_inventory_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListProductsRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
 }
 
-func (x *ListProductsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListProductsRequest) GetMinPrice() float64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (x *ListProductsRequest) GetMaxPrice() float64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[6]
+func (x *ListProductsRequest) GetSearch() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Search
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{6}
+func (x *ListProductsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *ListProductsRequest) GetInStock() bool {
 	if x != nil {
-		return x.Products
+		return x.InStock
 	}
-	return nil
+	return false
 }
 
-func (x *ListProductsResponse) GetTotal() int32 {
+func (x *ListProductsRequest) GetOnPromotion() bool {
 	if x != nil {
-		return x.Total
+		return x.OnPromotion
 	}
-	return 0
+	return false
 }
 
-type ProductResponse struct {
+func (x *ListProductsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// Products whose stock, including their variants, is below their reorder threshold
+type ListLowStockProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductResponse) Reset() {
-	*x = ProductResponse{}
-	mi := &file_inventory_inventory_proto_msgTypes[7]
+func (x *ListLowStockProductsRequest) Reset() {
+	*x = ListLowStockProductsRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductResponse) String() string {
+func (x *ListLowStockProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductResponse) ProtoMessage() {}
+func (*ListLowStockProductsRequest) ProtoMessage() {}
 
-func (x *ProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[7]
+func (x *ListLowStockProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,45 +705,52 @@ func (x *ProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
-func (*ProductResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListLowStockProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockProductsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ProductResponse) GetProduct() *Product {
+func (x *ListLowStockProductsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Product
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-// Category messages
-type Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+func (x *ListLowStockProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListProductsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Products []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total    int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Only set by ListProducts, and with a cursor only on the first page
+	Facets *ProductFacets `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`
+	// Cursor of the next page when listing with a cursor, empty on the last page
+	NextCursor    string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_inventory_inventory_proto_msgTypes[8]
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *ListProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*ListProductsResponse) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[8]
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,37 +761,2317 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Category) GetId() string {
+func (x *ListProductsResponse) GetProducts() []*Product {
 	if x != nil {
-		return x.Id
+		return x.Products
 	}
-	return ""
+	return nil
 }
 
-func (x *Category) GetName() string {
+func (x *ListProductsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Name
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *Category) GetDescription() string {
+func (x *ListProductsResponse) GetFacets() *ProductFacets {
 	if x != nil {
-		return x.Description
+		return x.Facets
 	}
-	return ""
+	return nil
 }
 
-func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListProductsResponse) GetNextCursor() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.NextCursor
 	}
-	return nil
+	return ""
+}
+
+// Product counts per category and price range. Each facet ignores its own filter.
+type ProductFacets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryFacet       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	PriceRanges   []*PriceRangeFacet     `protobuf:"bytes,2,rep,name=price_ranges,json=priceRanges,proto3" json:"price_ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductFacets) Reset() {
+	*x = ProductFacets{}
+	mi := &file_inventory_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFacets) ProtoMessage() {}
+
+func (x *ProductFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductFacets.ProtoReflect.Descriptor instead.
+func (*ProductFacets) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProductFacets) GetCategories() []*CategoryFacet {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ProductFacets) GetPriceRanges() []*PriceRangeFacet {
+	if x != nil {
+		return x.PriceRanges
+	}
+	return nil
+}
+
+type CategoryFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFacet) Reset() {
+	*x = CategoryFacet{}
+	mi := &file_inventory_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFacet) ProtoMessage() {}
+
+func (x *CategoryFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFacet.ProtoReflect.Descriptor instead.
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CategoryFacet) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CategoryFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryFacet) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Products priced from min up to, but not including, max. The highest range has no max.
+type PriceRangeFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           float64                `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceRangeFacet) Reset() {
+	*x = PriceRangeFacet{}
+	mi := &file_inventory_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceRangeFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRangeFacet) ProtoMessage() {}
+
+func (x *PriceRangeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRangeFacet.ProtoReflect.Descriptor instead.
+func (*PriceRangeFacet) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PriceRangeFacet) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PriceRangeFacet) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *PriceRangeFacet) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductResponse) Reset() {
+	*x = ProductResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductResponse) ProtoMessage() {}
+
+func (x *ProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
+func (*ProductResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Variant messages
+// A variant without a price is sold at the price of its product
+type ProductVariant struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku        string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Options    map[string]string      `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price      *float64               `protobuf:"fixed64,5,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	StockLevel int32                  `protobuf:"varint,6,opt,name=stock_level,json=stockLevel,proto3" json:"stock_level,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Stock of the variant in active warehouses
+	AvailableToSell int32 `protobuf:"varint,9,opt,name=available_to_sell,json=availableToSell,proto3" json:"available_to_sell,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProductVariant) Reset() {
+	*x = ProductVariant{}
+	mi := &file_inventory_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductVariant) ProtoMessage() {}
+
+func (x *ProductVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductVariant.ProtoReflect.Descriptor instead.
+func (*ProductVariant) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProductVariant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ProductVariant) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetStockLevel() int32 {
+	if x != nil {
+		return x.StockLevel
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductVariant) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ProductVariant) GetAvailableToSell() int32 {
+	if x != nil {
+		return x.AvailableToSell
+	}
+	return 0
+}
+
+type ProductMedia struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Position  int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// "image" or "file"
+	Kind        string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	FileName    string `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// Set for images only
+	Width         *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height        *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	HasThumbnail  bool                   `protobuf:"varint,10,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductMedia) Reset() {
+	*x = ProductMedia{}
+	mi := &file_inventory_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductMedia) ProtoMessage() {}
+
+func (x *ProductMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductMedia.ProtoReflect.Descriptor instead.
+func (*ProductMedia) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProductMedia) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductMedia) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ProductMedia) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *ProductMedia) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *ProductMedia) GetHasThumbnail() bool {
+	if x != nil {
+		return x.HasThumbnail
+	}
+	return false
+}
+
+func (x *ProductMedia) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Options       map[string]string      `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price         *float64               `protobuf:"fixed64,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	StockLevel    int32                  `protobuf:"varint,5,opt,name=stock_level,json=stockLevel,proto3" json:"stock_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVariantRequest) Reset() {
+	*x = CreateVariantRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVariantRequest) ProtoMessage() {}
+
+func (x *CreateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateVariantRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateVariantRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *CreateVariantRequest) GetStockLevel() int32 {
+	if x != nil {
+		return x.StockLevel
+	}
+	return 0
+}
+
+type GetVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVariantRequest) Reset() {
+	*x = GetVariantRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariantRequest) ProtoMessage() {}
+
+func (x *GetVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariantRequest.ProtoReflect.Descriptor instead.
+func (*GetVariantRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListVariantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariantsRequest) Reset() {
+	*x = ListVariantsRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariantsRequest) ProtoMessage() {}
+
+func (x *ListVariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariantsRequest.ProtoReflect.Descriptor instead.
+func (*ListVariantsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListVariantsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ListVariantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variants      []*ProductVariant      `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariantsResponse) Reset() {
+	*x = ListVariantsResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariantsResponse) ProtoMessage() {}
+
+func (x *ListVariantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariantsResponse.ProtoReflect.Descriptor instead.
+func (*ListVariantsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListVariantsResponse) GetVariants() []*ProductVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// Options replace the existing option values when replace_options is set;
+// clear_price removes the price override
+type UpdateVariantRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Sku            *string                `protobuf:"bytes,3,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	Options        map[string]string      `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReplaceOptions bool                   `protobuf:"varint,5,opt,name=replace_options,json=replaceOptions,proto3" json:"replace_options,omitempty"`
+	Price          *float64               `protobuf:"fixed64,6,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	ClearPrice     bool                   `protobuf:"varint,7,opt,name=clear_price,json=clearPrice,proto3" json:"clear_price,omitempty"`
+	// Rejected like the stock level of UpdateProductRequest
+	//
+	// Deprecated: Marked as deprecated in inventory/inventory.proto.
+	StockLevel    *int32 `protobuf:"varint,8,opt,name=stock_level,json=stockLevel,proto3,oneof" json:"stock_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVariantRequest) Reset() {
+	*x = UpdateVariantRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantRequest) ProtoMessage() {}
+
+func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateVariantRequest) GetReplaceOptions() bool {
+	if x != nil {
+		return x.ReplaceOptions
+	}
+	return false
+}
+
+func (x *UpdateVariantRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetClearPrice() bool {
+	if x != nil {
+		return x.ClearPrice
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in inventory/inventory.proto.
+func (x *UpdateVariantRequest) GetStockLevel() int32 {
+	if x != nil && x.StockLevel != nil {
+		return *x.StockLevel
+	}
+	return 0
+}
+
+type DeleteVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVariantRequest) Reset() {
+	*x = DeleteVariantRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVariantRequest) ProtoMessage() {}
+
+func (x *DeleteVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVariantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVariantRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       *ProductVariant        `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantResponse) Reset() {
+	*x = VariantResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantResponse) ProtoMessage() {}
+
+func (x *VariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantResponse.ProtoReflect.Descriptor instead.
+func (*VariantResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VariantResponse) GetVariant() *ProductVariant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+// Warehouse messages
+type Warehouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_inventory_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Warehouse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Warehouse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Warehouse) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Warehouse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Warehouse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateWarehouseRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseRequest) Reset() {
+	*x = GetWarehouseRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseRequest) ProtoMessage() {}
+
+func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*GetWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetWarehouseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Address       *string                `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseRequest) Reset() {
+	*x = UpdateWarehouseRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseRequest) ProtoMessage() {}
+
+func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateWarehouseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type DeleteWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWarehouseRequest) Reset() {
+	*x = DeleteWarehouseRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWarehouseRequest) ProtoMessage() {}
+
+func (x *DeleteWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteWarehouseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+type ListWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*Warehouse           `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+type WarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseResponse) Reset() {
+	*x = WarehouseResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseResponse) ProtoMessage() {}
+
+func (x *WarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseResponse.ProtoReflect.Descriptor instead.
+func (*WarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// variant_id is empty for the stock of a product without variants
+type WarehouseStock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseStock) Reset() {
+	*x = WarehouseStock{}
+	mi := &file_inventory_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseStock) ProtoMessage() {}
+
+func (x *WarehouseStock) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseStock.ProtoReflect.Descriptor instead.
+func (*WarehouseStock) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WarehouseStock) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *WarehouseStock) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *WarehouseStock) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *WarehouseStock) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WarehouseStock) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStockRequest) Reset() {
+	*x = SetStockRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStockRequest) ProtoMessage() {}
+
+func (x *SetStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStockRequest.ProtoReflect.Descriptor instead.
+func (*SetStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetStockRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *SetStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetStockRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *SetStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type StockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         *WarehouseStock        `protobuf:"bytes,1,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockResponse) Reset() {
+	*x = StockResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockResponse) ProtoMessage() {}
+
+func (x *StockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockResponse.ProtoReflect.Descriptor instead.
+func (*StockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StockResponse) GetStock() *WarehouseStock {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+type GetProductStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductStockRequest) Reset() {
+	*x = GetProductStockRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductStockRequest) ProtoMessage() {}
+
+func (x *GetProductStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductStockRequest.ProtoReflect.Descriptor instead.
+func (*GetProductStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetProductStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetProductStockResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Stock           []*WarehouseStock      `protobuf:"bytes,1,rep,name=stock,proto3" json:"stock,omitempty"`
+	AvailableToSell int32                  `protobuf:"varint,2,opt,name=available_to_sell,json=availableToSell,proto3" json:"available_to_sell,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetProductStockResponse) Reset() {
+	*x = GetProductStockResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductStockResponse) ProtoMessage() {}
+
+func (x *GetProductStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductStockResponse.ProtoReflect.Descriptor instead.
+func (*GetProductStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetProductStockResponse) GetStock() []*WarehouseStock {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+func (x *GetProductStockResponse) GetAvailableToSell() int32 {
+	if x != nil {
+		return x.AvailableToSell
+	}
+	return 0
+}
+
+type StockTransfer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromWarehouseId string                 `protobuf:"bytes,2,opt,name=from_warehouse_id,json=fromWarehouseId,proto3" json:"from_warehouse_id,omitempty"`
+	ToWarehouseId   string                 `protobuf:"bytes,3,opt,name=to_warehouse_id,json=toWarehouseId,proto3" json:"to_warehouse_id,omitempty"`
+	ProductId       string                 `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId       string                 `protobuf:"bytes,5,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Note            string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockTransfer) Reset() {
+	*x = StockTransfer{}
+	mi := &file_inventory_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockTransfer) ProtoMessage() {}
+
+func (x *StockTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockTransfer.ProtoReflect.Descriptor instead.
+func (*StockTransfer) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StockTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetFromWarehouseId() string {
+	if x != nil {
+		return x.FromWarehouseId
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetToWarehouseId() string {
+	if x != nil {
+		return x.ToWarehouseId
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockTransfer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TransferStockRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromWarehouseId string                 `protobuf:"bytes,1,opt,name=from_warehouse_id,json=fromWarehouseId,proto3" json:"from_warehouse_id,omitempty"`
+	ToWarehouseId   string                 `protobuf:"bytes,2,opt,name=to_warehouse_id,json=toWarehouseId,proto3" json:"to_warehouse_id,omitempty"`
+	ProductId       string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId       string                 `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Note            string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TransferStockRequest) GetFromWarehouseId() string {
+	if x != nil {
+		return x.FromWarehouseId
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetToWarehouseId() string {
+	if x != nil {
+		return x.ToWarehouseId
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type TransferStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *StockTransfer         `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockResponse) ProtoMessage() {}
+
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransferStockResponse) GetTransfer() *StockTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// Stock ledger messages
+// A movement records a change of the stock level of a product, or of one of its
+// variants when variant_id is set. reason is one of sale, return, adjustment, restock
+// or transfer. warehouse_id is set when the stock held in a warehouse changed with it.
+type StockMovement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Delta         int32                  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	BalanceAfter  int32                  `protobuf:"varint,5,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string                 `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	OrderId       string                 `protobuf:"bytes,8,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Note          string                 `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,11,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_inventory_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StockMovement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StockMovement) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetBalanceAfter() int32 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockMovement) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StockMovement) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+// The actor is taken from the user ID in the request metadata
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	OrderId       string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,7,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AdjustStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+type StockMovementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movement      *StockMovement         `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovementResponse) Reset() {
+	*x = StockMovementResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovementResponse) ProtoMessage() {}
+
+func (x *StockMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovementResponse.ProtoReflect.Descriptor instead.
+func (*StockMovementResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StockMovementResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+type ListStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListStockMovementsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListStockMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movements     []*StockMovement       `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Category messages
+type Category struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Applies to products of the category without a threshold of their own
+	DefaultReorderThreshold *int32 `protobuf:"varint,6,opt,name=default_reorder_threshold,json=defaultReorderThreshold,proto3,oneof" json:"default_reorder_threshold,omitempty"`
+	// Empty for root categories
+	ParentId string `protobuf:"bytes,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Only set by GetCategoryTree
+	Children      []*Category `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_inventory_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
 func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
@@ -613,17 +3081,40 @@ func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Category) GetDefaultReorderThreshold() int32 {
+	if x != nil && x.DefaultReorderThreshold != nil {
+		return *x.DefaultReorderThreshold
+	}
+	return 0
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetChildren() []*Category {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 type CreateCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description             string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultReorderThreshold *int32                 `protobuf:"varint,3,opt,name=default_reorder_threshold,json=defaultReorderThreshold,proto3,oneof" json:"default_reorder_threshold,omitempty"`
+	ParentId                string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *CreateCategoryRequest) Reset() {
 	*x = CreateCategoryRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[9]
+	mi := &file_inventory_inventory_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -635,7 +3126,120 @@ func (x *CreateCategoryRequest) String() string {
 func (*CreateCategoryRequest) ProtoMessage() {}
 
 func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[9]
+	mi := &file_inventory_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDefaultReorderThreshold() int32 {
+	if x != nil && x.DefaultReorderThreshold != nil {
+		return *x.DefaultReorderThreshold
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateCategoryRequest struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	Id                           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                         *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description                  *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	DefaultReorderThreshold      *int32                 `protobuf:"varint,4,opt,name=default_reorder_threshold,json=defaultReorderThreshold,proto3,oneof" json:"default_reorder_threshold,omitempty"`
+	ClearDefaultReorderThreshold bool                   `protobuf:"varint,5,opt,name=clear_default_reorder_threshold,json=clearDefaultReorderThreshold,proto3" json:"clear_default_reorder_threshold,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,50 +3247,71 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{9}
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
 }
 
-func (x *CreateCategoryRequest) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *UpdateCategoryRequest) GetDefaultReorderThreshold() int32 {
+	if x != nil && x.DefaultReorderThreshold != nil {
+		return *x.DefaultReorderThreshold
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCategoryRequest) GetDescription() string {
+func (x *UpdateCategoryRequest) GetClearDefaultReorderThreshold() bool {
 	if x != nil {
-		return x.Description
+		return x.ClearDefaultReorderThreshold
 	}
-	return ""
+	return false
 }
 
-type GetCategoryRequest struct {
+type DeleteCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryRequest) Reset() {
-	*x = GetCategoryRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[10]
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryRequest) String() string {
+func (x *DeleteCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryRequest) ProtoMessage() {}
+func (*DeleteCategoryRequest) ProtoMessage() {}
 
-func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[10]
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,42 +3322,41 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetCategoryRequest) GetId() string {
+func (x *DeleteCategoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateCategoryRequest struct {
+// Without a root_id the whole tree is returned
+type GetCategoryTreeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	RootId        string                 `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCategoryRequest) Reset() {
-	*x = UpdateCategoryRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[11]
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryRequest) String() string {
+func (x *GetCategoryTreeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryRequest) ProtoMessage() {}
+func (*GetCategoryTreeRequest) ProtoMessage() {}
 
-func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[11]
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,54 +3367,42 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UpdateCategoryRequest) GetId() string {
+func (x *GetCategoryTreeRequest) GetRootId() string {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateCategoryRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateCategoryRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+		return x.RootId
 	}
 	return ""
 }
 
-type DeleteCategoryRequest struct {
+// Moves a category with its subtree; an empty parent_id makes it a root
+type MoveCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCategoryRequest) Reset() {
-	*x = DeleteCategoryRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[12]
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCategoryRequest) String() string {
+func (x *MoveCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCategoryRequest) ProtoMessage() {}
+func (*MoveCategoryRequest) ProtoMessage() {}
 
-func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[12]
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,18 +3413,25 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteCategoryRequest) GetId() string {
+func (x *MoveCategoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
+func (x *MoveCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
 type ListCategoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
@@ -823,7 +3442,7 @@ type ListCategoriesRequest struct {
 
 func (x *ListCategoriesRequest) Reset() {
 	*x = ListCategoriesRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[13]
+	mi := &file_inventory_inventory_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -835,7 +3454,7 @@ func (x *ListCategoriesRequest) String() string {
 func (*ListCategoriesRequest) ProtoMessage() {}
 
 func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[13]
+	mi := &file_inventory_inventory_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +3467,7 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{13}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListCategoriesRequest) GetPage() int32 {
@@ -875,7 +3494,7 @@ type ListCategoriesResponse struct {
 
 func (x *ListCategoriesResponse) Reset() {
 	*x = ListCategoriesResponse{}
-	mi := &file_inventory_inventory_proto_msgTypes[14]
+	mi := &file_inventory_inventory_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -887,7 +3506,7 @@ func (x *ListCategoriesResponse) String() string {
 func (*ListCategoriesResponse) ProtoMessage() {}
 
 func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[14]
+	mi := &file_inventory_inventory_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +3519,7 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{14}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ListCategoriesResponse) GetCategories() []*Category {
@@ -926,7 +3545,7 @@ type CategoryResponse struct {
 
 func (x *CategoryResponse) Reset() {
 	*x = CategoryResponse{}
-	mi := &file_inventory_inventory_proto_msgTypes[15]
+	mi := &file_inventory_inventory_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -938,7 +3557,7 @@ func (x *CategoryResponse) String() string {
 func (*CategoryResponse) ProtoMessage() {}
 
 func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[15]
+	mi := &file_inventory_inventory_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,7 +3570,7 @@ func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryResponse.ProtoReflect.Descriptor instead.
 func (*CategoryResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{15}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *CategoryResponse) GetCategory() *Category {
@@ -980,7 +3599,7 @@ type Discount struct {
 
 func (x *Discount) Reset() {
 	*x = Discount{}
-	mi := &file_inventory_inventory_proto_msgTypes[16]
+	mi := &file_inventory_inventory_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -992,7 +3611,7 @@ func (x *Discount) String() string {
 func (*Discount) ProtoMessage() {}
 
 func (x *Discount) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[16]
+	mi := &file_inventory_inventory_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,7 +3624,7 @@ func (x *Discount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Discount.ProtoReflect.Descriptor instead.
 func (*Discount) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{16}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *Discount) GetId() string {
@@ -1092,7 +3711,7 @@ type CreateDiscountRequest struct {
 
 func (x *CreateDiscountRequest) Reset() {
 	*x = CreateDiscountRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[17]
+	mi := &file_inventory_inventory_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1104,7 +3723,7 @@ func (x *CreateDiscountRequest) String() string {
 func (*CreateDiscountRequest) ProtoMessage() {}
 
 func (x *CreateDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[17]
+	mi := &file_inventory_inventory_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,7 +3736,7 @@ func (x *CreateDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDiscountRequest.ProtoReflect.Descriptor instead.
 func (*CreateDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{17}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *CreateDiscountRequest) GetName() string {
@@ -1171,7 +3790,7 @@ type GetDiscountRequest struct {
 
 func (x *GetDiscountRequest) Reset() {
 	*x = GetDiscountRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[18]
+	mi := &file_inventory_inventory_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1183,7 +3802,7 @@ func (x *GetDiscountRequest) String() string {
 func (*GetDiscountRequest) ProtoMessage() {}
 
 func (x *GetDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[18]
+	mi := &file_inventory_inventory_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,7 +3815,7 @@ func (x *GetDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{18}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetDiscountRequest) GetId() string {
@@ -1222,7 +3841,7 @@ type UpdateDiscountRequest struct {
 
 func (x *UpdateDiscountRequest) Reset() {
 	*x = UpdateDiscountRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[19]
+	mi := &file_inventory_inventory_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1234,7 +3853,7 @@ func (x *UpdateDiscountRequest) String() string {
 func (*UpdateDiscountRequest) ProtoMessage() {}
 
 func (x *UpdateDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[19]
+	mi := &file_inventory_inventory_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,7 +3866,7 @@ func (x *UpdateDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDiscountRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{19}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *UpdateDiscountRequest) GetId() string {
@@ -1315,7 +3934,7 @@ type DeleteDiscountRequest struct {
 
 func (x *DeleteDiscountRequest) Reset() {
 	*x = DeleteDiscountRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[20]
+	mi := &file_inventory_inventory_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1327,7 +3946,7 @@ func (x *DeleteDiscountRequest) String() string {
 func (*DeleteDiscountRequest) ProtoMessage() {}
 
 func (x *DeleteDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[20]
+	mi := &file_inventory_inventory_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +3959,7 @@ func (x *DeleteDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDiscountRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{20}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *DeleteDiscountRequest) GetId() string {
@@ -1360,7 +3979,7 @@ type GetProductsWithPromotionRequest struct {
 
 func (x *GetProductsWithPromotionRequest) Reset() {
 	*x = GetProductsWithPromotionRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[21]
+	mi := &file_inventory_inventory_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1372,7 +3991,7 @@ func (x *GetProductsWithPromotionRequest) String() string {
 func (*GetProductsWithPromotionRequest) ProtoMessage() {}
 
 func (x *GetProductsWithPromotionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[21]
+	mi := &file_inventory_inventory_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +4004,7 @@ func (x *GetProductsWithPromotionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsWithPromotionRequest.ProtoReflect.Descriptor instead.
 func (*GetProductsWithPromotionRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{21}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetProductsWithPromotionRequest) GetPage() int32 {
@@ -1413,7 +4032,7 @@ type GetProductsByDiscountIDRequest struct {
 
 func (x *GetProductsByDiscountIDRequest) Reset() {
 	*x = GetProductsByDiscountIDRequest{}
-	mi := &file_inventory_inventory_proto_msgTypes[22]
+	mi := &file_inventory_inventory_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1425,7 +4044,7 @@ func (x *GetProductsByDiscountIDRequest) String() string {
 func (*GetProductsByDiscountIDRequest) ProtoMessage() {}
 
 func (x *GetProductsByDiscountIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[22]
+	mi := &file_inventory_inventory_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,7 +4057,7 @@ func (x *GetProductsByDiscountIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsByDiscountIDRequest.ProtoReflect.Descriptor instead.
 func (*GetProductsByDiscountIDRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{22}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetProductsByDiscountIDRequest) GetDiscountId() string {
@@ -1462,6 +4081,146 @@ func (x *GetProductsByDiscountIDRequest) GetLimit() int32 {
 	return 0
 }
 
+type GetActiveDiscountsForProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveDiscountsForProductsRequest) Reset() {
+	*x = GetActiveDiscountsForProductsRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveDiscountsForProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveDiscountsForProductsRequest) ProtoMessage() {}
+
+func (x *GetActiveDiscountsForProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveDiscountsForProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveDiscountsForProductsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetActiveDiscountsForProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type ProductDiscounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Discounts     []*Discount            `protobuf:"bytes,2,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductDiscounts) Reset() {
+	*x = ProductDiscounts{}
+	mi := &file_inventory_inventory_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductDiscounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDiscounts) ProtoMessage() {}
+
+func (x *ProductDiscounts) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductDiscounts.ProtoReflect.Descriptor instead.
+func (*ProductDiscounts) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ProductDiscounts) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductDiscounts) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type GetActiveDiscountsForProductsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductDiscounts []*ProductDiscounts    `protobuf:"bytes,1,rep,name=product_discounts,json=productDiscounts,proto3" json:"product_discounts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetActiveDiscountsForProductsResponse) Reset() {
+	*x = GetActiveDiscountsForProductsResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveDiscountsForProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveDiscountsForProductsResponse) ProtoMessage() {}
+
+func (x *GetActiveDiscountsForProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveDiscountsForProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveDiscountsForProductsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetActiveDiscountsForProductsResponse) GetProductDiscounts() []*ProductDiscounts {
+	if x != nil {
+		return x.ProductDiscounts
+	}
+	return nil
+}
+
 type DiscountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Discount      *Discount              `protobuf:"bytes,1,opt,name=discount,proto3" json:"discount,omitempty"`
@@ -1471,7 +4230,7 @@ type DiscountResponse struct {
 
 func (x *DiscountResponse) Reset() {
 	*x = DiscountResponse{}
-	mi := &file_inventory_inventory_proto_msgTypes[23]
+	mi := &file_inventory_inventory_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1483,7 +4242,7 @@ func (x *DiscountResponse) String() string {
 func (*DiscountResponse) ProtoMessage() {}
 
 func (x *DiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_proto_msgTypes[23]
+	mi := &file_inventory_inventory_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,7 +4255,7 @@ func (x *DiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscountResponse.ProtoReflect.Descriptor instead.
 func (*DiscountResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_proto_rawDescGZIP(), []int{23}
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *DiscountResponse) GetDiscount() *Discount {
@@ -1510,7 +4269,7 @@ var File_inventory_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x19inventory/inventory.proto\x12\tinventory\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x02\n" +
+	"\x19inventory/inventory.proto\x12\tinventory\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x04\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1524,7 +4283,13 @@ const file_inventory_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa4\x01\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\bvariants\x18\n" +
+	" \x03(\v2\x19.inventory.ProductVariantR\bvariants\x12*\n" +
+	"\x11available_to_sell\x18\v \x01(\x05R\x0favailableToSell\x120\n" +
+	"\x11reorder_threshold\x18\f \x01(\x05H\x00R\x10reorderThreshold\x88\x01\x01\x12-\n" +
+	"\x05media\x18\r \x03(\v2\x17.inventory.ProductMediaR\x05mediaB\x14\n" +
+	"\x12_reorder_threshold\"\xec\x01\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
@@ -1532,35 +4297,283 @@ const file_inventory_inventory_proto_rawDesc = "" +
 	"\vstock_level\x18\x04 \x01(\x05R\n" +
 	"stockLevel\x12\x1f\n" +
 	"\vcategory_id\x18\x05 \x01(\tR\n" +
-	"categoryId\"#\n" +
+	"categoryId\x120\n" +
+	"\x11reorder_threshold\x18\x06 \x01(\x05H\x00R\x10reorderThreshold\x88\x01\x01B\x14\n" +
+	"\x12_reorder_threshold\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x17GetProductsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"J\n" +
+	"\x18GetProductsByIDsResponse\x12.\n" +
+	"\bproducts\x18\x01 \x03(\v2\x12.inventory.ProductR\bproducts\"\x94\x03\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x19\n" +
-	"\x05price\x18\x04 \x01(\x01H\x02R\x05price\x88\x01\x01\x12$\n" +
-	"\vstock_level\x18\x05 \x01(\x05H\x03R\n" +
+	"\x05price\x18\x04 \x01(\x01H\x02R\x05price\x88\x01\x01\x12(\n" +
+	"\vstock_level\x18\x05 \x01(\x05B\x02\x18\x01H\x03R\n" +
 	"stockLevel\x88\x01\x01\x12$\n" +
 	"\vcategory_id\x18\x06 \x01(\tH\x04R\n" +
-	"categoryId\x88\x01\x01B\a\n" +
+	"categoryId\x88\x01\x01\x120\n" +
+	"\x11reorder_threshold\x18\a \x01(\x05H\x05R\x10reorderThreshold\x88\x01\x01\x126\n" +
+	"\x17clear_reorder_threshold\x18\b \x01(\bR\x15clearReorderThresholdB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\b\n" +
 	"\x06_priceB\x0e\n" +
 	"\f_stock_levelB\x0e\n" +
-	"\f_category_id\"&\n" +
+	"\f_category_idB\x14\n" +
+	"\x12_reorder_threshold\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe7\x03\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
 	"\vcategory_id\x18\x03 \x01(\tR\n" +
-	"categoryId\"\\\n" +
+	"categoryId\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12E\n" +
+	"\aoptions\x18\x05 \x03(\v2+.inventory.ListProductsRequest.OptionsEntryR\aoptions\x12 \n" +
+	"\tmin_price\x18\x06 \x01(\x01H\x00R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\a \x01(\x01H\x01R\bmaxPrice\x88\x01\x01\x12\x16\n" +
+	"\x06search\x18\b \x01(\tR\x06search\x12\x12\n" +
+	"\x04sort\x18\t \x01(\tR\x04sort\x12\x19\n" +
+	"\bin_stock\x18\n" +
+	" \x01(\bR\ainStock\x12!\n" +
+	"\fon_promotion\x18\v \x01(\bR\vonPromotion\x12\x1b\n" +
+	"\x06cursor\x18\f \x01(\tH\x02R\x06cursor\x88\x01\x01\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_priceB\t\n" +
+	"\a_cursor\"G\n" +
+	"\x1bListLowStockProductsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xaf\x01\n" +
 	"\x14ListProductsResponse\x12.\n" +
 	"\bproducts\x18\x01 \x03(\v2\x12.inventory.ProductR\bproducts\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"?\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x120\n" +
+	"\x06facets\x18\x03 \x01(\v2\x18.inventory.ProductFacetsR\x06facets\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"\x88\x01\n" +
+	"\rProductFacets\x128\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x18.inventory.CategoryFacetR\n" +
+	"categories\x12=\n" +
+	"\fprice_ranges\x18\x02 \x03(\v2\x1a.inventory.PriceRangeFacetR\vpriceRanges\"Z\n" +
+	"\rCategoryFacet\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"X\n" +
+	"\x0fPriceRangeFacet\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x00R\x03max\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05countB\x06\n" +
+	"\x04_max\"?\n" +
 	"\x0fProductResponse\x12,\n" +
-	"\aproduct\x18\x01 \x01(\v2\x12.inventory.ProductR\aproduct\"\xc6\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.inventory.ProductR\aproduct\"\xb7\x03\n" +
+	"\x0eProductVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12@\n" +
+	"\aoptions\x18\x04 \x03(\v2&.inventory.ProductVariant.OptionsEntryR\aoptions\x12\x19\n" +
+	"\x05price\x18\x05 \x01(\x01H\x00R\x05price\x88\x01\x01\x12\x1f\n" +
+	"\vstock_level\x18\x06 \x01(\x05R\n" +
+	"stockLevel\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12*\n" +
+	"\x11available_to_sell\x18\t \x01(\x05R\x0favailableToSell\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_price\"\xee\x02\n" +
+	"\fProductMedia\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12\x19\n" +
+	"\x05width\x18\b \x01(\x05H\x00R\x05width\x88\x01\x01\x12\x1b\n" +
+	"\x06height\x18\t \x01(\x05H\x01R\x06height\x88\x01\x01\x12#\n" +
+	"\rhas_thumbnail\x18\n" +
+	" \x01(\bR\fhasThumbnail\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\b\n" +
+	"\x06_widthB\t\n" +
+	"\a_height\"\x91\x02\n" +
+	"\x14CreateVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12F\n" +
+	"\aoptions\x18\x03 \x03(\v2,.inventory.CreateVariantRequest.OptionsEntryR\aoptions\x12\x19\n" +
+	"\x05price\x18\x04 \x01(\x01H\x00R\x05price\x88\x01\x01\x12\x1f\n" +
+	"\vstock_level\x18\x05 \x01(\x05R\n" +
+	"stockLevel\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_price\"B\n" +
+	"\x11GetVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"4\n" +
+	"\x13ListVariantsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"M\n" +
+	"\x14ListVariantsResponse\x125\n" +
+	"\bvariants\x18\x01 \x03(\v2\x19.inventory.ProductVariantR\bvariants\"\x91\x03\n" +
+	"\x14UpdateVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x15\n" +
+	"\x03sku\x18\x03 \x01(\tH\x00R\x03sku\x88\x01\x01\x12F\n" +
+	"\aoptions\x18\x04 \x03(\v2,.inventory.UpdateVariantRequest.OptionsEntryR\aoptions\x12'\n" +
+	"\x0freplace_options\x18\x05 \x01(\bR\x0ereplaceOptions\x12\x19\n" +
+	"\x05price\x18\x06 \x01(\x01H\x01R\x05price\x88\x01\x01\x12\x1f\n" +
+	"\vclear_price\x18\a \x01(\bR\n" +
+	"clearPrice\x12(\n" +
+	"\vstock_level\x18\b \x01(\x05B\x02\x18\x01H\x02R\n" +
+	"stockLevel\x88\x01\x01\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x06\n" +
+	"\x04_skuB\b\n" +
+	"\x06_priceB\x0e\n" +
+	"\f_stock_level\"E\n" +
+	"\x14DeleteVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"F\n" +
+	"\x0fVariantResponse\x123\n" +
+	"\avariant\x18\x01 \x01(\v2\x19.inventory.ProductVariantR\avariant\"\xf0\x01\n" +
+	"\tWarehouse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Z\n" +
+	"\x16CreateWarehouseRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"%\n" +
+	"\x13GetWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa5\x01\n" +
+	"\x16UpdateWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x03 \x01(\tH\x01R\aaddress\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x04 \x01(\bH\x02R\bisActive\x88\x01\x01B\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_addressB\f\n" +
+	"\n" +
+	"_is_active\"(\n" +
+	"\x16DeleteWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15ListWarehousesRequest\"N\n" +
+	"\x16ListWarehousesResponse\x124\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x14.inventory.WarehouseR\n" +
+	"warehouses\"G\n" +
+	"\x11WarehouseResponse\x122\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.inventory.WarehouseR\twarehouse\"\xc8\x01\n" +
+	"\x0eWarehouseStock\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tR\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8e\x01\n" +
+	"\x0fSetStockRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tR\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"@\n" +
+	"\rStockResponse\x12/\n" +
+	"\x05stock\x18\x01 \x01(\v2\x19.inventory.WarehouseStockR\x05stock\"7\n" +
+	"\x16GetProductStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"v\n" +
+	"\x17GetProductStockResponse\x12/\n" +
+	"\x05stock\x18\x01 \x03(\v2\x19.inventory.WarehouseStockR\x05stock\x12*\n" +
+	"\x11available_to_sell\x18\x02 \x01(\x05R\x0favailableToSell\"\x9c\x02\n" +
+	"\rStockTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11from_warehouse_id\x18\x02 \x01(\tR\x0ffromWarehouseId\x12&\n" +
+	"\x0fto_warehouse_id\x18\x03 \x01(\tR\rtoWarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x05 \x01(\tR\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd8\x01\n" +
+	"\x14TransferStockRequest\x12*\n" +
+	"\x11from_warehouse_id\x18\x01 \x01(\tR\x0ffromWarehouseId\x12&\n" +
+	"\x0fto_warehouse_id\x18\x02 \x01(\tR\rtoWarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\tR\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"M\n" +
+	"\x15TransferStockResponse\x124\n" +
+	"\btransfer\x18\x01 \x01(\v2\x18.inventory.StockTransferR\btransfer\"\xd3\x02\n" +
+	"\rStockMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tR\tvariantId\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x05R\x05delta\x12#\n" +
+	"\rbalance_after\x18\x05 \x01(\x05R\fbalanceAfter\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12\x19\n" +
+	"\border_id\x18\b \x01(\tR\aorderId\x12\x12\n" +
+	"\x04note\x18\t \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fwarehouse_id\x18\v \x01(\tR\vwarehouseId\"\xd2\x01\n" +
+	"\x12AdjustStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12!\n" +
+	"\fwarehouse_id\x18\a \x01(\tR\vwarehouseId\"M\n" +
+	"\x15StockMovementResponse\x124\n" +
+	"\bmovement\x18\x01 \x01(\v2\x18.inventory.StockMovementR\bmovement\"\x83\x01\n" +
+	"\x19ListStockMovementsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"j\n" +
+	"\x1aListStockMovementsResponse\x126\n" +
+	"\tmovements\x18\x01 \x03(\v2\x18.inventory.StockMovementR\tmovements\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xf3\x02\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1568,20 +4581,35 @@ const file_inventory_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"M\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12?\n" +
+	"\x19default_reorder_threshold\x18\x06 \x01(\x05H\x00R\x17defaultReorderThreshold\x88\x01\x01\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\tR\bparentId\x12/\n" +
+	"\bchildren\x18\b \x03(\v2\x13.inventory.CategoryR\bchildrenB\x1c\n" +
+	"\x1a_default_reorder_threshold\"\xc9\x01\n" +
 	"\x15CreateCategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"$\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12?\n" +
+	"\x19default_reorder_threshold\x18\x03 \x01(\x05H\x00R\x17defaultReorderThreshold\x88\x01\x01\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentIdB\x1c\n" +
+	"\x1a_default_reorder_threshold\"$\n" +
 	"\x12GetCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x80\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa6\x02\n" +
 	"\x15UpdateCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12?\n" +
+	"\x19default_reorder_threshold\x18\x04 \x01(\x05H\x02R\x17defaultReorderThreshold\x88\x01\x01\x12E\n" +
+	"\x1fclear_default_reorder_threshold\x18\x05 \x01(\bR\x1cclearDefaultReorderThresholdB\a\n" +
 	"\x05_nameB\x0e\n" +
-	"\f_description\"'\n" +
+	"\f_descriptionB\x1c\n" +
+	"\x1a_default_reorder_threshold\"'\n" +
 	"\x15DeleteCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x16GetCategoryTreeRequest\x12\x17\n" +
+	"\aroot_id\x18\x01 \x01(\tR\x06rootId\"B\n" +
+	"\x13MoveCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"A\n" +
 	"\x15ListCategoriesRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"c\n" +
@@ -1643,27 +4671,57 @@ const file_inventory_inventory_proto_rawDesc = "" +
 	"\vdiscount_id\x18\x01 \x01(\tR\n" +
 	"discountId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"C\n" +
-	"\x10DiscountResponse\x12/\n" +
-	"\bdiscount\x18\x01 \x01(\v2\x13.inventory.DiscountR\bdiscount2\xb9\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"G\n" +
+	"$GetActiveDiscountsForProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\"d\n" +
+	"\x10ProductDiscounts\x12\x1d\n" +
 	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x121\n" +
+	"\tdiscounts\x18\x02 \x03(\v2\x13.inventory.DiscountR\tdiscounts\"q\n" +
+	"%GetActiveDiscountsForProductsResponse\x12H\n" +
+	"\x11product_discounts\x18\x01 \x03(\v2\x1b.inventory.ProductDiscountsR\x10productDiscounts\"C\n" +
+	"\x10DiscountResponse\x12/\n" +
+	"\bdiscount\x18\x01 \x01(\v2\x13.inventory.DiscountR\bdiscount2\xbb\x18\n" +
 	"\x10InventoryService\x12L\n" +
 	"\rCreateProduct\x12\x1f.inventory.CreateProductRequest\x1a\x1a.inventory.ProductResponse\x12J\n" +
-	"\x0eGetProductByID\x12\x1c.inventory.GetProductRequest\x1a\x1a.inventory.ProductResponse\x12L\n" +
+	"\x0eGetProductByID\x12\x1c.inventory.GetProductRequest\x1a\x1a.inventory.ProductResponse\x12[\n" +
+	"\x10GetProductsByIDs\x12\".inventory.GetProductsByIDsRequest\x1a#.inventory.GetProductsByIDsResponse\x12L\n" +
 	"\rUpdateProduct\x12\x1f.inventory.UpdateProductRequest\x1a\x1a.inventory.ProductResponse\x12H\n" +
 	"\rDeleteProduct\x12\x1f.inventory.DeleteProductRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
-	"\fListProducts\x12\x1e.inventory.ListProductsRequest\x1a\x1f.inventory.ListProductsResponse\x12O\n" +
+	"\fListProducts\x12\x1e.inventory.ListProductsRequest\x1a\x1f.inventory.ListProductsResponse\x12_\n" +
+	"\x14ListLowStockProducts\x12&.inventory.ListLowStockProductsRequest\x1a\x1f.inventory.ListProductsResponse\x12L\n" +
+	"\rCreateVariant\x12\x1f.inventory.CreateVariantRequest\x1a\x1a.inventory.VariantResponse\x12F\n" +
+	"\n" +
+	"GetVariant\x12\x1c.inventory.GetVariantRequest\x1a\x1a.inventory.VariantResponse\x12O\n" +
+	"\fListVariants\x12\x1e.inventory.ListVariantsRequest\x1a\x1f.inventory.ListVariantsResponse\x12L\n" +
+	"\rUpdateVariant\x12\x1f.inventory.UpdateVariantRequest\x1a\x1a.inventory.VariantResponse\x12H\n" +
+	"\rDeleteVariant\x12\x1f.inventory.DeleteVariantRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x0fCreateWarehouse\x12!.inventory.CreateWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12L\n" +
+	"\fGetWarehouse\x12\x1e.inventory.GetWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12R\n" +
+	"\x0fUpdateWarehouse\x12!.inventory.UpdateWarehouseRequest\x1a\x1c.inventory.WarehouseResponse\x12L\n" +
+	"\x0fDeleteWarehouse\x12!.inventory.DeleteWarehouseRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x0eListWarehouses\x12 .inventory.ListWarehousesRequest\x1a!.inventory.ListWarehousesResponse\x12@\n" +
+	"\bSetStock\x12\x1a.inventory.SetStockRequest\x1a\x18.inventory.StockResponse\x12X\n" +
+	"\x0fGetProductStock\x12!.inventory.GetProductStockRequest\x1a\".inventory.GetProductStockResponse\x12R\n" +
+	"\rTransferStock\x12\x1f.inventory.TransferStockRequest\x1a .inventory.TransferStockResponse\x12N\n" +
+	"\vAdjustStock\x12\x1d.inventory.AdjustStockRequest\x1a .inventory.StockMovementResponse\x12a\n" +
+	"\x12ListStockMovements\x12$.inventory.ListStockMovementsRequest\x1a%.inventory.ListStockMovementsResponse\x12O\n" +
 	"\x0eCreateCategory\x12 .inventory.CreateCategoryRequest\x1a\x1b.inventory.CategoryResponse\x12M\n" +
 	"\x0fGetCategoryByID\x12\x1d.inventory.GetCategoryRequest\x1a\x1b.inventory.CategoryResponse\x12O\n" +
 	"\x0eUpdateCategory\x12 .inventory.UpdateCategoryRequest\x1a\x1b.inventory.CategoryResponse\x12J\n" +
 	"\x0eDeleteCategory\x12 .inventory.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
-	"\x0eListCategories\x12 .inventory.ListCategoriesRequest\x1a!.inventory.ListCategoriesResponse\x12O\n" +
+	"\x0eListCategories\x12 .inventory.ListCategoriesRequest\x1a!.inventory.ListCategoriesResponse\x12W\n" +
+	"\x0fGetCategoryTree\x12!.inventory.GetCategoryTreeRequest\x1a!.inventory.ListCategoriesResponse\x12Z\n" +
+	"\x16GetCategoryBreadcrumbs\x12\x1d.inventory.GetCategoryRequest\x1a!.inventory.ListCategoriesResponse\x12K\n" +
+	"\fMoveCategory\x12\x1e.inventory.MoveCategoryRequest\x1a\x1b.inventory.CategoryResponse\x12O\n" +
 	"\x0eCreateDiscount\x12 .inventory.CreateDiscountRequest\x1a\x1b.inventory.DiscountResponse\x12M\n" +
 	"\x0fGetDiscountByID\x12\x1d.inventory.GetDiscountRequest\x1a\x1b.inventory.DiscountResponse\x12O\n" +
 	"\x0eUpdateDiscount\x12 .inventory.UpdateDiscountRequest\x1a\x1b.inventory.DiscountResponse\x12J\n" +
 	"\x0eDeleteDiscount\x12 .inventory.DeleteDiscountRequest\x1a\x16.google.protobuf.Empty\x12j\n" +
 	"\x1bGetAllProductsWithPromotion\x12*.inventory.GetProductsWithPromotionRequest\x1a\x1f.inventory.ListProductsResponse\x12e\n" +
-	"\x17GetProductsByDiscountID\x12).inventory.GetProductsByDiscountIDRequest\x1a\x1f.inventory.ListProductsResponseB4Z2github.com/baccala1010/e-commerce/inventory/pkg/pbb\x06proto3"
+	"\x17GetProductsByDiscountID\x12).inventory.GetProductsByDiscountIDRequest\x1a\x1f.inventory.ListProductsResponse\x12\x82\x01\n" +
+	"\x1dGetActiveDiscountsForProducts\x12/.inventory.GetActiveDiscountsForProductsRequest\x1a0.inventory.GetActiveDiscountsForProductsResponseB4Z2github.com/baccala1010/e-commerce/inventory/pkg/pbb\x06proto3"
 
 var (
 	file_inventory_inventory_proto_rawDescOnce sync.Once
@@ -1677,91 +4735,208 @@ func file_inventory_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_inventory_proto_rawDescData
 }
 
-var file_inventory_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_inventory_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_inventory_inventory_proto_goTypes = []any{
-	(*Product)(nil),                         // 0: inventory.Product
-	(*CreateProductRequest)(nil),            // 1: inventory.CreateProductRequest
-	(*GetProductRequest)(nil),               // 2: inventory.GetProductRequest
-	(*UpdateProductRequest)(nil),            // 3: inventory.UpdateProductRequest
-	(*DeleteProductRequest)(nil),            // 4: inventory.DeleteProductRequest
-	(*ListProductsRequest)(nil),             // 5: inventory.ListProductsRequest
-	(*ListProductsResponse)(nil),            // 6: inventory.ListProductsResponse
-	(*ProductResponse)(nil),                 // 7: inventory.ProductResponse
-	(*Category)(nil),                        // 8: inventory.Category
-	(*CreateCategoryRequest)(nil),           // 9: inventory.CreateCategoryRequest
-	(*GetCategoryRequest)(nil),              // 10: inventory.GetCategoryRequest
-	(*UpdateCategoryRequest)(nil),           // 11: inventory.UpdateCategoryRequest
-	(*DeleteCategoryRequest)(nil),           // 12: inventory.DeleteCategoryRequest
-	(*ListCategoriesRequest)(nil),           // 13: inventory.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),          // 14: inventory.ListCategoriesResponse
-	(*CategoryResponse)(nil),                // 15: inventory.CategoryResponse
-	(*Discount)(nil),                        // 16: inventory.Discount
-	(*CreateDiscountRequest)(nil),           // 17: inventory.CreateDiscountRequest
-	(*GetDiscountRequest)(nil),              // 18: inventory.GetDiscountRequest
-	(*UpdateDiscountRequest)(nil),           // 19: inventory.UpdateDiscountRequest
-	(*DeleteDiscountRequest)(nil),           // 20: inventory.DeleteDiscountRequest
-	(*GetProductsWithPromotionRequest)(nil), // 21: inventory.GetProductsWithPromotionRequest
-	(*GetProductsByDiscountIDRequest)(nil),  // 22: inventory.GetProductsByDiscountIDRequest
-	(*DiscountResponse)(nil),                // 23: inventory.DiscountResponse
-	(*timestamppb.Timestamp)(nil),           // 24: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                   // 25: google.protobuf.Empty
+	(*Product)(nil),                               // 0: inventory.Product
+	(*CreateProductRequest)(nil),                  // 1: inventory.CreateProductRequest
+	(*GetProductRequest)(nil),                     // 2: inventory.GetProductRequest
+	(*GetProductsByIDsRequest)(nil),               // 3: inventory.GetProductsByIDsRequest
+	(*GetProductsByIDsResponse)(nil),              // 4: inventory.GetProductsByIDsResponse
+	(*UpdateProductRequest)(nil),                  // 5: inventory.UpdateProductRequest
+	(*DeleteProductRequest)(nil),                  // 6: inventory.DeleteProductRequest
+	(*ListProductsRequest)(nil),                   // 7: inventory.ListProductsRequest
+	(*ListLowStockProductsRequest)(nil),           // 8: inventory.ListLowStockProductsRequest
+	(*ListProductsResponse)(nil),                  // 9: inventory.ListProductsResponse
+	(*ProductFacets)(nil),                         // 10: inventory.ProductFacets
+	(*CategoryFacet)(nil),                         // 11: inventory.CategoryFacet
+	(*PriceRangeFacet)(nil),                       // 12: inventory.PriceRangeFacet
+	(*ProductResponse)(nil),                       // 13: inventory.ProductResponse
+	(*ProductVariant)(nil),                        // 14: inventory.ProductVariant
+	(*ProductMedia)(nil),                          // 15: inventory.ProductMedia
+	(*CreateVariantRequest)(nil),                  // 16: inventory.CreateVariantRequest
+	(*GetVariantRequest)(nil),                     // 17: inventory.GetVariantRequest
+	(*ListVariantsRequest)(nil),                   // 18: inventory.ListVariantsRequest
+	(*ListVariantsResponse)(nil),                  // 19: inventory.ListVariantsResponse
+	(*UpdateVariantRequest)(nil),                  // 20: inventory.UpdateVariantRequest
+	(*DeleteVariantRequest)(nil),                  // 21: inventory.DeleteVariantRequest
+	(*VariantResponse)(nil),                       // 22: inventory.VariantResponse
+	(*Warehouse)(nil),                             // 23: inventory.Warehouse
+	(*CreateWarehouseRequest)(nil),                // 24: inventory.CreateWarehouseRequest
+	(*GetWarehouseRequest)(nil),                   // 25: inventory.GetWarehouseRequest
+	(*UpdateWarehouseRequest)(nil),                // 26: inventory.UpdateWarehouseRequest
+	(*DeleteWarehouseRequest)(nil),                // 27: inventory.DeleteWarehouseRequest
+	(*ListWarehousesRequest)(nil),                 // 28: inventory.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),                // 29: inventory.ListWarehousesResponse
+	(*WarehouseResponse)(nil),                     // 30: inventory.WarehouseResponse
+	(*WarehouseStock)(nil),                        // 31: inventory.WarehouseStock
+	(*SetStockRequest)(nil),                       // 32: inventory.SetStockRequest
+	(*StockResponse)(nil),                         // 33: inventory.StockResponse
+	(*GetProductStockRequest)(nil),                // 34: inventory.GetProductStockRequest
+	(*GetProductStockResponse)(nil),               // 35: inventory.GetProductStockResponse
+	(*StockTransfer)(nil),                         // 36: inventory.StockTransfer
+	(*TransferStockRequest)(nil),                  // 37: inventory.TransferStockRequest
+	(*TransferStockResponse)(nil),                 // 38: inventory.TransferStockResponse
+	(*StockMovement)(nil),                         // 39: inventory.StockMovement
+	(*AdjustStockRequest)(nil),                    // 40: inventory.AdjustStockRequest
+	(*StockMovementResponse)(nil),                 // 41: inventory.StockMovementResponse
+	(*ListStockMovementsRequest)(nil),             // 42: inventory.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),            // 43: inventory.ListStockMovementsResponse
+	(*Category)(nil),                              // 44: inventory.Category
+	(*CreateCategoryRequest)(nil),                 // 45: inventory.CreateCategoryRequest
+	(*GetCategoryRequest)(nil),                    // 46: inventory.GetCategoryRequest
+	(*UpdateCategoryRequest)(nil),                 // 47: inventory.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),                 // 48: inventory.DeleteCategoryRequest
+	(*GetCategoryTreeRequest)(nil),                // 49: inventory.GetCategoryTreeRequest
+	(*MoveCategoryRequest)(nil),                   // 50: inventory.MoveCategoryRequest
+	(*ListCategoriesRequest)(nil),                 // 51: inventory.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),                // 52: inventory.ListCategoriesResponse
+	(*CategoryResponse)(nil),                      // 53: inventory.CategoryResponse
+	(*Discount)(nil),                              // 54: inventory.Discount
+	(*CreateDiscountRequest)(nil),                 // 55: inventory.CreateDiscountRequest
+	(*GetDiscountRequest)(nil),                    // 56: inventory.GetDiscountRequest
+	(*UpdateDiscountRequest)(nil),                 // 57: inventory.UpdateDiscountRequest
+	(*DeleteDiscountRequest)(nil),                 // 58: inventory.DeleteDiscountRequest
+	(*GetProductsWithPromotionRequest)(nil),       // 59: inventory.GetProductsWithPromotionRequest
+	(*GetProductsByDiscountIDRequest)(nil),        // 60: inventory.GetProductsByDiscountIDRequest
+	(*GetActiveDiscountsForProductsRequest)(nil),  // 61: inventory.GetActiveDiscountsForProductsRequest
+	(*ProductDiscounts)(nil),                      // 62: inventory.ProductDiscounts
+	(*GetActiveDiscountsForProductsResponse)(nil), // 63: inventory.GetActiveDiscountsForProductsResponse
+	(*DiscountResponse)(nil),                      // 64: inventory.DiscountResponse
+	nil,                                           // 65: inventory.ListProductsRequest.OptionsEntry
+	nil,                                           // 66: inventory.ProductVariant.OptionsEntry
+	nil,                                           // 67: inventory.CreateVariantRequest.OptionsEntry
+	nil,                                           // 68: inventory.UpdateVariantRequest.OptionsEntry
+	(*timestamppb.Timestamp)(nil),                 // 69: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                         // 70: google.protobuf.Empty
 }
 var file_inventory_inventory_proto_depIdxs = []int32{
-	8,  // 0: inventory.Product.category:type_name -> inventory.Category
-	24, // 1: inventory.Product.created_at:type_name -> google.protobuf.Timestamp
-	24, // 2: inventory.Product.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: inventory.ListProductsResponse.products:type_name -> inventory.Product
-	0,  // 4: inventory.ProductResponse.product:type_name -> inventory.Product
-	24, // 5: inventory.Category.created_at:type_name -> google.protobuf.Timestamp
-	24, // 6: inventory.Category.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 7: inventory.ListCategoriesResponse.categories:type_name -> inventory.Category
-	8,  // 8: inventory.CategoryResponse.category:type_name -> inventory.Category
-	24, // 9: inventory.Discount.start_date:type_name -> google.protobuf.Timestamp
-	24, // 10: inventory.Discount.end_date:type_name -> google.protobuf.Timestamp
-	24, // 11: inventory.Discount.created_at:type_name -> google.protobuf.Timestamp
-	24, // 12: inventory.Discount.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 13: inventory.CreateDiscountRequest.start_date:type_name -> google.protobuf.Timestamp
-	24, // 14: inventory.CreateDiscountRequest.end_date:type_name -> google.protobuf.Timestamp
-	24, // 15: inventory.UpdateDiscountRequest.start_date:type_name -> google.protobuf.Timestamp
-	24, // 16: inventory.UpdateDiscountRequest.end_date:type_name -> google.protobuf.Timestamp
-	16, // 17: inventory.DiscountResponse.discount:type_name -> inventory.Discount
-	1,  // 18: inventory.InventoryService.CreateProduct:input_type -> inventory.CreateProductRequest
-	2,  // 19: inventory.InventoryService.GetProductByID:input_type -> inventory.GetProductRequest
-	3,  // 20: inventory.InventoryService.UpdateProduct:input_type -> inventory.UpdateProductRequest
-	4,  // 21: inventory.InventoryService.DeleteProduct:input_type -> inventory.DeleteProductRequest
-	5,  // 22: inventory.InventoryService.ListProducts:input_type -> inventory.ListProductsRequest
-	9,  // 23: inventory.InventoryService.CreateCategory:input_type -> inventory.CreateCategoryRequest
-	10, // 24: inventory.InventoryService.GetCategoryByID:input_type -> inventory.GetCategoryRequest
-	11, // 25: inventory.InventoryService.UpdateCategory:input_type -> inventory.UpdateCategoryRequest
-	12, // 26: inventory.InventoryService.DeleteCategory:input_type -> inventory.DeleteCategoryRequest
-	13, // 27: inventory.InventoryService.ListCategories:input_type -> inventory.ListCategoriesRequest
-	17, // 28: inventory.InventoryService.CreateDiscount:input_type -> inventory.CreateDiscountRequest
-	18, // 29: inventory.InventoryService.GetDiscountByID:input_type -> inventory.GetDiscountRequest
-	19, // 30: inventory.InventoryService.UpdateDiscount:input_type -> inventory.UpdateDiscountRequest
-	20, // 31: inventory.InventoryService.DeleteDiscount:input_type -> inventory.DeleteDiscountRequest
-	21, // 32: inventory.InventoryService.GetAllProductsWithPromotion:input_type -> inventory.GetProductsWithPromotionRequest
-	22, // 33: inventory.InventoryService.GetProductsByDiscountID:input_type -> inventory.GetProductsByDiscountIDRequest
-	7,  // 34: inventory.InventoryService.CreateProduct:output_type -> inventory.ProductResponse
-	7,  // 35: inventory.InventoryService.GetProductByID:output_type -> inventory.ProductResponse
-	7,  // 36: inventory.InventoryService.UpdateProduct:output_type -> inventory.ProductResponse
-	25, // 37: inventory.InventoryService.DeleteProduct:output_type -> google.protobuf.Empty
-	6,  // 38: inventory.InventoryService.ListProducts:output_type -> inventory.ListProductsResponse
-	15, // 39: inventory.InventoryService.CreateCategory:output_type -> inventory.CategoryResponse
-	15, // 40: inventory.InventoryService.GetCategoryByID:output_type -> inventory.CategoryResponse
-	15, // 41: inventory.InventoryService.UpdateCategory:output_type -> inventory.CategoryResponse
-	25, // 42: inventory.InventoryService.DeleteCategory:output_type -> google.protobuf.Empty
-	14, // 43: inventory.InventoryService.ListCategories:output_type -> inventory.ListCategoriesResponse
-	23, // 44: inventory.InventoryService.CreateDiscount:output_type -> inventory.DiscountResponse
-	23, // 45: inventory.InventoryService.GetDiscountByID:output_type -> inventory.DiscountResponse
-	23, // 46: inventory.InventoryService.UpdateDiscount:output_type -> inventory.DiscountResponse
-	25, // 47: inventory.InventoryService.DeleteDiscount:output_type -> google.protobuf.Empty
-	6,  // 48: inventory.InventoryService.GetAllProductsWithPromotion:output_type -> inventory.ListProductsResponse
-	6,  // 49: inventory.InventoryService.GetProductsByDiscountID:output_type -> inventory.ListProductsResponse
-	34, // [34:50] is the sub-list for method output_type
-	18, // [18:34] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	44, // 0: inventory.Product.category:type_name -> inventory.Category
+	69, // 1: inventory.Product.created_at:type_name -> google.protobuf.Timestamp
+	69, // 2: inventory.Product.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 3: inventory.Product.variants:type_name -> inventory.ProductVariant
+	15, // 4: inventory.Product.media:type_name -> inventory.ProductMedia
+	0,  // 5: inventory.GetProductsByIDsResponse.products:type_name -> inventory.Product
+	65, // 6: inventory.ListProductsRequest.options:type_name -> inventory.ListProductsRequest.OptionsEntry
+	0,  // 7: inventory.ListProductsResponse.products:type_name -> inventory.Product
+	10, // 8: inventory.ListProductsResponse.facets:type_name -> inventory.ProductFacets
+	11, // 9: inventory.ProductFacets.categories:type_name -> inventory.CategoryFacet
+	12, // 10: inventory.ProductFacets.price_ranges:type_name -> inventory.PriceRangeFacet
+	0,  // 11: inventory.ProductResponse.product:type_name -> inventory.Product
+	66, // 12: inventory.ProductVariant.options:type_name -> inventory.ProductVariant.OptionsEntry
+	69, // 13: inventory.ProductVariant.created_at:type_name -> google.protobuf.Timestamp
+	69, // 14: inventory.ProductVariant.updated_at:type_name -> google.protobuf.Timestamp
+	69, // 15: inventory.ProductMedia.created_at:type_name -> google.protobuf.Timestamp
+	67, // 16: inventory.CreateVariantRequest.options:type_name -> inventory.CreateVariantRequest.OptionsEntry
+	14, // 17: inventory.ListVariantsResponse.variants:type_name -> inventory.ProductVariant
+	68, // 18: inventory.UpdateVariantRequest.options:type_name -> inventory.UpdateVariantRequest.OptionsEntry
+	14, // 19: inventory.VariantResponse.variant:type_name -> inventory.ProductVariant
+	69, // 20: inventory.Warehouse.created_at:type_name -> google.protobuf.Timestamp
+	69, // 21: inventory.Warehouse.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 22: inventory.ListWarehousesResponse.warehouses:type_name -> inventory.Warehouse
+	23, // 23: inventory.WarehouseResponse.warehouse:type_name -> inventory.Warehouse
+	69, // 24: inventory.WarehouseStock.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 25: inventory.StockResponse.stock:type_name -> inventory.WarehouseStock
+	31, // 26: inventory.GetProductStockResponse.stock:type_name -> inventory.WarehouseStock
+	69, // 27: inventory.StockTransfer.created_at:type_name -> google.protobuf.Timestamp
+	36, // 28: inventory.TransferStockResponse.transfer:type_name -> inventory.StockTransfer
+	69, // 29: inventory.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	39, // 30: inventory.StockMovementResponse.movement:type_name -> inventory.StockMovement
+	39, // 31: inventory.ListStockMovementsResponse.movements:type_name -> inventory.StockMovement
+	69, // 32: inventory.Category.created_at:type_name -> google.protobuf.Timestamp
+	69, // 33: inventory.Category.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 34: inventory.Category.children:type_name -> inventory.Category
+	44, // 35: inventory.ListCategoriesResponse.categories:type_name -> inventory.Category
+	44, // 36: inventory.CategoryResponse.category:type_name -> inventory.Category
+	69, // 37: inventory.Discount.start_date:type_name -> google.protobuf.Timestamp
+	69, // 38: inventory.Discount.end_date:type_name -> google.protobuf.Timestamp
+	69, // 39: inventory.Discount.created_at:type_name -> google.protobuf.Timestamp
+	69, // 40: inventory.Discount.updated_at:type_name -> google.protobuf.Timestamp
+	69, // 41: inventory.CreateDiscountRequest.start_date:type_name -> google.protobuf.Timestamp
+	69, // 42: inventory.CreateDiscountRequest.end_date:type_name -> google.protobuf.Timestamp
+	69, // 43: inventory.UpdateDiscountRequest.start_date:type_name -> google.protobuf.Timestamp
+	69, // 44: inventory.UpdateDiscountRequest.end_date:type_name -> google.protobuf.Timestamp
+	54, // 45: inventory.ProductDiscounts.discounts:type_name -> inventory.Discount
+	62, // 46: inventory.GetActiveDiscountsForProductsResponse.product_discounts:type_name -> inventory.ProductDiscounts
+	54, // 47: inventory.DiscountResponse.discount:type_name -> inventory.Discount
+	1,  // 48: inventory.InventoryService.CreateProduct:input_type -> inventory.CreateProductRequest
+	2,  // 49: inventory.InventoryService.GetProductByID:input_type -> inventory.GetProductRequest
+	3,  // 50: inventory.InventoryService.GetProductsByIDs:input_type -> inventory.GetProductsByIDsRequest
+	5,  // 51: inventory.InventoryService.UpdateProduct:input_type -> inventory.UpdateProductRequest
+	6,  // 52: inventory.InventoryService.DeleteProduct:input_type -> inventory.DeleteProductRequest
+	7,  // 53: inventory.InventoryService.ListProducts:input_type -> inventory.ListProductsRequest
+	8,  // 54: inventory.InventoryService.ListLowStockProducts:input_type -> inventory.ListLowStockProductsRequest
+	16, // 55: inventory.InventoryService.CreateVariant:input_type -> inventory.CreateVariantRequest
+	17, // 56: inventory.InventoryService.GetVariant:input_type -> inventory.GetVariantRequest
+	18, // 57: inventory.InventoryService.ListVariants:input_type -> inventory.ListVariantsRequest
+	20, // 58: inventory.InventoryService.UpdateVariant:input_type -> inventory.UpdateVariantRequest
+	21, // 59: inventory.InventoryService.DeleteVariant:input_type -> inventory.DeleteVariantRequest
+	24, // 60: inventory.InventoryService.CreateWarehouse:input_type -> inventory.CreateWarehouseRequest
+	25, // 61: inventory.InventoryService.GetWarehouse:input_type -> inventory.GetWarehouseRequest
+	26, // 62: inventory.InventoryService.UpdateWarehouse:input_type -> inventory.UpdateWarehouseRequest
+	27, // 63: inventory.InventoryService.DeleteWarehouse:input_type -> inventory.DeleteWarehouseRequest
+	28, // 64: inventory.InventoryService.ListWarehouses:input_type -> inventory.ListWarehousesRequest
+	32, // 65: inventory.InventoryService.SetStock:input_type -> inventory.SetStockRequest
+	34, // 66: inventory.InventoryService.GetProductStock:input_type -> inventory.GetProductStockRequest
+	37, // 67: inventory.InventoryService.TransferStock:input_type -> inventory.TransferStockRequest
+	40, // 68: inventory.InventoryService.AdjustStock:input_type -> inventory.AdjustStockRequest
+	42, // 69: inventory.InventoryService.ListStockMovements:input_type -> inventory.ListStockMovementsRequest
+	45, // 70: inventory.InventoryService.CreateCategory:input_type -> inventory.CreateCategoryRequest
+	46, // 71: inventory.InventoryService.GetCategoryByID:input_type -> inventory.GetCategoryRequest
+	47, // 72: inventory.InventoryService.UpdateCategory:input_type -> inventory.UpdateCategoryRequest
+	48, // 73: inventory.InventoryService.DeleteCategory:input_type -> inventory.DeleteCategoryRequest
+	51, // 74: inventory.InventoryService.ListCategories:input_type -> inventory.ListCategoriesRequest
+	49, // 75: inventory.InventoryService.GetCategoryTree:input_type -> inventory.GetCategoryTreeRequest
+	46, // 76: inventory.InventoryService.GetCategoryBreadcrumbs:input_type -> inventory.GetCategoryRequest
+	50, // 77: inventory.InventoryService.MoveCategory:input_type -> inventory.MoveCategoryRequest
+	55, // 78: inventory.InventoryService.CreateDiscount:input_type -> inventory.CreateDiscountRequest
+	56, // 79: inventory.InventoryService.GetDiscountByID:input_type -> inventory.GetDiscountRequest
+	57, // 80: inventory.InventoryService.UpdateDiscount:input_type -> inventory.UpdateDiscountRequest
+	58, // 81: inventory.InventoryService.DeleteDiscount:input_type -> inventory.DeleteDiscountRequest
+	59, // 82: inventory.InventoryService.GetAllProductsWithPromotion:input_type -> inventory.GetProductsWithPromotionRequest
+	60, // 83: inventory.InventoryService.GetProductsByDiscountID:input_type -> inventory.GetProductsByDiscountIDRequest
+	61, // 84: inventory.InventoryService.GetActiveDiscountsForProducts:input_type -> inventory.GetActiveDiscountsForProductsRequest
+	13, // 85: inventory.InventoryService.CreateProduct:output_type -> inventory.ProductResponse
+	13, // 86: inventory.InventoryService.GetProductByID:output_type -> inventory.ProductResponse
+	4,  // 87: inventory.InventoryService.GetProductsByIDs:output_type -> inventory.GetProductsByIDsResponse
+	13, // 88: inventory.InventoryService.UpdateProduct:output_type -> inventory.ProductResponse
+	70, // 89: inventory.InventoryService.DeleteProduct:output_type -> google.protobuf.Empty
+	9,  // 90: inventory.InventoryService.ListProducts:output_type -> inventory.ListProductsResponse
+	9,  // 91: inventory.InventoryService.ListLowStockProducts:output_type -> inventory.ListProductsResponse
+	22, // 92: inventory.InventoryService.CreateVariant:output_type -> inventory.VariantResponse
+	22, // 93: inventory.InventoryService.GetVariant:output_type -> inventory.VariantResponse
+	19, // 94: inventory.InventoryService.ListVariants:output_type -> inventory.ListVariantsResponse
+	22, // 95: inventory.InventoryService.UpdateVariant:output_type -> inventory.VariantResponse
+	70, // 96: inventory.InventoryService.DeleteVariant:output_type -> google.protobuf.Empty
+	30, // 97: inventory.InventoryService.CreateWarehouse:output_type -> inventory.WarehouseResponse
+	30, // 98: inventory.InventoryService.GetWarehouse:output_type -> inventory.WarehouseResponse
+	30, // 99: inventory.InventoryService.UpdateWarehouse:output_type -> inventory.WarehouseResponse
+	70, // 100: inventory.InventoryService.DeleteWarehouse:output_type -> google.protobuf.Empty
+	29, // 101: inventory.InventoryService.ListWarehouses:output_type -> inventory.ListWarehousesResponse
+	33, // 102: inventory.InventoryService.SetStock:output_type -> inventory.StockResponse
+	35, // 103: inventory.InventoryService.GetProductStock:output_type -> inventory.GetProductStockResponse
+	38, // 104: inventory.InventoryService.TransferStock:output_type -> inventory.TransferStockResponse
+	41, // 105: inventory.InventoryService.AdjustStock:output_type -> inventory.StockMovementResponse
+	43, // 106: inventory.InventoryService.ListStockMovements:output_type -> inventory.ListStockMovementsResponse
+	53, // 107: inventory.InventoryService.CreateCategory:output_type -> inventory.CategoryResponse
+	53, // 108: inventory.InventoryService.GetCategoryByID:output_type -> inventory.CategoryResponse
+	53, // 109: inventory.InventoryService.UpdateCategory:output_type -> inventory.CategoryResponse
+	70, // 110: inventory.InventoryService.DeleteCategory:output_type -> google.protobuf.Empty
+	52, // 111: inventory.InventoryService.ListCategories:output_type -> inventory.ListCategoriesResponse
+	52, // 112: inventory.InventoryService.GetCategoryTree:output_type -> inventory.ListCategoriesResponse
+	52, // 113: inventory.InventoryService.GetCategoryBreadcrumbs:output_type -> inventory.ListCategoriesResponse
+	53, // 114: inventory.InventoryService.MoveCategory:output_type -> inventory.CategoryResponse
+	64, // 115: inventory.InventoryService.CreateDiscount:output_type -> inventory.DiscountResponse
+	64, // 116: inventory.InventoryService.GetDiscountByID:output_type -> inventory.DiscountResponse
+	64, // 117: inventory.InventoryService.UpdateDiscount:output_type -> inventory.DiscountResponse
+	70, // 118: inventory.InventoryService.DeleteDiscount:output_type -> google.protobuf.Empty
+	9,  // 119: inventory.InventoryService.GetAllProductsWithPromotion:output_type -> inventory.ListProductsResponse
+	9,  // 120: inventory.InventoryService.GetProductsByDiscountID:output_type -> inventory.ListProductsResponse
+	63, // 121: inventory.InventoryService.GetActiveDiscountsForProducts:output_type -> inventory.GetActiveDiscountsForProductsResponse
+	85, // [85:122] is the sub-list for method output_type
+	48, // [48:85] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_inventory_inventory_proto_init() }
@@ -1769,16 +4944,27 @@ func file_inventory_inventory_proto_init() {
 	if File_inventory_inventory_proto != nil {
 		return
 	}
-	file_inventory_inventory_proto_msgTypes[3].OneofWrappers = []any{}
-	file_inventory_inventory_proto_msgTypes[11].OneofWrappers = []any{}
-	file_inventory_inventory_proto_msgTypes[19].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[0].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[1].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[5].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[7].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[12].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[14].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[15].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[16].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[20].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[26].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[44].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[45].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[47].OneofWrappers = []any{}
+	file_inventory_inventory_proto_msgTypes[57].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_inventory_proto_rawDesc), len(file_inventory_inventory_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
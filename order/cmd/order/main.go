@@ -19,10 +19,15 @@ import (
 	"github.com/baccala1010/e-commerce/order/internal/middleware"
 	"github.com/baccala1010/e-commerce/order/internal/repository"
 	"github.com/baccala1010/e-commerce/order/internal/usecase"
+	"github.com/baccala1010/e-commerce/order/pkg/grpcserver"
+	"github.com/baccala1010/e-commerce/order/pkg/health"
+	"github.com/baccala1010/e-commerce/order/pkg/kafka"
+	"github.com/baccala1010/e-commerce/order/pkg/metrics"
 	"github.com/baccala1010/e-commerce/order/pkg/pb"
+	"github.com/baccala1010/e-commerce/order/pkg/postgre"
+	"github.com/baccala1010/e-commerce/order/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc"
 )
 
 func main() {
@@ -36,6 +41,18 @@ func main() {
 	// Set up logging
 	app.SetupLogging(cfg)
 
+	// Set up tracing
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Options{
+		ServiceName: cfg.Server.Name,
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.GetEndpoint(),
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.GetSampleRatio(),
+	})
+	if err != nil {
+		logrus.Fatalf("Failed to initialize tracing: %v", err)
+	}
+
 	// Initialize database
 	db, err := database.InitDB(cfg)
 	if err != nil {
@@ -61,8 +78,19 @@ func main() {
 		return cachedOrderRepo.(*repository.CachedOrderRepository).RefreshCache()
 	})
 
+	// Initialize Kafka producer for order status events
+	kafkaProducer, err := kafka.NewProducer(cfg.Kafka.BootstrapServers, cfg.Kafka.Topics.OrderStatusEvents)
+	if err != nil {
+		logrus.Warnf("Failed to initialize Kafka producer: %v", err)
+		logrus.Warn("Order status events will not be published to Kafka")
+		kafkaProducer = nil
+	} else {
+		logrus.Info("Kafka producer initialized successfully")
+		defer kafkaProducer.Close()
+	}
+
 	// Initialize use cases
-	orderUseCase := usecase.NewOrderUseCase(cachedOrderRepo)
+	orderUseCase := usecase.NewOrderUseCase(cachedOrderRepo, kafkaProducer)
 	reviewUseCase := usecase.NewReviewUseCase(reviewRepo, orderRepo)
 
 	// Initialize handlers
@@ -72,6 +100,13 @@ func main() {
 	// Create backoffice gRPC server instance
 	backofficeServer := backoffice.NewServer(orderUseCase, reviewUseCase)
 
+	// Set up health checks
+	healthChecker := health.NewChecker(cfg.Server.Name, cfg.Health.GetCheckTimeout())
+	healthChecker.Register("database", func(ctx context.Context) error {
+		return postgre.Ping(ctx, db)
+	})
+	healthChecker.RegisterOptional("kafka", kafkaProducer.Ping)
+
 	// Set up Gin router
 	gin.SetMode(gin.ReleaseMode)
 	if cfg.Logging.Level == "debug" {
@@ -79,14 +114,20 @@ func main() {
 	}
 
 	router := gin.New()
+	router.Use(middleware.RequestID())
 	router.Use(middleware.Recovery())
+	router.Use(middleware.Tracing(cfg.Server.Name))
 	router.Use(middleware.Logger())
+	router.Use(middleware.Metrics())
 	router.Use(middleware.CORS())
 
-	// Register routes
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{"status": "up", "service": cfg.Server.Name})
-	})
+	// Liveness and readiness probes; /health reports readiness for existing checks
+	router.GET("/health", gin.WrapH(healthChecker.ReadyHandler()))
+	router.GET("/health/live", gin.WrapH(healthChecker.LiveHandler()))
+	router.GET("/health/ready", gin.WrapH(healthChecker.ReadyHandler()))
+
+	// Expose Prometheus metrics
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
 
 	// Register routes
 	v1 := router.Group("/api/v1")
@@ -114,7 +155,9 @@ func main() {
 
 	// Start the gRPC server
 	grpcAddr := fmt.Sprintf(":%d", cfg.Server.GRPCPort)
-	grpcServer := grpc.NewServer()
+	grpcServer := grpcserver.New(grpcserver.Options{
+		DefaultTimeout: cfg.Server.GetGRPCTimeout(),
+	})
 	pb.RegisterOrderServiceServer(grpcServer, backofficeServer)
 
 	grpcListener, err := net.Listen("tcp", grpcAddr)
@@ -146,6 +189,11 @@ func main() {
 	<-signalChan
 	logrus.Info("Received termination signal, shutting down...")
 
+	// Report not ready and give load balancers time to stop sending requests
+	healthChecker.Shutdown()
+	grpcServer.Shutdown()
+	time.Sleep(cfg.Health.GetShutdownDelay())
+
 	// Stop periodic cache refresh
 	orderCache.StopPeriodicRefresh()
 
@@ -160,4 +208,8 @@ func main() {
 		logrus.Errorf("HTTP server shutdown error: %v", err)
 	}
 	logrus.Info("HTTP server stopped")
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logrus.Errorf("Tracing shutdown error: %v", err)
+	}
 }
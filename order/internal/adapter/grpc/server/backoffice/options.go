@@ -18,11 +18,30 @@ func convertOrderToProto(order *model.Order) *pb.Order {
 		ShippingPhone:   order.ShippingPhone,
 		ShippingAddress: order.ShippingAddr,
 		Payment:         convertPaymentToProto(&order.Payment),
+		Items:           convertOrderItemsToProto(order.Items),
 		CreatedAt:       timestamppb.New(order.CreatedAt),
 		UpdatedAt:       timestamppb.New(order.UpdatedAt),
 	}
 }
 
+func convertOrderItemsToProto(items []model.OrderItem) []*pb.OrderItem {
+	protoItems := make([]*pb.OrderItem, 0, len(items))
+	for _, item := range items {
+		protoItem := &pb.OrderItem{
+			Id:        item.ID.String(),
+			OrderId:   item.OrderID.String(),
+			ProductId: item.ProductID.String(),
+			Quantity:  int32(item.Quantity),
+			UnitPrice: item.UnitPrice,
+		}
+		if item.VariantID != nil {
+			protoItem.VariantId = item.VariantID.String()
+		}
+		protoItems = append(protoItems, protoItem)
+	}
+	return protoItems
+}
+
 func convertPaymentToProto(payment *model.Payment) *pb.Payment {
 	return &pb.Payment{
 		Id:            payment.ID.String(),
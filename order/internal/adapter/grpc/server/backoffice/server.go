@@ -2,7 +2,6 @@ package backoffice
 
 import (
 	"context"
-	"time"
 
 	"github.com/baccala1010/e-commerce/order/internal/model"
 	"github.com/baccala1010/e-commerce/order/pkg/pb"
@@ -19,6 +18,30 @@ func (s *Server) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
 	}
 
+	items := make([]model.OrderItemDTO, 0, len(req.Items))
+	for _, item := range req.Items {
+		productID, err := uuid.Parse(item.ProductId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+		}
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "quantity must be positive")
+		}
+		itemDTO := model.OrderItemDTO{
+			ProductID: productID,
+			Quantity:  int(item.Quantity),
+			UnitPrice: item.UnitPrice,
+		}
+		if item.VariantId != "" {
+			variantID, err := uuid.Parse(item.VariantId)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid variant ID: %v", err)
+			}
+			itemDTO.VariantID = &variantID
+		}
+		items = append(items, itemDTO)
+	}
+
 	createReq := model.CreateOrderRequest{
 		UserID:        userID,
 		TotalAmount:   req.TotalAmount,
@@ -26,12 +49,13 @@ func (s *Server) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*
 		ShippingEmail: req.ShippingEmail,
 		ShippingPhone: req.ShippingPhone,
 		ShippingAddr:  req.ShippingAddress,
+		Items:         items,
 		Payment: model.PaymentDTO{
 			Method: convertProtoPaymentMethodToModel(req.Payment.Method),
 		},
 	}
 
-	order, err := s.orderUseCase.CreateOrder(createReq)
+	order, err := s.orderUseCase.CreateOrder(ctx, createReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create order: %v", err)
 	}
@@ -47,7 +71,7 @@ func (s *Server) GetOrderByID(ctx context.Context, req *pb.GetOrderRequest) (*pb
 		return nil, status.Errorf(codes.InvalidArgument, "invalid order ID: %v", err)
 	}
 
-	order, err := s.orderUseCase.GetOrderByID(orderID)
+	order, err := s.orderUseCase.GetOrderByID(ctx, orderID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get order: %v", err)
 	}
@@ -71,7 +95,7 @@ func (s *Server) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatu
 		Status: convertProtoOrderStatusToModel(req.Status),
 	}
 
-	order, err := s.orderUseCase.UpdateOrderStatus(orderID, updateReq)
+	order, err := s.orderUseCase.UpdateOrderStatus(ctx, orderID, updateReq)
 	if err != nil {
 		if err.Error() == "order not found" {
 			return nil, status.Errorf(codes.NotFound, "order not found")
@@ -93,7 +117,27 @@ func (s *Server) ListUserOrders(ctx context.Context, req *pb.ListUserOrdersReque
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
 	}
 
-	orders, total, err := s.orderUseCase.ListUserOrders(userID, int(req.Page), int(req.Limit))
+	if req.Cursor != nil {
+		orders, next, err := s.orderUseCase.ListUserOrdersAfter(ctx, userID, *req.Cursor, int(req.Limit))
+		if err != nil {
+			if err.Error() == model.ErrInvalidCursor {
+				return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to list user orders: %v", err)
+		}
+
+		protoOrders := make([]*pb.Order, len(orders))
+		for i := range orders {
+			protoOrders[i] = convertOrderToProto(&orders[i])
+		}
+
+		return &pb.ListOrdersResponse{
+			Orders:     protoOrders,
+			NextCursor: next,
+		}, nil
+	}
+
+	orders, total, err := s.orderUseCase.ListUserOrders(ctx, userID, int(req.Page), int(req.Limit))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list user orders: %v", err)
 	}
@@ -116,34 +160,16 @@ func (s *Server) ProcessPayment(ctx context.Context, req *pb.ProcessPaymentReque
 		return nil, status.Errorf(codes.InvalidArgument, "invalid order ID: %v", err)
 	}
 
-	// Get the order to ensure it exists and to get the payment details
-	order, err := s.orderUseCase.GetOrderByID(orderID)
+	payment, err := s.orderUseCase.ProcessPayment(ctx, orderID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get order: %v", err)
-	}
-
-	if order == nil {
-		return nil, status.Errorf(codes.NotFound, "order not found")
-	}
-
-	// In a real implementation, this would call a payment processor
-	// For now, we'll just simulate a successful payment
-	payment := order.Payment
-	payment.Status = model.PaymentStatusSuccess
-	payment.TransactionID = uuid.New().String()
-	payment.PaymentDate = time.Now()
-
-	// Update the order status to paid
-	updateReq := model.UpdateOrderStatusRequest{
-		Status: model.OrderStatusPaid,
-	}
-	_, err = s.orderUseCase.UpdateOrderStatus(orderID, updateReq)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update order status: %v", err)
+		if err.Error() == model.ErrOrderNotFound {
+			return nil, status.Errorf(codes.NotFound, "order not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to process payment: %v", err)
 	}
 
 	return &pb.PaymentResponse{
-		Payment: convertPaymentToProto(&payment),
+		Payment: convertPaymentToProto(payment),
 	}, nil
 }
 
@@ -188,7 +214,7 @@ func (s *Server) CreateReview(ctx context.Context, req *pb.CreateReviewRequest)
 		Description: req.Description,
 	}
 
-	review, err := s.reviewUseCase.CreateReview(createReq)
+	review, err := s.reviewUseCase.CreateReview(ctx, createReq)
 	if err != nil {
 		if err.Error() == model.ErrOrderNotFound {
 			return nil, status.Errorf(codes.NotFound, "order not found")
@@ -207,7 +233,7 @@ func (s *Server) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.R
 		return nil, status.Errorf(codes.InvalidArgument, "invalid review ID: %v", err)
 	}
 
-	review, err := s.reviewUseCase.GetReviewByID(reviewID)
+	review, err := s.reviewUseCase.GetReviewByID(ctx, reviewID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get review: %v", err)
 	}
@@ -227,7 +253,7 @@ func (s *Server) GetOrderReviews(ctx context.Context, req *pb.GetOrderReviewsReq
 		return nil, status.Errorf(codes.InvalidArgument, "invalid order ID: %v", err)
 	}
 
-	reviews, err := s.reviewUseCase.GetReviewsByOrderID(orderID)
+	reviews, err := s.reviewUseCase.GetReviewsByOrderID(ctx, orderID)
 	if err != nil {
 		if err.Error() == model.ErrOrderNotFound {
 			return nil, status.Errorf(codes.NotFound, "order not found")
@@ -251,7 +277,7 @@ func (s *Server) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid review ID: %v", err)
 	}
 
-	if err := s.reviewUseCase.DeleteReview(reviewID); err != nil {
+	if err := s.reviewUseCase.DeleteReview(ctx, reviewID); err != nil {
 		if err.Error() == model.ErrReviewNotFound {
 			return nil, status.Errorf(codes.NotFound, "review not found")
 		}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/baccala1010/e-commerce/order/internal/model"
+	"github.com/baccala1010/e-commerce/order/pkg/metrics"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
@@ -35,6 +36,7 @@ func (c *MemoryCache) GetOrder(id uuid.UUID) (*model.Order, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	order, exists := c.orders[id]
+	metrics.CacheLookup("order", exists)
 	if !exists {
 		return nil, false
 	}
@@ -62,6 +64,7 @@ func (c *MemoryCache) GetOrderList(key string) ([]model.Order, int64, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	item, exists := c.orderLists[key]
+	metrics.CacheLookup("order_list", exists)
 	if !exists {
 		return nil, 0, false
 	}
@@ -12,13 +12,25 @@ type Config struct {
 	Database         DatabaseConfig
 	InventoryService InventoryServiceConfig `mapstructure:"inventory_service"`
 	Kafka            KafkaConfig
+	Tracing          TracingConfig
+	Health           HealthConfig
 	Logging          LoggingConfig
 }
 
 type ServerConfig struct {
-	Port     int
-	GRPCPort int `mapstructure:"grpc_port"`
-	Name     string
+	Port        int
+	GRPCPort    int    `mapstructure:"grpc_port"`
+	GRPCTimeout string `mapstructure:"grpc_timeout"`
+	Name        string
+}
+
+// GetGRPCTimeout returns the timeout of gRPC calls without a deadline, 10 seconds by default
+func (sc *ServerConfig) GetGRPCTimeout() time.Duration {
+	d, err := time.ParseDuration(sc.GRPCTimeout)
+	if err != nil || d <= 0 {
+		return 10 * time.Second
+	}
+	return d
 }
 
 type DatabaseConfig struct {
@@ -37,18 +49,69 @@ type InventoryServiceConfig struct {
 	BaseURL string `mapstructure:"base_url"`
 }
 
+// TracingConfig selects where spans are exported: "otlp", "stdout" or "none"
+type TracingConfig struct {
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// GetEndpoint returns the OTLP collector address, localhost:4317 by default
+func (tc *TracingConfig) GetEndpoint() string {
+	if tc.Endpoint == "" {
+		return "localhost:4317"
+	}
+	return tc.Endpoint
+}
+
+// GetSampleRatio returns the share of traces started here that are sampled, all by default
+func (tc *TracingConfig) GetSampleRatio() float64 {
+	if tc.SampleRatio <= 0 || tc.SampleRatio > 1 {
+		return 1
+	}
+	return tc.SampleRatio
+}
+
 type LoggingConfig struct {
 	Level string
 }
 
+// HealthConfig tunes the readiness probe and the graceful shutdown
+type HealthConfig struct {
+	CheckTimeout  string `mapstructure:"check_timeout"`
+	ShutdownDelay string `mapstructure:"shutdown_delay"`
+}
+
+// GetCheckTimeout returns the time each dependency check may take, 2 seconds by default
+func (hc *HealthConfig) GetCheckTimeout() time.Duration {
+	d, err := time.ParseDuration(hc.CheckTimeout)
+	if err != nil || d <= 0 {
+		return 2 * time.Second
+	}
+	return d
+}
+
+// GetShutdownDelay returns how long the service keeps serving after it
+// stopped reporting ready, so load balancers can take it out first. No delay
+// by default.
+func (hc *HealthConfig) GetShutdownDelay() time.Duration {
+	d, err := time.ParseDuration(hc.ShutdownDelay)
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
+
 type KafkaConfig struct {
 	BootstrapServers string `mapstructure:"bootstrap_servers"`
 	Topics           KafkaTopics
 }
 
 type KafkaTopics struct {
-	OrderEvents string `mapstructure:"order_events"`
-	UserEvents  string `mapstructure:"user_events"`
+	OrderEvents       string `mapstructure:"order_events"`
+	UserEvents        string `mapstructure:"user_events"`
+	OrderStatusEvents string `mapstructure:"order_status_events"`
 }
 
 func LoadConfig(path string) (*Config, error) {
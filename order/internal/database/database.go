@@ -40,6 +40,7 @@ func InitDB(cfg *config.Config) (*gorm.DB, error) {
 	if err := db.AutoMigrate(
 		&model.Order{},
 		&model.Payment{},
+		&model.OrderItem{},
 	); err != nil {
 		return nil, err
 	}
@@ -27,7 +27,7 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 		return
 	}
 
-	order, err := h.orderUseCase.CreateOrder(req)
+	order, err := h.orderUseCase.CreateOrder(c.Request.Context(), req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -44,7 +44,7 @@ func (h *OrderHandler) GetOrderByID(c *gin.Context) {
 		return
 	}
 
-	order, err := h.orderUseCase.GetOrderByID(id)
+	order, err := h.orderUseCase.GetOrderByID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -72,7 +72,7 @@ func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
 		return
 	}
 
-	order, err := h.orderUseCase.UpdateOrderStatus(id, req)
+	order, err := h.orderUseCase.UpdateOrderStatus(c.Request.Context(), id, req)
 	if err != nil {
 		if err.Error() == "order not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -108,7 +108,27 @@ func (h *OrderHandler) ListUserOrders(c *gin.Context) {
 		pageSize = 10
 	}
 
-	orders, total, err := h.orderUseCase.ListUserOrders(userID, page, pageSize)
+	// A cursor, even an empty one for the first page, selects keyset pagination
+	if cursor, ok := c.GetQuery("cursor"); ok {
+		orders, next, err := h.orderUseCase.ListUserOrdersAfter(c.Request.Context(), userID, cursor, pageSize)
+		if err != nil {
+			if err.Error() == model.ErrInvalidCursor {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"data":        orders,
+			"next_cursor": next,
+			"page_size":   pageSize,
+		})
+		return
+	}
+
+	orders, total, err := h.orderUseCase.ListUserOrders(c.Request.Context(), userID, page, pageSize)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
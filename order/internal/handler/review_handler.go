@@ -29,7 +29,7 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 		return
 	}
 
-	review, err := h.reviewUseCase.CreateReview(req)
+	review, err := h.reviewUseCase.CreateReview(c.Request.Context(), req)
 	if err != nil {
 		if err.Error() == model.ErrOrderNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -51,7 +51,7 @@ func (h *ReviewHandler) GetReviewByID(c *gin.Context) {
 		return
 	}
 
-	review, err := h.reviewUseCase.GetReviewByID(id)
+	review, err := h.reviewUseCase.GetReviewByID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -74,7 +74,7 @@ func (h *ReviewHandler) GetReviewsByOrderID(c *gin.Context) {
 		return
 	}
 
-	reviews, err := h.reviewUseCase.GetReviewsByOrderID(orderID)
+	reviews, err := h.reviewUseCase.GetReviewsByOrderID(c.Request.Context(), orderID)
 	if err != nil {
 		if err.Error() == model.ErrOrderNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -96,7 +96,7 @@ func (h *ReviewHandler) DeleteReview(c *gin.Context) {
 		return
 	}
 
-	if err := h.reviewUseCase.DeleteReview(id); err != nil {
+	if err := h.reviewUseCase.DeleteReview(c.Request.Context(), id); err != nil {
 		if err.Error() == model.ErrReviewNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
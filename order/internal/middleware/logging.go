@@ -20,7 +20,7 @@ func Logger() gin.HandlerFunc {
 		latency := time.Since(start)
 
 		// Log request details
-		logrus.WithFields(logrus.Fields{
+		logrus.WithContext(c.Request.Context()).WithFields(logrus.Fields{
 			"status":     c.Writer.Status(),
 			"method":     c.Request.Method,
 			"path":       c.Request.URL.Path,
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"github.com/baccala1010/e-commerce/order/pkg/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestID middleware for tagging each request with the request ID sent by
+// the caller, or a new one, so that log lines of the request can be correlated
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(logging.RequestIDHeader)
+		if !logging.ValidID(requestID) {
+			requestID = logging.NewRequestID()
+		}
+		c.Header(logging.RequestIDHeader, requestID)
+
+		ctx := logging.WithRequestID(c.Request.Context(), requestID)
+		if userID := c.GetHeader(logging.UserIDHeader); logging.ValidID(userID) {
+			ctx = logging.WithUserID(ctx, userID)
+		}
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
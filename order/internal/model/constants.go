@@ -4,4 +4,5 @@ package model
 const (
 	ErrOrderNotFound  = "order not found"
 	ErrReviewNotFound = "review not found"
+	ErrInvalidCursor  = "invalid cursor"
 )
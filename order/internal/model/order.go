@@ -19,7 +19,7 @@ const (
 
 type Order struct {
 	ID            uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
-	UserID        uuid.UUID      `json:"user_id" gorm:"type:uuid;not null"`
+	UserID        uuid.UUID      `json:"user_id" gorm:"type:uuid;not null;index:idx_orders_user_created,priority:1"`
 	Status        OrderStatus    `json:"status" gorm:"type:varchar(20);not null;default:'pending'"`
 	TotalAmount   float64        `json:"total_amount" gorm:"type:decimal(10,2);not null"`
 	ShippingName  string         `json:"shipping_name" gorm:"type:varchar(255);not null"`
@@ -27,7 +27,8 @@ type Order struct {
 	ShippingPhone string         `json:"shipping_phone" gorm:"type:varchar(20);not null"`
 	ShippingAddr  string         `json:"shipping_address" gorm:"type:text;not null"`
 	Payment       Payment        `json:"payment" gorm:"foreignKey:OrderID"`
-	CreatedAt     time.Time      `json:"created_at" gorm:"not null;default:now()"`
+	Items         []OrderItem    `json:"items" gorm:"foreignKey:OrderID"`
+	CreatedAt     time.Time      `json:"created_at" gorm:"not null;default:now();index:idx_orders_user_created,priority:2"`
 	UpdatedAt     time.Time      `json:"updated_at" gorm:"not null;default:now()"`
 	DeletedAt     gorm.DeletedAt `json:"-" gorm:"index"`
 }
@@ -44,15 +45,45 @@ func (o *Order) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// OrderItem is a product line of an order. VariantID is set when a specific
+// variant of the product was ordered.
+type OrderItem struct {
+	ID        uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	OrderID   uuid.UUID      `json:"order_id" gorm:"type:uuid;not null;index"`
+	ProductID uuid.UUID      `json:"product_id" gorm:"type:uuid;not null"`
+	VariantID *uuid.UUID     `json:"variant_id,omitempty" gorm:"type:uuid;index"`
+	Quantity  int            `json:"quantity" gorm:"not null"`
+	UnitPrice float64        `json:"unit_price" gorm:"type:decimal(10,2);not null"`
+	CreatedAt time.Time      `json:"created_at" gorm:"not null;default:now()"`
+	UpdatedAt time.Time      `json:"updated_at" gorm:"not null;default:now()"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+func (i *OrderItem) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// OrderItemDTO is used for order creation requests
+type OrderItemDTO struct {
+	ProductID uuid.UUID  `json:"product_id" binding:"required"`
+	VariantID *uuid.UUID `json:"variant_id"`
+	Quantity  int        `json:"quantity" binding:"required,gt=0"`
+	UnitPrice float64    `json:"unit_price" binding:"gte=0"`
+}
+
 // CreateOrderRequest represents the request body for creating a new order
 type CreateOrderRequest struct {
-	UserID        uuid.UUID  `json:"user_id" binding:"required"`
-	TotalAmount   float64    `json:"total_amount" binding:"required,gt=0"`
-	Payment       PaymentDTO `json:"payment" binding:"required"`
-	ShippingName  string     `json:"shipping_name" binding:"required"`
-	ShippingEmail string     `json:"shipping_email" binding:"required,email"`
-	ShippingPhone string     `json:"shipping_phone" binding:"required"`
-	ShippingAddr  string     `json:"shipping_address" binding:"required"`
+	UserID        uuid.UUID      `json:"user_id" binding:"required"`
+	TotalAmount   float64        `json:"total_amount" binding:"required,gt=0"`
+	Payment       PaymentDTO     `json:"payment" binding:"required"`
+	ShippingName  string         `json:"shipping_name" binding:"required"`
+	ShippingEmail string         `json:"shipping_email" binding:"required,email"`
+	ShippingPhone string         `json:"shipping_phone" binding:"required"`
+	ShippingAddr  string         `json:"shipping_address" binding:"required"`
+	Items         []OrderItemDTO `json:"items" binding:"omitempty,dive"`
 }
 
 // UpdateOrderStatusRequest represents the request body for updating an order status
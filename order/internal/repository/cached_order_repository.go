@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/baccala1010/e-commerce/order/internal/cache"
@@ -20,8 +21,8 @@ func NewCachedOrderRepository(repo OrderRepository, cache cache.OrderCache) Orde
 	}
 }
 
-func (r *CachedOrderRepository) Create(order *model.Order) error {
-	err := r.repo.Create(order)
+func (r *CachedOrderRepository) Create(ctx context.Context, order *model.Order) error {
+	err := r.repo.Create(ctx, order)
 	if err != nil {
 		return err
 	}
@@ -29,11 +30,11 @@ func (r *CachedOrderRepository) Create(order *model.Order) error {
 	return nil
 }
 
-func (r *CachedOrderRepository) FindByID(id uuid.UUID) (*model.Order, error) {
+func (r *CachedOrderRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Order, error) {
 	if order, found := r.cache.GetOrder(id); found {
 		return order, nil
 	}
-	order, err := r.repo.FindByID(id)
+	order, err := r.repo.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -44,12 +45,12 @@ func (r *CachedOrderRepository) FindByID(id uuid.UUID) (*model.Order, error) {
 }
 
 // Remove ListOrderParams and List method, wrap FindByUserID for caching
-func (r *CachedOrderRepository) FindByUserID(userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error) {
+func (r *CachedOrderRepository) FindByUserID(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error) {
 	cacheKey := fmt.Sprintf("user:%s:page:%d:size:%d", userID.String(), page, pageSize)
 	if orders, total, found := r.cache.GetOrderList(cacheKey); found {
 		return orders, total, nil
 	}
-	orders, total, err := r.repo.FindByUserID(userID, page, pageSize)
+	orders, total, err := r.repo.FindByUserID(ctx, userID, page, pageSize)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -57,6 +58,12 @@ func (r *CachedOrderRepository) FindByUserID(userID uuid.UUID, page, pageSize in
 	return orders, total, nil
 }
 
+// FindByUserIDAfter retrieves the page of orders of a user following a cursor. Cursor
+// pages are not cached, so new orders show up right away.
+func (r *CachedOrderRepository) FindByUserIDAfter(ctx context.Context, userID uuid.UUID, cursor string, pageSize int) ([]model.Order, string, error) {
+	return r.repo.FindByUserIDAfter(ctx, userID, cursor, pageSize)
+}
+
 func (r *CachedOrderRepository) RefreshCache() error {
 	// Example: refresh cache for first 100 users (in production, use a better approach)
 	// This is a placeholder; you may want to load all orders or recent orders
@@ -64,11 +71,17 @@ func (r *CachedOrderRepository) RefreshCache() error {
 }
 
 // Add missing Update method to satisfy OrderRepository interface
-func (r *CachedOrderRepository) Update(order *model.Order) error {
-	err := r.repo.Update(order)
+func (r *CachedOrderRepository) Update(ctx context.Context, order *model.Order) error {
+	err := r.repo.Update(ctx, order)
 	if err != nil {
 		return err
 	}
 	r.cache.SetOrder(order)
 	return nil
 }
+
+// UpdatePayment updates the payment of an order. The cached order is replaced
+// when the order itself is updated.
+func (r *CachedOrderRepository) UpdatePayment(ctx context.Context, payment *model.Payment) error {
+	return r.repo.UpdatePayment(ctx, payment)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// encodeCursor renders the position of a page as an opaque, URL safe cursor
+func encodeCursor(position interface{}) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor reads the position of a page back from a cursor
+func decodeCursor(cursor string, position interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, position)
+}
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/baccala1010/e-commerce/order/internal/model"
 	"github.com/google/uuid"
@@ -9,10 +11,12 @@ import (
 )
 
 type OrderRepository interface {
-	Create(order *model.Order) error
-	FindByID(id uuid.UUID) (*model.Order, error)
-	Update(order *model.Order) error
-	FindByUserID(userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error)
+	Create(ctx context.Context, order *model.Order) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.Order, error)
+	Update(ctx context.Context, order *model.Order) error
+	UpdatePayment(ctx context.Context, payment *model.Payment) error
+	FindByUserID(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error)
+	FindByUserIDAfter(ctx context.Context, userID uuid.UUID, cursor string, pageSize int) ([]model.Order, string, error)
 }
 
 type orderRepository struct {
@@ -23,14 +27,14 @@ func NewOrderRepository(db *gorm.DB) OrderRepository {
 	return &orderRepository{db: db}
 }
 
-func (r *orderRepository) Create(order *model.Order) error {
-	return r.db.Create(order).Error
+func (r *orderRepository) Create(ctx context.Context, order *model.Order) error {
+	return r.db.WithContext(ctx).Create(order).Error
 }
 
-func (r *orderRepository) FindByID(id uuid.UUID) (*model.Order, error) {
+func (r *orderRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Order, error) {
 	var order model.Order
 
-	if err := r.db.Preload("Payment").First(&order, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Payment").Preload("Items").First(&order, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -40,15 +44,19 @@ func (r *orderRepository) FindByID(id uuid.UUID) (*model.Order, error) {
 	return &order, nil
 }
 
-func (r *orderRepository) Update(order *model.Order) error {
-	return r.db.Save(order).Error
+func (r *orderRepository) Update(ctx context.Context, order *model.Order) error {
+	return r.db.WithContext(ctx).Save(order).Error
 }
 
-func (r *orderRepository) FindByUserID(userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error) {
+func (r *orderRepository) UpdatePayment(ctx context.Context, payment *model.Payment) error {
+	return r.db.WithContext(ctx).Save(payment).Error
+}
+
+func (r *orderRepository) FindByUserID(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error) {
 	var orders []model.Order
 	var total int64
 
-	query := r.db.Model(&model.Order{}).Where("user_id = ?", userID)
+	query := r.db.WithContext(ctx).Model(&model.Order{}).Where("user_id = ?", userID)
 
 	// Count total results
 	if err := query.Count(&total).Error; err != nil {
@@ -66,9 +74,56 @@ func (r *orderRepository) FindByUserID(userID uuid.UUID, page, pageSize int) ([]
 
 	offset := (page - 1) * pageSize
 
-	if err := query.Preload("Payment").Offset(offset).Limit(pageSize).Find(&orders).Error; err != nil {
+	if err := query.Preload("Payment").Preload("Items").Order("created_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&orders).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return orders, total, nil
 }
+
+// orderCursor is the position after the last order of a page
+type orderCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// FindByUserIDAfter returns the page of orders of a user following the cursor, or
+// the first page for an empty cursor, newest first, and the cursor of the next
+// page. The next cursor is empty on the last page. Unlike FindByUserID it neither
+// counts the orders nor skips rows, so orders placed meanwhile do not shift the pages.
+func (r *orderRepository) FindByUserIDAfter(ctx context.Context, userID uuid.UUID, cursor string, pageSize int) ([]model.Order, string, error) {
+	var orders []model.Order
+
+	query := r.db.WithContext(ctx).Model(&model.Order{}).Where("user_id = ?", userID)
+
+	if cursor != "" {
+		var after orderCursor
+		if err := decodeCursor(cursor, &after); err != nil {
+			return nil, "", errors.New(model.ErrInvalidCursor)
+		}
+		query = query.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	// One order more than the page tells whether there is a next page
+	if err := query.Preload("Payment").Preload("Items").Order("created_at DESC, id DESC").Limit(pageSize + 1).Find(&orders).Error; err != nil {
+		return nil, "", err
+	}
+
+	if len(orders) <= pageSize {
+		return orders, "", nil
+	}
+
+	orders = orders[:pageSize]
+	last := orders[len(orders)-1]
+
+	next, err := encodeCursor(orderCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return orders, next, nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/baccala1010/e-commerce/order/internal/model"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -8,10 +9,10 @@ import (
 
 // ReviewRepository defines the interface for review repository operations
 type ReviewRepository interface {
-	CreateReview(review *model.Review) (*model.Review, error)
-	GetReviewByID(id uuid.UUID) (*model.Review, error)
-	GetReviewsByOrderID(orderID uuid.UUID) ([]model.Review, error)
-	DeleteReview(id uuid.UUID) error
+	CreateReview(ctx context.Context, review *model.Review) (*model.Review, error)
+	GetReviewByID(ctx context.Context, id uuid.UUID) (*model.Review, error)
+	GetReviewsByOrderID(ctx context.Context, orderID uuid.UUID) ([]model.Review, error)
+	DeleteReview(ctx context.Context, id uuid.UUID) error
 }
 
 // reviewRepository implements the ReviewRepository interface
@@ -27,17 +28,17 @@ func NewReviewRepository(db *gorm.DB) ReviewRepository {
 }
 
 // CreateReview creates a new review
-func (r *reviewRepository) CreateReview(review *model.Review) (*model.Review, error) {
-	if err := r.db.Create(review).Error; err != nil {
+func (r *reviewRepository) CreateReview(ctx context.Context, review *model.Review) (*model.Review, error) {
+	if err := r.db.WithContext(ctx).Create(review).Error; err != nil {
 		return nil, err
 	}
 	return review, nil
 }
 
 // GetReviewByID gets a review by ID
-func (r *reviewRepository) GetReviewByID(id uuid.UUID) (*model.Review, error) {
+func (r *reviewRepository) GetReviewByID(ctx context.Context, id uuid.UUID) (*model.Review, error) {
 	var review model.Review
-	if err := r.db.Where("id = ?", id).First(&review).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&review).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -47,15 +48,15 @@ func (r *reviewRepository) GetReviewByID(id uuid.UUID) (*model.Review, error) {
 }
 
 // GetReviewsByOrderID gets all reviews for an order
-func (r *reviewRepository) GetReviewsByOrderID(orderID uuid.UUID) ([]model.Review, error) {
+func (r *reviewRepository) GetReviewsByOrderID(ctx context.Context, orderID uuid.UUID) ([]model.Review, error) {
 	var reviews []model.Review
-	if err := r.db.Where("order_id = ?", orderID).Find(&reviews).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("order_id = ?", orderID).Find(&reviews).Error; err != nil {
 		return nil, err
 	}
 	return reviews, nil
 }
 
 // DeleteReview deletes a review
-func (r *reviewRepository) DeleteReview(id uuid.UUID) error {
-	return r.db.Where("id = ?", id).Delete(&model.Review{}).Error
+func (r *reviewRepository) DeleteReview(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Review{}).Error
 }
\ No newline at end of file
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/baccala1010/e-commerce/order/internal/model"
+	"github.com/baccala1010/e-commerce/order/pkg/events"
+	"github.com/baccala1010/e-commerce/order/pkg/kafka"
+	"github.com/baccala1010/e-commerce/order/pkg/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// publishStatusEvent announces a status change of an order or its payment.
+// Publishing is best effort: a failure is logged and does not fail the
+// operation that caused it.
+func publishStatusEvent(ctx context.Context, producer *kafka.Producer, eventType string, order *model.Order, previous, current string) {
+	if producer == nil || previous == current {
+		return
+	}
+
+	// The order owner is carried along so that consumers can log it
+	if logging.UserID(ctx) == "" {
+		ctx = logging.WithUserID(ctx, order.UserID.String())
+	}
+
+	event := events.OrderStatusEvent{
+		Type:           eventType,
+		OrderID:        order.ID.String(),
+		UserID:         order.UserID.String(),
+		Status:         current,
+		PreviousStatus: previous,
+		Timestamp:      time.Now(),
+	}
+
+	value, err := json.Marshal(event)
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to encode %s event of order %s: %v", eventType, order.ID, err)
+		return
+	}
+
+	if err := producer.PublishEvent(ctx, order.UserID.String(), value); err != nil {
+		logrus.WithContext(ctx).Errorf("Failed to publish %s event of order %s: %v", eventType, order.ID, err)
+	}
+}
@@ -1,21 +1,24 @@
 package usecase
 
 import (
+	"context"
 	"github.com/baccala1010/e-commerce/order/internal/model"
 	"github.com/google/uuid"
 )
 
 // OrderUseCase represents the business logic interface for order operations
 type OrderUseCase interface {
-	CreateOrder(request model.CreateOrderRequest) (*model.Order, error)
-	GetOrderByID(id uuid.UUID) (*model.Order, error)
-	UpdateOrderStatus(id uuid.UUID, request model.UpdateOrderStatusRequest) (*model.Order, error)
-	ListUserOrders(userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error)
+	CreateOrder(ctx context.Context, request model.CreateOrderRequest) (*model.Order, error)
+	GetOrderByID(ctx context.Context, id uuid.UUID) (*model.Order, error)
+	UpdateOrderStatus(ctx context.Context, id uuid.UUID, request model.UpdateOrderStatusRequest) (*model.Order, error)
+	ListUserOrders(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error)
+	ListUserOrdersAfter(ctx context.Context, userID uuid.UUID, cursor string, pageSize int) ([]model.Order, string, error)
+	ProcessPayment(ctx context.Context, orderID uuid.UUID) (*model.Payment, error)
 }
 
 type ReviewUseCase interface {
-	CreateReview(request model.CreateReviewRequest) (*model.Review, error)
-	GetReviewByID(id uuid.UUID) (*model.Review, error)
-	GetReviewsByOrderID(orderID uuid.UUID) ([]model.Review, error)
-	DeleteReview(id uuid.UUID) error
+	CreateReview(ctx context.Context, request model.CreateReviewRequest) (*model.Review, error)
+	GetReviewByID(ctx context.Context, id uuid.UUID) (*model.Review, error)
+	GetReviewsByOrderID(ctx context.Context, orderID uuid.UUID) ([]model.Review, error)
+	DeleteReview(ctx context.Context, id uuid.UUID) error
 }
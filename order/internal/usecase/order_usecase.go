@@ -1,26 +1,32 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/baccala1010/e-commerce/order/internal/model"
 	"github.com/baccala1010/e-commerce/order/internal/repository"
+	"github.com/baccala1010/e-commerce/order/pkg/events"
+	"github.com/baccala1010/e-commerce/order/pkg/kafka"
 	"github.com/google/uuid"
 )
 
 type orderUseCase struct {
 	orderRepo repository.OrderRepository
+	producer  *kafka.Producer
 }
 
 // NewOrderUseCase creates a new order use case
-func NewOrderUseCase(orderRepo repository.OrderRepository) OrderUseCase {
+func NewOrderUseCase(orderRepo repository.OrderRepository, producer *kafka.Producer) OrderUseCase {
 	return &orderUseCase{
 		orderRepo: orderRepo,
+		producer:  producer,
 	}
 }
 
-func (u *orderUseCase) CreateOrder(request model.CreateOrderRequest) (*model.Order, error) {
+func (u *orderUseCase) CreateOrder(ctx context.Context, request model.CreateOrderRequest) (*model.Order, error) {
 	// Create the order directly from the request
 	order := &model.Order{
 		UserID:        request.UserID,
@@ -42,15 +48,24 @@ func (u *orderUseCase) CreateOrder(request model.CreateOrderRequest) (*model.Ord
 	// Associate payment with order
 	order.Payment = payment
 
-	if err := u.orderRepo.Create(order); err != nil {
+	for _, item := range request.Items {
+		order.Items = append(order.Items, model.OrderItem{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		})
+	}
+
+	if err := u.orderRepo.Create(ctx, order); err != nil {
 		return nil, fmt.Errorf("error creating order: %w", err)
 	}
 
 	return order, nil
 }
 
-func (u *orderUseCase) GetOrderByID(id uuid.UUID) (*model.Order, error) {
-	order, err := u.orderRepo.FindByID(id)
+func (u *orderUseCase) GetOrderByID(ctx context.Context, id uuid.UUID) (*model.Order, error) {
+	order, err := u.orderRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("error finding order: %w", err)
 	}
@@ -62,14 +77,14 @@ func (u *orderUseCase) GetOrderByID(id uuid.UUID) (*model.Order, error) {
 	return order, nil
 }
 
-func (u *orderUseCase) UpdateOrderStatus(id uuid.UUID, request model.UpdateOrderStatusRequest) (*model.Order, error) {
-	order, err := u.orderRepo.FindByID(id)
+func (u *orderUseCase) UpdateOrderStatus(ctx context.Context, id uuid.UUID, request model.UpdateOrderStatusRequest) (*model.Order, error) {
+	order, err := u.orderRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("error finding order: %w", err)
 	}
 
 	if order == nil {
-		return nil, errors.New("order not found")
+		return nil, errors.New(model.ErrOrderNotFound)
 	}
 
 	// Validate status transition
@@ -77,17 +92,62 @@ func (u *orderUseCase) UpdateOrderStatus(id uuid.UUID, request model.UpdateOrder
 		return nil, fmt.Errorf("invalid status transition from %s to %s", order.Status, request.Status)
 	}
 
+	previous := order.Status
 	order.Status = request.Status
 
-	if err := u.orderRepo.Update(order); err != nil {
+	if err := u.orderRepo.Update(ctx, order); err != nil {
 		return nil, fmt.Errorf("error updating order: %w", err)
 	}
 
+	publishStatusEvent(ctx, u.producer, events.TypeOrderStatus, order, string(previous), string(order.Status))
 	return order, nil
 }
 
-func (u *orderUseCase) ListUserOrders(userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error) {
-	return u.orderRepo.FindByUserID(userID, page, pageSize)
+func (u *orderUseCase) ProcessPayment(ctx context.Context, orderID uuid.UUID) (*model.Payment, error) {
+	order, err := u.orderRepo.FindByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding order: %w", err)
+	}
+
+	if order == nil {
+		return nil, errors.New(model.ErrOrderNotFound)
+	}
+
+	if !isValidStatusTransition(order.Status, model.OrderStatusPaid) {
+		return nil, fmt.Errorf("invalid status transition from %s to %s", order.Status, model.OrderStatusPaid)
+	}
+
+	// In a real implementation, this would call a payment processor
+	// For now, we'll just simulate a successful payment
+	previousPayment := order.Payment.Status
+	order.Payment.Status = model.PaymentStatusSuccess
+	order.Payment.TransactionID = uuid.New().String()
+	order.Payment.PaymentDate = time.Now()
+
+	if err := u.orderRepo.UpdatePayment(ctx, &order.Payment); err != nil {
+		return nil, fmt.Errorf("error updating payment: %w", err)
+	}
+
+	publishStatusEvent(ctx, u.producer, events.TypePaymentStatus, order, string(previousPayment), string(order.Payment.Status))
+
+	// Update the order status to paid
+	previous := order.Status
+	order.Status = model.OrderStatusPaid
+
+	if err := u.orderRepo.Update(ctx, order); err != nil {
+		return nil, fmt.Errorf("error updating order: %w", err)
+	}
+
+	publishStatusEvent(ctx, u.producer, events.TypeOrderStatus, order, string(previous), string(order.Status))
+	return &order.Payment, nil
+}
+
+func (u *orderUseCase) ListUserOrders(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]model.Order, int64, error) {
+	return u.orderRepo.FindByUserID(ctx, userID, page, pageSize)
+}
+
+func (u *orderUseCase) ListUserOrdersAfter(ctx context.Context, userID uuid.UUID, cursor string, pageSize int) ([]model.Order, string, error) {
+	return u.orderRepo.FindByUserIDAfter(ctx, userID, cursor, pageSize)
 }
 
 // isValidStatusTransition checks if the status transition is valid
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 
 	"github.com/baccala1010/e-commerce/order/internal/model"
@@ -23,9 +24,9 @@ func NewReviewUseCase(reviewRepo repository.ReviewRepository, orderRepo reposito
 }
 
 // CreateReview creates a new review
-func (u *reviewUseCase) CreateReview(request model.CreateReviewRequest) (*model.Review, error) {
+func (u *reviewUseCase) CreateReview(ctx context.Context, request model.CreateReviewRequest) (*model.Review, error) {
 	// Verify that the order exists
-	order, err := u.orderRepo.FindByID(request.OrderID)
+	order, err := u.orderRepo.FindByID(ctx, request.OrderID)
 	if err != nil {
 		return nil, err
 	}
@@ -41,18 +42,18 @@ func (u *reviewUseCase) CreateReview(request model.CreateReviewRequest) (*model.
 		Description: request.Description,
 	}
 
-	return u.reviewRepo.CreateReview(review)
+	return u.reviewRepo.CreateReview(ctx, review)
 }
 
 // GetReviewByID gets a review by ID
-func (u *reviewUseCase) GetReviewByID(id uuid.UUID) (*model.Review, error) {
-	return u.reviewRepo.GetReviewByID(id)
+func (u *reviewUseCase) GetReviewByID(ctx context.Context, id uuid.UUID) (*model.Review, error) {
+	return u.reviewRepo.GetReviewByID(ctx, id)
 }
 
 // GetReviewsByOrderID gets all reviews for an order
-func (u *reviewUseCase) GetReviewsByOrderID(orderID uuid.UUID) ([]model.Review, error) {
+func (u *reviewUseCase) GetReviewsByOrderID(ctx context.Context, orderID uuid.UUID) ([]model.Review, error) {
 	// Verify that the order exists
-	order, err := u.orderRepo.FindByID(orderID)
+	order, err := u.orderRepo.FindByID(ctx, orderID)
 	if err != nil {
 		return nil, err
 	}
@@ -60,13 +61,13 @@ func (u *reviewUseCase) GetReviewsByOrderID(orderID uuid.UUID) ([]model.Review,
 		return nil, errors.New(model.ErrOrderNotFound)
 	}
 
-	return u.reviewRepo.GetReviewsByOrderID(orderID)
+	return u.reviewRepo.GetReviewsByOrderID(ctx, orderID)
 }
 
 // DeleteReview deletes a review
-func (u *reviewUseCase) DeleteReview(id uuid.UUID) error {
+func (u *reviewUseCase) DeleteReview(ctx context.Context, id uuid.UUID) error {
 	// Verify that the review exists
-	review, err := u.reviewRepo.GetReviewByID(id)
+	review, err := u.reviewRepo.GetReviewByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -74,5 +75,5 @@ func (u *reviewUseCase) DeleteReview(id uuid.UUID) error {
 		return errors.New(model.ErrReviewNotFound)
 	}
 
-	return u.reviewRepo.DeleteReview(id)
+	return u.reviewRepo.DeleteReview(ctx, id)
 }
@@ -0,0 +1,21 @@
+package events
+
+import "time"
+
+// Kinds of order status events
+const (
+	TypeOrderStatus   = "order_status"
+	TypePaymentStatus = "payment_status"
+)
+
+// OrderStatusEvent is published by the order service whenever the status of an
+// order or of its payment changes. It is encoded as JSON and keyed by the user
+// ID, so the events of a user keep their order.
+type OrderStatusEvent struct {
+	Type           string    `json:"type"`
+	OrderID        string    `json:"order_id"`
+	UserID         string    `json:"user_id"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status"`
+	Timestamp      time.Time `json:"timestamp"`
+}
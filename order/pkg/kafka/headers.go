@@ -0,0 +1,41 @@
+package kafka
+
+import (
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// HeaderCarrier adapts the headers of a Kafka message to the OpenTelemetry
+// propagation API, so trace context travels with the message
+type HeaderCarrier struct {
+	Headers *[]kafka.Header
+}
+
+// Get returns the value of a header
+func (c HeaderCarrier) Get(key string) string {
+	for _, header := range *c.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// Set replaces the value of a header
+func (c HeaderCarrier) Set(key, value string) {
+	for i, header := range *c.Headers {
+		if header.Key == key {
+			(*c.Headers)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c.Headers = append(*c.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+// Keys returns the header keys
+func (c HeaderCarrier) Keys() []string {
+	keys := make([]string, len(*c.Headers))
+	for i, header := range *c.Headers {
+		keys[i] = header.Key
+	}
+	return keys
+}
@@ -1,11 +1,19 @@
 package kafka
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
 	"time"
 
+	"github.com/baccala1010/e-commerce/order/pkg/logging"
+	"github.com/baccala1010/e-commerce/order/pkg/metrics"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Producer represents a Kafka producer
@@ -28,10 +36,11 @@ func NewProducer(bootstrapServers, topicName string) (*Producer, error) {
 		for e := range p.Events() {
 			switch ev := e.(type) {
 			case *kafka.Message:
+				metrics.KafkaProduced(*ev.TopicPartition.Topic, ev.TopicPartition.Error)
 				if ev.TopicPartition.Error != nil {
-					log.Printf("Failed to deliver message: %v\n", ev.TopicPartition.Error)
+					logrus.Errorf("Failed to deliver message: %v", ev.TopicPartition.Error)
 				} else {
-					log.Printf("Message delivered to %v\n", ev.TopicPartition)
+					logrus.Debugf("Message delivered to %v", ev.TopicPartition)
 				}
 			}
 		}
@@ -43,16 +52,61 @@ func NewProducer(bootstrapServers, topicName string) (*Producer, error) {
 	}, nil
 }
 
-// PublishEvent publishes an event to Kafka
-func (p *Producer) PublishEvent(key string, value []byte) error {
+// PublishEvent publishes an event to Kafka. The trace context and request ID
+// of ctx are added to the message headers so consumers can continue the trace.
+func (p *Producer) PublishEvent(ctx context.Context, key string, value []byte) error {
+	ctx, span := otel.Tracer("kafka").Start(ctx, p.topicName+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(p.topicName),
+			semconv.MessagingKafkaMessageKey(key),
+		),
+	)
+	defer span.End()
+
 	message := &kafka.Message{
 		TopicPartition: kafka.TopicPartition{Topic: &p.topicName, Partition: kafka.PartitionAny},
 		Key:            []byte(key),
 		Value:          value,
 		Timestamp:      time.Now(),
 	}
+	carrier := HeaderCarrier{Headers: &message.Headers}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if requestID := logging.RequestID(ctx); requestID != "" {
+		carrier.Set(logging.RequestIDKey, requestID)
+	}
+	if userID := logging.UserID(ctx); userID != "" {
+		carrier.Set(logging.UserIDKey, userID)
+	}
+
+	if err := p.producer.Produce(message, nil); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
+
+// Ping checks that the brokers answer by fetching the metadata of the topic.
+// A producer that failed to initialize is reported as unavailable.
+func (p *Producer) Ping(ctx context.Context) error {
+	if p == nil {
+		return errors.New("kafka producer is not initialized")
+	}
+
+	timeoutMs := 5000
+	if deadline, ok := ctx.Deadline(); ok {
+		timeoutMs = int(time.Until(deadline).Milliseconds())
+	}
+	if timeoutMs <= 0 {
+		return context.DeadlineExceeded
+	}
 
-	return p.producer.Produce(message, nil)
+	if _, err := p.producer.GetMetadata(&p.topicName, false, timeoutMs); err != nil {
+		return fmt.Errorf("failed to fetch metadata of topic %s: %w", p.topicName, err)
+	}
+	return nil
 }
 
 // Close closes the Kafka producer
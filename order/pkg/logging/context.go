@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// Names under which the request ID and user ID travel between services
+const (
+	RequestIDHeader = "X-Request-ID"
+	UserIDHeader    = "X-User-ID"
+
+	// gRPC metadata and Kafka header keys are lower case
+	RequestIDKey = "x-request-id"
+	UserIDKey    = "x-user-id"
+)
+
+type requestIDKey struct{}
+
+type userIDKey struct{}
+
+// validID matches the IDs accepted from callers, so they cannot inject
+// arbitrary content into logs and headers
+var validID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// NewRequestID generates a request ID
+func NewRequestID() string {
+	return uuid.NewString()
+}
+
+// ValidID reports whether an ID received from a caller can be used as is
+func ValidID(id string) bool {
+	return validID.MatchString(id)
+}
+
+// WithRequestID returns a context carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID returns the request ID carried by the context, if any
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// WithUserID returns a context carrying the ID of the user the request is made for
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserID returns the user ID carried by the context, if any
+func UserID(ctx context.Context) string {
+	userID, _ := ctx.Value(userIDKey{}).(string)
+	return userID
+}
@@ -267,6 +267,7 @@ type Order struct {
 	Payment         *Payment               `protobuf:"bytes,9,opt,name=payment,proto3" json:"payment,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Items           []*OrderItem           `protobuf:"bytes,12,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -378,6 +379,98 @@ func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// variant_id is empty unless a specific variant of the product was ordered
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	VariantId     string                 `protobuf:"bytes,6,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_order_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderItem) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *OrderItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
 type CreateOrderRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -387,13 +480,14 @@ type CreateOrderRequest struct {
 	ShippingEmail   string                 `protobuf:"bytes,5,opt,name=shipping_email,json=shippingEmail,proto3" json:"shipping_email,omitempty"`
 	ShippingPhone   string                 `protobuf:"bytes,6,opt,name=shipping_phone,json=shippingPhone,proto3" json:"shipping_phone,omitempty"`
 	ShippingAddress string                 `protobuf:"bytes,7,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	Items           []*CreateOrderItem     `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_order_order_proto_msgTypes[1]
+	mi := &file_order_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +499,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[1]
+	mi := &file_order_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +512,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{1}
+	return file_order_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateOrderRequest) GetUserId() string {
@@ -470,6 +564,81 @@ func (x *CreateOrderRequest) GetShippingAddress() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetItems() []*CreateOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateOrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	VariantId     string                 `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderItem) Reset() {
+	*x = CreateOrderItem{}
+	mi := &file_order_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderItem) ProtoMessage() {}
+
+func (x *CreateOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderItem.ProtoReflect.Descriptor instead.
+func (*CreateOrderItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateOrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateOrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateOrderItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CreateOrderItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
 type PaymentInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Method        PaymentMethod          `protobuf:"varint,1,opt,name=method,proto3,enum=order.PaymentMethod" json:"method,omitempty"`
@@ -479,7 +648,7 @@ type PaymentInfo struct {
 
 func (x *PaymentInfo) Reset() {
 	*x = PaymentInfo{}
-	mi := &file_order_order_proto_msgTypes[2]
+	mi := &file_order_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +660,7 @@ func (x *PaymentInfo) String() string {
 func (*PaymentInfo) ProtoMessage() {}
 
 func (x *PaymentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[2]
+	mi := &file_order_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +673,7 @@ func (x *PaymentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentInfo.ProtoReflect.Descriptor instead.
 func (*PaymentInfo) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{2}
+	return file_order_order_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PaymentInfo) GetMethod() PaymentMethod {
@@ -523,7 +692,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_order_order_proto_msgTypes[3]
+	mi := &file_order_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +704,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[3]
+	mi := &file_order_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +717,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{3}
+	return file_order_order_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetOrderRequest) GetId() string {
@@ -568,7 +737,7 @@ type UpdateOrderStatusRequest struct {
 
 func (x *UpdateOrderStatusRequest) Reset() {
 	*x = UpdateOrderStatusRequest{}
-	mi := &file_order_order_proto_msgTypes[4]
+	mi := &file_order_order_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -580,7 +749,7 @@ func (x *UpdateOrderStatusRequest) String() string {
 func (*UpdateOrderStatusRequest) ProtoMessage() {}
 
 func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[4]
+	mi := &file_order_order_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +762,7 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{4}
+	return file_order_order_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateOrderStatusRequest) GetId() string {
@@ -611,17 +780,20 @@ func (x *UpdateOrderStatusRequest) GetStatus() OrderStatus {
 }
 
 type ListUserOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page   int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit  int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Set, even to an empty string for the first page, to page with cursors instead
+	// of page numbers. Cursor pages have no total.
+	Cursor        *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserOrdersRequest) Reset() {
 	*x = ListUserOrdersRequest{}
-	mi := &file_order_order_proto_msgTypes[5]
+	mi := &file_order_order_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -633,7 +805,7 @@ func (x *ListUserOrdersRequest) String() string {
 func (*ListUserOrdersRequest) ProtoMessage() {}
 
 func (x *ListUserOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[5]
+	mi := &file_order_order_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +818,7 @@ func (x *ListUserOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserOrdersRequest.ProtoReflect.Descriptor instead.
 func (*ListUserOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{5}
+	return file_order_order_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListUserOrdersRequest) GetUserId() string {
@@ -670,17 +842,26 @@ func (x *ListUserOrdersRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListUserOrdersRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 type ListOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total  int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Cursor of the next page when listing with a cursor, empty on the last page
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListOrdersResponse) Reset() {
 	*x = ListOrdersResponse{}
-	mi := &file_order_order_proto_msgTypes[6]
+	mi := &file_order_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -692,7 +873,7 @@ func (x *ListOrdersResponse) String() string {
 func (*ListOrdersResponse) ProtoMessage() {}
 
 func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[6]
+	mi := &file_order_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +886,7 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
 func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{6}
+	return file_order_order_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListOrdersResponse) GetOrders() []*Order {
@@ -722,6 +903,13 @@ func (x *ListOrdersResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *ListOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type OrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
@@ -731,7 +919,7 @@ type OrderResponse struct {
 
 func (x *OrderResponse) Reset() {
 	*x = OrderResponse{}
-	mi := &file_order_order_proto_msgTypes[7]
+	mi := &file_order_order_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -743,7 +931,7 @@ func (x *OrderResponse) String() string {
 func (*OrderResponse) ProtoMessage() {}
 
 func (x *OrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[7]
+	mi := &file_order_order_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +944,7 @@ func (x *OrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
 func (*OrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{7}
+	return file_order_order_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OrderResponse) GetOrder() *Order {
@@ -784,7 +972,7 @@ type Payment struct {
 
 func (x *Payment) Reset() {
 	*x = Payment{}
-	mi := &file_order_order_proto_msgTypes[8]
+	mi := &file_order_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -796,7 +984,7 @@ func (x *Payment) String() string {
 func (*Payment) ProtoMessage() {}
 
 func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[8]
+	mi := &file_order_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +997,7 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Payment.ProtoReflect.Descriptor instead.
 func (*Payment) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{8}
+	return file_order_order_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Payment) GetId() string {
@@ -885,7 +1073,7 @@ type ProcessPaymentRequest struct {
 
 func (x *ProcessPaymentRequest) Reset() {
 	*x = ProcessPaymentRequest{}
-	mi := &file_order_order_proto_msgTypes[9]
+	mi := &file_order_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -897,7 +1085,7 @@ func (x *ProcessPaymentRequest) String() string {
 func (*ProcessPaymentRequest) ProtoMessage() {}
 
 func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[9]
+	mi := &file_order_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +1098,7 @@ func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessPaymentRequest.ProtoReflect.Descriptor instead.
 func (*ProcessPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{9}
+	return file_order_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ProcessPaymentRequest) GetOrderId() string {
@@ -936,7 +1124,7 @@ type GetPaymentRequest struct {
 
 func (x *GetPaymentRequest) Reset() {
 	*x = GetPaymentRequest{}
-	mi := &file_order_order_proto_msgTypes[10]
+	mi := &file_order_order_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -948,7 +1136,7 @@ func (x *GetPaymentRequest) String() string {
 func (*GetPaymentRequest) ProtoMessage() {}
 
 func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[10]
+	mi := &file_order_order_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,7 +1149,7 @@ func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentRequest.ProtoReflect.Descriptor instead.
 func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{10}
+	return file_order_order_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPaymentRequest) GetId() string {
@@ -982,7 +1170,7 @@ type UpdatePaymentStatusRequest struct {
 
 func (x *UpdatePaymentStatusRequest) Reset() {
 	*x = UpdatePaymentStatusRequest{}
-	mi := &file_order_order_proto_msgTypes[11]
+	mi := &file_order_order_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -994,7 +1182,7 @@ func (x *UpdatePaymentStatusRequest) String() string {
 func (*UpdatePaymentStatusRequest) ProtoMessage() {}
 
 func (x *UpdatePaymentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[11]
+	mi := &file_order_order_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,7 +1195,7 @@ func (x *UpdatePaymentStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePaymentStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePaymentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{11}
+	return file_order_order_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdatePaymentStatusRequest) GetId() string {
@@ -1040,7 +1228,7 @@ type PaymentResponse struct {
 
 func (x *PaymentResponse) Reset() {
 	*x = PaymentResponse{}
-	mi := &file_order_order_proto_msgTypes[12]
+	mi := &file_order_order_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1052,7 +1240,7 @@ func (x *PaymentResponse) String() string {
 func (*PaymentResponse) ProtoMessage() {}
 
 func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[12]
+	mi := &file_order_order_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,7 +1253,7 @@ func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentResponse.ProtoReflect.Descriptor instead.
 func (*PaymentResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{12}
+	return file_order_order_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PaymentResponse) GetPayment() *Payment {
@@ -1089,7 +1277,7 @@ type Review struct {
 
 func (x *Review) Reset() {
 	*x = Review{}
-	mi := &file_order_order_proto_msgTypes[13]
+	mi := &file_order_order_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1101,7 +1289,7 @@ func (x *Review) String() string {
 func (*Review) ProtoMessage() {}
 
 func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[13]
+	mi := &file_order_order_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +1302,7 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Review.ProtoReflect.Descriptor instead.
 func (*Review) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{13}
+	return file_order_order_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Review) GetId() string {
@@ -1171,7 +1359,7 @@ type CreateReviewRequest struct {
 
 func (x *CreateReviewRequest) Reset() {
 	*x = CreateReviewRequest{}
-	mi := &file_order_order_proto_msgTypes[14]
+	mi := &file_order_order_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1183,7 +1371,7 @@ func (x *CreateReviewRequest) String() string {
 func (*CreateReviewRequest) ProtoMessage() {}
 
 func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[14]
+	mi := &file_order_order_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,7 +1384,7 @@ func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
 func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{14}
+	return file_order_order_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateReviewRequest) GetOrderId() string {
@@ -1236,7 +1424,7 @@ type GetReviewRequest struct {
 
 func (x *GetReviewRequest) Reset() {
 	*x = GetReviewRequest{}
-	mi := &file_order_order_proto_msgTypes[15]
+	mi := &file_order_order_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1248,7 +1436,7 @@ func (x *GetReviewRequest) String() string {
 func (*GetReviewRequest) ProtoMessage() {}
 
 func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[15]
+	mi := &file_order_order_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,7 +1449,7 @@ func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
 func (*GetReviewRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{15}
+	return file_order_order_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetReviewRequest) GetId() string {
@@ -1280,7 +1468,7 @@ type GetOrderReviewsRequest struct {
 
 func (x *GetOrderReviewsRequest) Reset() {
 	*x = GetOrderReviewsRequest{}
-	mi := &file_order_order_proto_msgTypes[16]
+	mi := &file_order_order_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1292,7 +1480,7 @@ func (x *GetOrderReviewsRequest) String() string {
 func (*GetOrderReviewsRequest) ProtoMessage() {}
 
 func (x *GetOrderReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[16]
+	mi := &file_order_order_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,7 +1493,7 @@ func (x *GetOrderReviewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderReviewsRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{16}
+	return file_order_order_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetOrderReviewsRequest) GetOrderId() string {
@@ -1324,7 +1512,7 @@ type GetOrderReviewsResponse struct {
 
 func (x *GetOrderReviewsResponse) Reset() {
 	*x = GetOrderReviewsResponse{}
-	mi := &file_order_order_proto_msgTypes[17]
+	mi := &file_order_order_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1336,7 +1524,7 @@ func (x *GetOrderReviewsResponse) String() string {
 func (*GetOrderReviewsResponse) ProtoMessage() {}
 
 func (x *GetOrderReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[17]
+	mi := &file_order_order_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1349,7 +1537,7 @@ func (x *GetOrderReviewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderReviewsResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{17}
+	return file_order_order_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetOrderReviewsResponse) GetReviews() []*Review {
@@ -1368,7 +1556,7 @@ type DeleteReviewRequest struct {
 
 func (x *DeleteReviewRequest) Reset() {
 	*x = DeleteReviewRequest{}
-	mi := &file_order_order_proto_msgTypes[18]
+	mi := &file_order_order_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1380,7 +1568,7 @@ func (x *DeleteReviewRequest) String() string {
 func (*DeleteReviewRequest) ProtoMessage() {}
 
 func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[18]
+	mi := &file_order_order_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1393,7 +1581,7 @@ func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{18}
+	return file_order_order_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteReviewRequest) GetId() string {
@@ -1412,7 +1600,7 @@ type ReviewResponse struct {
 
 func (x *ReviewResponse) Reset() {
 	*x = ReviewResponse{}
-	mi := &file_order_order_proto_msgTypes[19]
+	mi := &file_order_order_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1424,7 +1612,7 @@ func (x *ReviewResponse) String() string {
 func (*ReviewResponse) ProtoMessage() {}
 
 func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[19]
+	mi := &file_order_order_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,7 +1625,7 @@ func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewResponse.ProtoReflect.Descriptor instead.
 func (*ReviewResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{19}
+	return file_order_order_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ReviewResponse) GetReview() *Review {
@@ -1451,7 +1639,7 @@ var File_order_order_proto protoreflect.FileDescriptor
 
 const file_order_order_proto_rawDesc = "" +
 	"\n" +
-	"\x11order/order.proto\x12\x05order\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbd\x03\n" +
+	"\x11order/order.proto\x12\x05order\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x03\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12*\n" +
@@ -1466,7 +1654,18 @@ const file_order_order_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9c\x02\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12&\n" +
+	"\x05items\x18\f \x03(\v2\x10.order.OrderItemR\x05items\"\xaf\x01\n" +
+	"\tOrderItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x01R\tunitPrice\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x06 \x01(\tR\tvariantId\"\xca\x02\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\ftotal_amount\x18\x02 \x01(\x01R\vtotalAmount\x12,\n" +
@@ -1474,21 +1673,34 @@ const file_order_order_proto_rawDesc = "" +
 	"\rshipping_name\x18\x04 \x01(\tR\fshippingName\x12%\n" +
 	"\x0eshipping_email\x18\x05 \x01(\tR\rshippingEmail\x12%\n" +
 	"\x0eshipping_phone\x18\x06 \x01(\tR\rshippingPhone\x12)\n" +
-	"\x10shipping_address\x18\a \x01(\tR\x0fshippingAddress\";\n" +
+	"\x10shipping_address\x18\a \x01(\tR\x0fshippingAddress\x12,\n" +
+	"\x05items\x18\b \x03(\v2\x16.order.CreateOrderItemR\x05items\"\x8a\x01\n" +
+	"\x0fCreateOrderItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\x01R\tunitPrice\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\tR\tvariantId\";\n" +
 	"\vPaymentInfo\x12,\n" +
 	"\x06method\x18\x01 \x01(\x0e2\x14.order.PaymentMethodR\x06method\"!\n" +
 	"\x0fGetOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
 	"\x18UpdateOrderStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\x06status\"Z\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\x06status\"\x82\x01\n" +
 	"\x15ListUserOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"P\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"q\n" +
 	"\x12ListOrdersResponse\x12$\n" +
 	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"3\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"3\n" +
 	"\rOrderResponse\x12\"\n" +
 	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"\x84\x03\n" +
 	"\aPayment\x12\x0e\n" +
@@ -1590,85 +1802,89 @@ func file_order_order_proto_rawDescGZIP() []byte {
 }
 
 var file_order_order_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_order_order_proto_goTypes = []any{
 	(OrderStatus)(0),                   // 0: order.OrderStatus
 	(PaymentStatus)(0),                 // 1: order.PaymentStatus
 	(PaymentMethod)(0),                 // 2: order.PaymentMethod
 	(Rating)(0),                        // 3: order.Rating
 	(*Order)(nil),                      // 4: order.Order
-	(*CreateOrderRequest)(nil),         // 5: order.CreateOrderRequest
-	(*PaymentInfo)(nil),                // 6: order.PaymentInfo
-	(*GetOrderRequest)(nil),            // 7: order.GetOrderRequest
-	(*UpdateOrderStatusRequest)(nil),   // 8: order.UpdateOrderStatusRequest
-	(*ListUserOrdersRequest)(nil),      // 9: order.ListUserOrdersRequest
-	(*ListOrdersResponse)(nil),         // 10: order.ListOrdersResponse
-	(*OrderResponse)(nil),              // 11: order.OrderResponse
-	(*Payment)(nil),                    // 12: order.Payment
-	(*ProcessPaymentRequest)(nil),      // 13: order.ProcessPaymentRequest
-	(*GetPaymentRequest)(nil),          // 14: order.GetPaymentRequest
-	(*UpdatePaymentStatusRequest)(nil), // 15: order.UpdatePaymentStatusRequest
-	(*PaymentResponse)(nil),            // 16: order.PaymentResponse
-	(*Review)(nil),                     // 17: order.Review
-	(*CreateReviewRequest)(nil),        // 18: order.CreateReviewRequest
-	(*GetReviewRequest)(nil),           // 19: order.GetReviewRequest
-	(*GetOrderReviewsRequest)(nil),     // 20: order.GetOrderReviewsRequest
-	(*GetOrderReviewsResponse)(nil),    // 21: order.GetOrderReviewsResponse
-	(*DeleteReviewRequest)(nil),        // 22: order.DeleteReviewRequest
-	(*ReviewResponse)(nil),             // 23: order.ReviewResponse
-	(*timestamppb.Timestamp)(nil),      // 24: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 25: google.protobuf.Empty
+	(*OrderItem)(nil),                  // 5: order.OrderItem
+	(*CreateOrderRequest)(nil),         // 6: order.CreateOrderRequest
+	(*CreateOrderItem)(nil),            // 7: order.CreateOrderItem
+	(*PaymentInfo)(nil),                // 8: order.PaymentInfo
+	(*GetOrderRequest)(nil),            // 9: order.GetOrderRequest
+	(*UpdateOrderStatusRequest)(nil),   // 10: order.UpdateOrderStatusRequest
+	(*ListUserOrdersRequest)(nil),      // 11: order.ListUserOrdersRequest
+	(*ListOrdersResponse)(nil),         // 12: order.ListOrdersResponse
+	(*OrderResponse)(nil),              // 13: order.OrderResponse
+	(*Payment)(nil),                    // 14: order.Payment
+	(*ProcessPaymentRequest)(nil),      // 15: order.ProcessPaymentRequest
+	(*GetPaymentRequest)(nil),          // 16: order.GetPaymentRequest
+	(*UpdatePaymentStatusRequest)(nil), // 17: order.UpdatePaymentStatusRequest
+	(*PaymentResponse)(nil),            // 18: order.PaymentResponse
+	(*Review)(nil),                     // 19: order.Review
+	(*CreateReviewRequest)(nil),        // 20: order.CreateReviewRequest
+	(*GetReviewRequest)(nil),           // 21: order.GetReviewRequest
+	(*GetOrderReviewsRequest)(nil),     // 22: order.GetOrderReviewsRequest
+	(*GetOrderReviewsResponse)(nil),    // 23: order.GetOrderReviewsResponse
+	(*DeleteReviewRequest)(nil),        // 24: order.DeleteReviewRequest
+	(*ReviewResponse)(nil),             // 25: order.ReviewResponse
+	(*timestamppb.Timestamp)(nil),      // 26: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 27: google.protobuf.Empty
 }
 var file_order_order_proto_depIdxs = []int32{
 	0,  // 0: order.Order.status:type_name -> order.OrderStatus
-	12, // 1: order.Order.payment:type_name -> order.Payment
-	24, // 2: order.Order.created_at:type_name -> google.protobuf.Timestamp
-	24, // 3: order.Order.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 4: order.CreateOrderRequest.payment:type_name -> order.PaymentInfo
-	2,  // 5: order.PaymentInfo.method:type_name -> order.PaymentMethod
-	0,  // 6: order.UpdateOrderStatusRequest.status:type_name -> order.OrderStatus
-	4,  // 7: order.ListOrdersResponse.orders:type_name -> order.Order
-	4,  // 8: order.OrderResponse.order:type_name -> order.Order
-	2,  // 9: order.Payment.method:type_name -> order.PaymentMethod
-	1,  // 10: order.Payment.status:type_name -> order.PaymentStatus
-	24, // 11: order.Payment.payment_date:type_name -> google.protobuf.Timestamp
-	24, // 12: order.Payment.created_at:type_name -> google.protobuf.Timestamp
-	24, // 13: order.Payment.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 14: order.ProcessPaymentRequest.method:type_name -> order.PaymentMethod
-	1,  // 15: order.UpdatePaymentStatusRequest.status:type_name -> order.PaymentStatus
-	12, // 16: order.PaymentResponse.payment:type_name -> order.Payment
-	3,  // 17: order.Review.rating:type_name -> order.Rating
-	24, // 18: order.Review.create_at:type_name -> google.protobuf.Timestamp
-	3,  // 19: order.CreateReviewRequest.rating:type_name -> order.Rating
-	17, // 20: order.GetOrderReviewsResponse.reviews:type_name -> order.Review
-	17, // 21: order.ReviewResponse.review:type_name -> order.Review
-	5,  // 22: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	7,  // 23: order.OrderService.GetOrderByID:input_type -> order.GetOrderRequest
-	8,  // 24: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	9,  // 25: order.OrderService.ListUserOrders:input_type -> order.ListUserOrdersRequest
-	13, // 26: order.OrderService.ProcessPayment:input_type -> order.ProcessPaymentRequest
-	14, // 27: order.OrderService.GetPaymentByID:input_type -> order.GetPaymentRequest
-	15, // 28: order.OrderService.UpdatePaymentStatus:input_type -> order.UpdatePaymentStatusRequest
-	18, // 29: order.OrderService.CreateReview:input_type -> order.CreateReviewRequest
-	19, // 30: order.OrderService.GetReview:input_type -> order.GetReviewRequest
-	20, // 31: order.OrderService.GetOrderReviews:input_type -> order.GetOrderReviewsRequest
-	22, // 32: order.OrderService.DeleteReview:input_type -> order.DeleteReviewRequest
-	11, // 33: order.OrderService.CreateOrder:output_type -> order.OrderResponse
-	11, // 34: order.OrderService.GetOrderByID:output_type -> order.OrderResponse
-	11, // 35: order.OrderService.UpdateOrderStatus:output_type -> order.OrderResponse
-	10, // 36: order.OrderService.ListUserOrders:output_type -> order.ListOrdersResponse
-	16, // 37: order.OrderService.ProcessPayment:output_type -> order.PaymentResponse
-	16, // 38: order.OrderService.GetPaymentByID:output_type -> order.PaymentResponse
-	16, // 39: order.OrderService.UpdatePaymentStatus:output_type -> order.PaymentResponse
-	23, // 40: order.OrderService.CreateReview:output_type -> order.ReviewResponse
-	23, // 41: order.OrderService.GetReview:output_type -> order.ReviewResponse
-	21, // 42: order.OrderService.GetOrderReviews:output_type -> order.GetOrderReviewsResponse
-	25, // 43: order.OrderService.DeleteReview:output_type -> google.protobuf.Empty
-	33, // [33:44] is the sub-list for method output_type
-	22, // [22:33] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	14, // 1: order.Order.payment:type_name -> order.Payment
+	26, // 2: order.Order.created_at:type_name -> google.protobuf.Timestamp
+	26, // 3: order.Order.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 4: order.Order.items:type_name -> order.OrderItem
+	8,  // 5: order.CreateOrderRequest.payment:type_name -> order.PaymentInfo
+	7,  // 6: order.CreateOrderRequest.items:type_name -> order.CreateOrderItem
+	2,  // 7: order.PaymentInfo.method:type_name -> order.PaymentMethod
+	0,  // 8: order.UpdateOrderStatusRequest.status:type_name -> order.OrderStatus
+	4,  // 9: order.ListOrdersResponse.orders:type_name -> order.Order
+	4,  // 10: order.OrderResponse.order:type_name -> order.Order
+	2,  // 11: order.Payment.method:type_name -> order.PaymentMethod
+	1,  // 12: order.Payment.status:type_name -> order.PaymentStatus
+	26, // 13: order.Payment.payment_date:type_name -> google.protobuf.Timestamp
+	26, // 14: order.Payment.created_at:type_name -> google.protobuf.Timestamp
+	26, // 15: order.Payment.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 16: order.ProcessPaymentRequest.method:type_name -> order.PaymentMethod
+	1,  // 17: order.UpdatePaymentStatusRequest.status:type_name -> order.PaymentStatus
+	14, // 18: order.PaymentResponse.payment:type_name -> order.Payment
+	3,  // 19: order.Review.rating:type_name -> order.Rating
+	26, // 20: order.Review.create_at:type_name -> google.protobuf.Timestamp
+	3,  // 21: order.CreateReviewRequest.rating:type_name -> order.Rating
+	19, // 22: order.GetOrderReviewsResponse.reviews:type_name -> order.Review
+	19, // 23: order.ReviewResponse.review:type_name -> order.Review
+	6,  // 24: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	9,  // 25: order.OrderService.GetOrderByID:input_type -> order.GetOrderRequest
+	10, // 26: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	11, // 27: order.OrderService.ListUserOrders:input_type -> order.ListUserOrdersRequest
+	15, // 28: order.OrderService.ProcessPayment:input_type -> order.ProcessPaymentRequest
+	16, // 29: order.OrderService.GetPaymentByID:input_type -> order.GetPaymentRequest
+	17, // 30: order.OrderService.UpdatePaymentStatus:input_type -> order.UpdatePaymentStatusRequest
+	20, // 31: order.OrderService.CreateReview:input_type -> order.CreateReviewRequest
+	21, // 32: order.OrderService.GetReview:input_type -> order.GetReviewRequest
+	22, // 33: order.OrderService.GetOrderReviews:input_type -> order.GetOrderReviewsRequest
+	24, // 34: order.OrderService.DeleteReview:input_type -> order.DeleteReviewRequest
+	13, // 35: order.OrderService.CreateOrder:output_type -> order.OrderResponse
+	13, // 36: order.OrderService.GetOrderByID:output_type -> order.OrderResponse
+	13, // 37: order.OrderService.UpdateOrderStatus:output_type -> order.OrderResponse
+	12, // 38: order.OrderService.ListUserOrders:output_type -> order.ListOrdersResponse
+	18, // 39: order.OrderService.ProcessPayment:output_type -> order.PaymentResponse
+	18, // 40: order.OrderService.GetPaymentByID:output_type -> order.PaymentResponse
+	18, // 41: order.OrderService.UpdatePaymentStatus:output_type -> order.PaymentResponse
+	25, // 42: order.OrderService.CreateReview:output_type -> order.ReviewResponse
+	25, // 43: order.OrderService.GetReview:output_type -> order.ReviewResponse
+	23, // 44: order.OrderService.GetOrderReviews:output_type -> order.GetOrderReviewsResponse
+	27, // 45: order.OrderService.DeleteReview:output_type -> google.protobuf.Empty
+	35, // [35:46] is the sub-list for method output_type
+	24, // [24:35] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_order_order_proto_init() }
@@ -1676,13 +1892,14 @@ func file_order_order_proto_init() {
 	if File_order_order_proto != nil {
 		return
 	}
+	file_order_order_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_order_proto_rawDesc), len(file_order_order_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   20,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3,19 +3,18 @@ package grpc
 import (
 	"context"
 	"fmt"
-	"log"
 	"net"
 
 	"github.com/baccala1010/e-commerce/statistics/internal/config"
 	"github.com/baccala1010/e-commerce/statistics/internal/handler"
+	"github.com/baccala1010/e-commerce/statistics/pkg/grpcserver"
 	"github.com/baccala1010/e-commerce/statistics/pkg/pb" // добавлен импорт pb
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"github.com/sirupsen/logrus"
 )
 
 // Server represents the gRPC server for the statistics service
 type Server struct {
-	server   *grpc.Server
+	server   *grpcserver.Server
 	cfg      *config.Config
 	listener net.Listener
 }
@@ -28,12 +27,11 @@ func NewServer(cfg *config.Config, statisticsHandler *handler.StatisticsHandler)
 		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
 	}
 
-	server := grpc.NewServer()
+	server := grpcserver.New(grpcserver.Options{
+		DefaultTimeout: cfg.Server.GetGRPCTimeout(),
+	})
 	pb.RegisterStatisticsServiceServer(server, statisticsHandler)
 
-	// Enable reflection for tools like grpcurl
-	reflection.Register(server)
-
 	return &Server{
 		server:   server,
 		cfg:      cfg,
@@ -43,7 +41,7 @@ func NewServer(cfg *config.Config, statisticsHandler *handler.StatisticsHandler)
 
 // Start starts the gRPC server
 func (s *Server) Start(ctx context.Context) error {
-	log.Printf("gRPC server started on %s", s.listener.Addr().String())
+	logrus.Infof("gRPC server started on %s", s.listener.Addr().String())
 
 	errCh := make(chan error)
 	go func() {
@@ -54,7 +52,7 @@ func (s *Server) Start(ctx context.Context) error {
 
 	select {
 	case <-ctx.Done():
-		log.Println("Shutting down gRPC server...")
+		logrus.Info("Shutting down gRPC server...")
 		s.server.GracefulStop()
 		return nil
 	case err := <-errCh:
@@ -62,6 +60,11 @@ func (s *Server) Start(ctx context.Context) error {
 	}
 }
 
+// Shutdown reports the service as not serving to gRPC health checks
+func (s *Server) Shutdown() {
+	s.server.Shutdown()
+}
+
 // Stop stops the gRPC server
 func (s *Server) Stop() {
 	if s.server != nil {
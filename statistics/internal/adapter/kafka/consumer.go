@@ -3,7 +3,6 @@ package kafka
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	eventspb "github.com/baccala1010/e-commerce/order/pkg/pb/event
@@ -13,6 +12,8 @@ import (
 	kafkawrapper "github.com/baccala1010/e-commerce/statistics/pkg/kafka"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/codes"
 )
 
 // EventProcessor handles consuming events from Kafka and processing them
@@ -21,6 +22,7 @@ type EventProcessor struct {
 	userRepo          repository.UserRepository
 	orderRepo         repository.OrderRepository
 	productRepo       repository.ProductRepository
+	groupID           string
 	orderEventTopic   string
 	productEventTopic string
 	userEventTopic    string
@@ -52,6 +54,7 @@ func NewEventProcessor(
 		userRepo:          userRepo,
 		orderRepo:         orderRepo,
 		productRepo:       productRepo,
+		groupID:           cfg.Kafka.ConsumerGroupID,
 		orderEventTopic:   cfg.Kafka.Topics.OrderEvents,
 		productEventTopic: cfg.Kafka.Topics.ProductEvents,
 		userEventTopic:    cfg.Kafka.Topics.UserEvents,
@@ -65,27 +68,36 @@ func (p *EventProcessor) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to start Kafka consumer: %w", err)
 	}
 
-	log.Println("Kafka event processor started")
+	logrus.Info("Kafka event processor started")
 
 	// Process messages from the consumer
 	go func() {
 		for msg := range p.consumer.Messages() {
-			log.Printf("Received message from topic %s: %s", *msg.TopicPartition.Topic, string(msg.Value))
+			// Continue the trace and request the event was published in
+			msgCtx, span := kafkawrapper.StartProcessSpan(ctx, p.groupID, msg)
+			logrus.WithContext(msgCtx).Debugf("Received message from topic %s: %s", *msg.TopicPartition.Topic, string(msg.Value))
 
+			var err error
 			switch *msg.TopicPartition.Topic {
 			case p.orderEventTopic:
-				if err := p.processOrderEvent(ctx, msg.Value); err != nil {
-					log.Printf("Failed to process order event: %v", err)
+				if err = p.processOrderEvent(msgCtx, msg.Value); err != nil {
+					logrus.WithContext(msgCtx).Errorf("Failed to process order event: %v", err)
 				}
 			case p.productEventTopic:
-				if err := p.processProductEvent(ctx, msg.Value); err != nil {
-					log.Printf("Failed to process product event: %v", err)
+				if err = p.processProductEvent(msgCtx, msg.Value); err != nil {
+					logrus.WithContext(msgCtx).Errorf("Failed to process product event: %v", err)
 				}
 			case p.userEventTopic:
-				if err := p.processUserEvent(ctx, msg.Value); err != nil {
-					log.Printf("Failed to process user event: %v", err)
+				if err = p.processUserEvent(msgCtx, msg.Value); err != nil {
+					logrus.WithContext(msgCtx).Errorf("Failed to process user event: %v", err)
 				}
 			}
+
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
 		}
 	}()
 
@@ -117,7 +129,7 @@ func (p *EventProcessor) processOrderEvent(ctx context.Context, data []byte) err
 	// Store or update the order based on event type
 	switch orderEvent.EventType {
 	case eventspb.EventType_EVENT_TYPE_CREATED:
-		log.Printf("Processing order create event: %s", order.ID)
+		logrus.WithContext(ctx).Infof("Processing order create event: %s", order.ID)
 		if err := p.orderRepo.Create(ctx, order); err != nil {
 			return fmt.Errorf("failed to create order: %w", err)
 		}
@@ -130,11 +142,11 @@ func (p *EventProcessor) processOrderEvent(ctx context.Context, data []byte) err
 			UpdatedAt:        time.Now(),
 		}
 		if err := p.userRepo.Create(ctx, user); err != nil {
-			log.Printf("Warning: failed to ensure user exists: %v", err)
+			logrus.WithContext(ctx).Warnf("Failed to ensure user exists: %v", err)
 		}
 
 	case eventspb.EventType_EVENT_TYPE_UPDATED:
-		log.Printf("Processing order update event: %s", order.ID)
+		logrus.WithContext(ctx).Infof("Processing order update event: %s", order.ID)
 		if err := p.orderRepo.Update(ctx, order); err != nil {
 			return fmt.Errorf("failed to update order: %w", err)
 		}
@@ -168,12 +180,12 @@ func (p *EventProcessor) processProductEvent(ctx context.Context, data []byte) e
 	// Store or update the product based on event type
 	switch productEvent.EventType {
 	case eventspb.EventType_EVENT_TYPE_CREATED:
-		log.Printf("Processing product create event: %s", product.ID)
+		logrus.WithContext(ctx).Infof("Processing product create event: %s", product.ID)
 		if err := p.productRepo.Create(ctx, product); err != nil {
 			return fmt.Errorf("failed to create product: %w", err)
 		}
 	case eventspb.EventType_EVENT_TYPE_UPDATED:
-		log.Printf("Processing product update event: %s", product.ID)
+		logrus.WithContext(ctx).Infof("Processing product update event: %s", product.ID)
 		if err := p.productRepo.Update(ctx, product); err != nil {
 			return fmt.Errorf("failed to update product: %w", err)
 		}
@@ -202,12 +214,12 @@ func (p *EventProcessor) processUserEvent(ctx context.Context, data []byte) erro
 	// Store or update the user based on event type
 	switch userEvent.EventType {
 	case eventspb.EventType_EVENT_TYPE_CREATED:
-		log.Printf("Processing user create event: %s", user.ID)
+		logrus.WithContext(ctx).Infof("Processing user create event: %s", user.ID)
 		if err := p.userRepo.Create(ctx, user); err != nil {
 			return fmt.Errorf("failed to create user: %w", err)
 		}
 	case eventspb.EventType_EVENT_TYPE_UPDATED:
-		log.Printf("Processing user update event: %s", user.ID)
+		logrus.WithContext(ctx).Infof("Processing user update event: %s", user.ID)
 		if err := p.userRepo.Update(ctx, user); err != nil {
 			return fmt.Errorf("failed to update user: %w", err)
 		}
@@ -216,6 +228,11 @@ func (p *EventProcessor) processUserEvent(ctx context.Context, data []byte) erro
 	return nil
 }
 
+// Ping checks that the Kafka brokers the events are consumed from answer
+func (p *EventProcessor) Ping(ctx context.Context) error {
+	return p.consumer.Ping(ctx)
+}
+
 // Stop stops the Kafka consumer
 func (p *EventProcessor) Stop() {
 	if p.consumer != nil {
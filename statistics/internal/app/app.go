@@ -2,10 +2,13 @@ package app
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/baccala1010/e-commerce/statistics/internal/adapter/grpc"
 	"github.com/baccala1010/e-commerce/statistics/internal/adapter/kafka"
@@ -14,13 +17,20 @@ import (
 	"github.com/baccala1010/e-commerce/statistics/internal/handler"
 	"github.com/baccala1010/e-commerce/statistics/internal/repository"
 	"github.com/baccala1010/e-commerce/statistics/internal/usecase"
+	"github.com/baccala1010/e-commerce/statistics/pkg/health"
+	"github.com/baccala1010/e-commerce/statistics/pkg/metrics"
+	"github.com/baccala1010/e-commerce/statistics/pkg/tracing"
+	"github.com/sirupsen/logrus"
 )
 
 // App represents the statistics application
 type App struct {
-	cfg           *config.Config
-	grpcServer    *grpc.Server
+	cfg            *config.Config
+	grpcServer     *grpc.Server
 	eventProcessor *kafka.EventProcessor
+	health         *health.Checker
+
+	shutdownTracing func(context.Context) error
 }
 
 // New creates a new statistics application
@@ -31,6 +41,21 @@ func New(configPath string) (*App, error) {
 		return nil, err
 	}
 
+	// Set up logging
+	configureLogging(cfg.Tracing.GetServiceName(), cfg.Logging.Level)
+
+	// Set up tracing
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Options{
+		ServiceName: cfg.Tracing.GetServiceName(),
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.GetEndpoint(),
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.GetSampleRatio(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Setup application context with cancellation
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -64,31 +89,86 @@ func New(configPath string) (*App, error) {
 		return nil, err
 	}
 
+	// Set up health checks
+	healthChecker := health.NewChecker(cfg.Tracing.GetServiceName(), cfg.Health.GetCheckTimeout())
+	healthChecker.Register("database", db.Ping)
+	healthChecker.RegisterOptional("kafka", eventProcessor.Ping)
+
 	return &App{
-		cfg:           cfg,
-		grpcServer:    grpcServer,
+		cfg:            cfg,
+		grpcServer:     grpcServer,
 		eventProcessor: eventProcessor,
+		health:         healthChecker,
+
+		shutdownTracing: shutdownTracing,
 	}, nil
 }
 
 // Run starts the statistics application
 func (a *App) Run() error {
-	log.Println("Starting Statistics Service")
+	logrus.Info("Starting Statistics Service")
 
 	// Setup graceful shutdown
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	// Flush the remaining spans on the way out
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := a.shutdownTracing(shutdownCtx); err != nil {
+			logrus.Errorf("Tracing shutdown error: %v", err)
+		}
+	}()
+
 	// Start Kafka consumer
 	if err := a.eventProcessor.Start(ctx); err != nil {
 		return err
 	}
 	defer a.eventProcessor.Stop()
 
+	// Serve Prometheus metrics and the health probes
+	metricsServer := a.startMetricsServer()
+	defer metricsServer.Close()
+
+	// Report not ready once the shutdown begins and give load balancers time
+	// to stop sending requests before the gRPC server stops
+	serveCtx, stopServing := context.WithCancel(context.Background())
+	defer stopServing()
+	go func() {
+		<-ctx.Done()
+		a.health.Shutdown()
+		a.grpcServer.Shutdown()
+		time.Sleep(a.cfg.Health.GetShutdownDelay())
+		stopServing()
+	}()
+
 	// Start gRPC server (blocking)
-	if err := a.grpcServer.Start(ctx); err != nil {
+	if err := a.grpcServer.Start(serveCtx); err != nil {
 		return err
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// startMetricsServer serves the Prometheus metrics and the health probes on the metrics port
+func (a *App) startMetricsServer() *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/health/live", a.health.LiveHandler())
+	mux.Handle("/health/ready", a.health.ReadyHandler())
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf("%s:%s", a.cfg.Server.Host, a.cfg.Metrics.GetPort()),
+		Handler: mux,
+	}
+
+	go func() {
+		logrus.Infof("Metrics server started on %s", server.Addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.Errorf("Failed to serve metrics: %v", err)
+		}
+	}()
+
+	return server
+}
@@ -1,13 +1,19 @@
 package app
 
 import (
-	"log"
-	"os"
+	"github.com/baccala1010/e-commerce/statistics/pkg/logging"
+	"github.com/sirupsen/logrus"
 )
 
-// InitializeLogging sets up logging for the application
+// InitializeLogging sets up logging for the application until the
+// configuration is loaded
 func InitializeLogging() {
-	log.SetOutput(os.Stdout)
-	log.SetFlags(log.LstdFlags | log.Lshortfile | log.Lmicroseconds)
-	log.Println("Logging initialized")
-}
\ No newline at end of file
+	logging.Setup("statistics-service", "info")
+	logrus.Info("Logging initialized")
+}
+
+// configureLogging applies the logging configuration
+func configureLogging(serviceName, level string) {
+	logging.Setup(serviceName, level)
+	logrus.Infof("Log level set to %s", level)
+}
@@ -11,6 +11,9 @@ type Config struct {
 	Server  ServerConfig  `yaml:"server"`
 	DB      DBConfig      `yaml:"db"`
 	Kafka   KafkaConfig   `yaml:"kafka"`
+	Metrics MetricsConfig `yaml:"metrics"`
+	Tracing TracingConfig `yaml:"tracing"`
+	Health  HealthConfig  `yaml:"health"`
 	Logging LoggingConfig `yaml:"logging"`
 }
 
@@ -19,6 +22,15 @@ type ServerConfig struct {
 	Port         string        `yaml:"port"`
 	ReadTimeout  time.Duration `yaml:"read_timeout"`
 	WriteTimeout time.Duration `yaml:"write_timeout"`
+	GRPCTimeout  time.Duration `yaml:"grpc_timeout"`
+}
+
+// GetGRPCTimeout returns the timeout of gRPC calls without a deadline, 10 seconds by default
+func (sc *ServerConfig) GetGRPCTimeout() time.Duration {
+	if sc.GRPCTimeout <= 0 {
+		return 10 * time.Second
+	}
+	return sc.GRPCTimeout
 }
 
 type DBConfig struct {
@@ -44,6 +56,76 @@ type TopicsConfig struct {
 	UserEvents    string `yaml:"user_events"`
 }
 
+// MetricsConfig is where the Prometheus metrics and the health probes are served over HTTP
+type MetricsConfig struct {
+	Port string `yaml:"port"`
+}
+
+// GetPort returns the metrics port, 9083 by default
+func (mc *MetricsConfig) GetPort() string {
+	if mc.Port == "" {
+		return "9083"
+	}
+	return mc.Port
+}
+
+// TracingConfig selects where spans are exported: "otlp", "stdout" or "none"
+type TracingConfig struct {
+	ServiceName string  `yaml:"service_name"`
+	Exporter    string  `yaml:"exporter"`
+	Endpoint    string  `yaml:"endpoint"`
+	Insecure    bool    `yaml:"insecure"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// GetServiceName returns the name spans are reported under, statistics-service by default
+func (tc *TracingConfig) GetServiceName() string {
+	if tc.ServiceName == "" {
+		return "statistics-service"
+	}
+	return tc.ServiceName
+}
+
+// GetEndpoint returns the OTLP collector address, localhost:4317 by default
+func (tc *TracingConfig) GetEndpoint() string {
+	if tc.Endpoint == "" {
+		return "localhost:4317"
+	}
+	return tc.Endpoint
+}
+
+// GetSampleRatio returns the share of traces started here that are sampled, all by default
+func (tc *TracingConfig) GetSampleRatio() float64 {
+	if tc.SampleRatio <= 0 || tc.SampleRatio > 1 {
+		return 1
+	}
+	return tc.SampleRatio
+}
+
+// HealthConfig tunes the readiness probe and the graceful shutdown
+type HealthConfig struct {
+	CheckTimeout  time.Duration `yaml:"check_timeout"`
+	ShutdownDelay time.Duration `yaml:"shutdown_delay"`
+}
+
+// GetCheckTimeout returns the time each dependency check may take, 2 seconds by default
+func (hc *HealthConfig) GetCheckTimeout() time.Duration {
+	if hc.CheckTimeout <= 0 {
+		return 2 * time.Second
+	}
+	return hc.CheckTimeout
+}
+
+// GetShutdownDelay returns how long the service keeps serving after it
+// stopped reporting ready, so load balancers can take it out first. No delay
+// by default.
+func (hc *HealthConfig) GetShutdownDelay() time.Duration {
+	if hc.ShutdownDelay < 0 {
+		return 0
+	}
+	return hc.ShutdownDelay
+}
+
 type LoggingConfig struct {
 	Level string `yaml:"level"`
 }
@@ -3,11 +3,11 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/baccala1010/e-commerce/statistics/internal/config"
 	"github.com/baccala1010/e-commerce/statistics/pkg/postgre"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
 )
 
 // InitDatabase initializes the PostgreSQL database connection
@@ -100,6 +100,6 @@ func createTables(ctx context.Context, pool *pgxpool.Pool) error {
 		return fmt.Errorf("failed to create order_items table: %w", err)
 	}
 
-	log.Println("Database tables created successfully")
+	logrus.Info("Database tables created successfully")
 	return nil
 }
@@ -3,12 +3,12 @@ package handler
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/baccala1010/e-commerce/statistics/internal/usecase"
 	"github.com/baccala1010/e-commerce/statistics/pkg/pb"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -35,7 +35,7 @@ func (h *StatisticsHandler) GetUserOrdersStatistics(ctx context.Context, req *pb
 	// Call use case to get user order statistics
 	stats, err := h.statisticsUsecase.GetUserOrdersStatistics(ctx, req.UserId)
 	if err != nil {
-		log.Printf("Failed to get user order statistics: %v", err)
+		logrus.WithContext(ctx).Errorf("Failed to get user order statistics: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to get statistics: %v", err)
 	}
 
@@ -78,7 +78,7 @@ func (h *StatisticsHandler) GetUserStatistics(ctx context.Context, req *pb.UserS
 	// Call use case to get general user statistics
 	stats, err := h.statisticsUsecase.GetUserStatistics(ctx)
 	if err != nil {
-		log.Printf("Failed to get user statistics: %v", err)
+		logrus.WithContext(ctx).Errorf("Failed to get user statistics: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to get user statistics: %v", err)
 	}
 
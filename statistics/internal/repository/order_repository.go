@@ -3,11 +3,11 @@ package repository
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/baccala1010/e-commerce/statistics/internal/model"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
 )
 
 type orderRepository struct {
@@ -125,7 +125,7 @@ func (r *orderRepository) FindByID(ctx context.Context, id string) (*model.Order
 
 	rows, err := r.pool.Query(ctx, itemsQuery, id)
 	if err != nil {
-		log.Printf("failed to get order items: %v", err)
+		logrus.WithContext(ctx).Errorf("failed to get order items: %v", err)
 	} else {
 		defer rows.Close()
 
@@ -140,7 +140,7 @@ func (r *orderRepository) FindByID(ctx context.Context, id string) (*model.Order
 				&item.CreatedAt,
 			)
 			if err != nil {
-				log.Printf("failed to scan order item: %v", err)
+				logrus.WithContext(ctx).Errorf("failed to scan order item: %v", err)
 				continue
 			}
 			item.OrderID = id
@@ -235,7 +235,7 @@ func (r *orderRepository) GetUserOrdersStatistics(ctx context.Context, userID st
 		// Get hourly distribution
 		timeDistribution, err := r.GetHourlyDistribution(ctx, userID)
 		if err != nil {
-			log.Printf("failed to get order time distribution: %v", err)
+			logrus.WithContext(ctx).Errorf("failed to get order time distribution: %v", err)
 		} else {
 			stats.OrderTimeDistribution = timeDistribution
 		}
@@ -2,11 +2,14 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"sync/atomic"
 	"time"
 
+	"github.com/baccala1010/e-commerce/statistics/pkg/metrics"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/sirupsen/logrus"
 )
 
 type ConsumerConfig struct {
@@ -17,8 +20,10 @@ type ConsumerConfig struct {
 
 type Consumer struct {
 	consumer *kafka.Consumer
+	groupID  string
 	topics   []string
 	msgChan  chan *kafka.Message
+	closed   atomic.Bool
 }
 
 // NewConsumer creates a new Kafka consumer
@@ -35,6 +40,7 @@ func NewConsumer(config ConsumerConfig, topics []string) (*Consumer, error) {
 
 	return &Consumer{
 		consumer: consumer,
+		groupID:  config.GroupID,
 		topics:   topics,
 		msgChan:  make(chan *kafka.Message, 100),
 	}, nil
@@ -47,22 +53,24 @@ func (c *Consumer) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to subscribe to topics: %w", err)
 	}
 
-	log.Printf("Kafka consumer started, subscribed to topics: %v", c.topics)
+	logrus.Infof("Kafka consumer started, subscribed to topics: %v", c.topics)
 
 	go func() {
 		for {
 			select {
 			case <-ctx.Done():
-				log.Println("Kafka consumer context done, stopping consumer...")
+				logrus.Info("Kafka consumer context done, stopping consumer...")
+				c.closed.Store(true)
 				c.consumer.Close()
 				close(c.msgChan)
 				return
 			default:
 				msg, err := c.consumer.ReadMessage(100 * time.Millisecond)
 				if err == nil {
+					c.observe(msg)
 					c.msgChan <- msg
 				} else if !err.(kafka.Error).IsTimeout() {
-					log.Printf("Consumer error: %v", err)
+					logrus.Errorf("Consumer error: %v", err)
 				}
 			}
 		}
@@ -71,6 +79,37 @@ func (c *Consumer) Start(ctx context.Context) error {
 	return nil
 }
 
+// observe records a consumed message and the lag of its partition, taken from
+// the high watermark of the last fetch so no broker round trip is needed
+func (c *Consumer) observe(msg *kafka.Message) {
+	partition := msg.TopicPartition
+	lag := int64(-1)
+	if _, high, err := c.consumer.GetWatermarkOffsets(*partition.Topic, partition.Partition); err == nil {
+		lag = high - int64(partition.Offset) - 1
+	}
+	metrics.KafkaConsumed(c.groupID, *partition.Topic, partition.Partition, lag)
+}
+
+// Ping checks that the brokers answer by fetching their metadata
+func (c *Consumer) Ping(ctx context.Context) error {
+	if c.closed.Load() {
+		return errors.New("kafka consumer is closed")
+	}
+
+	timeoutMs := 5000
+	if deadline, ok := ctx.Deadline(); ok {
+		timeoutMs = int(time.Until(deadline).Milliseconds())
+	}
+	if timeoutMs <= 0 {
+		return context.DeadlineExceeded
+	}
+
+	if _, err := c.consumer.GetMetadata(nil, false, timeoutMs); err != nil {
+		return fmt.Errorf("failed to fetch broker metadata: %w", err)
+	}
+	return nil
+}
+
 // Messages returns a channel of Kafka messages
 func (c *Consumer) Messages() <-chan *kafka.Message {
 	return c.msgChan
@@ -79,6 +118,7 @@ func (c *Consumer) Messages() <-chan *kafka.Message {
 // Close closes the Kafka consumer and message channel
 func (c *Consumer) Close() {
 	if c.consumer != nil {
+		c.closed.Store(true)
 		c.consumer.Close()
 	}
 	close(c.msgChan)
@@ -2,10 +2,10 @@ package kafka
 
 import (
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/sirupsen/logrus"
 )
 
 type Producer struct {
@@ -26,9 +26,9 @@ func NewProducer(bootstrapServers, topicName string) (*Producer, error) {
 			switch ev := e.(type) {
 			case *kafka.Message:
 				if ev.TopicPartition.Error != nil {
-					log.Printf("Failed to deliver message: %v\n", ev.TopicPartition.Error)
+					logrus.Errorf("Failed to deliver message: %v", ev.TopicPartition.Error)
 				} else {
-					log.Printf("Message delivered to %v\n", ev.TopicPartition)
+					logrus.Debugf("Message delivered to %v", ev.TopicPartition)
 				}
 			}
 		}
@@ -0,0 +1,37 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/baccala1010/e-commerce/statistics/pkg/logging"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartProcessSpan starts the span of processing a consumed message,
+// continuing the trace the message was published in. The returned context
+// also carries the request ID and user ID the message was published with.
+func StartProcessSpan(ctx context.Context, groupID string, msg *kafka.Message) (context.Context, trace.Span) {
+	topic := *msg.TopicPartition.Topic
+	carrier := HeaderCarrier{Headers: &msg.Headers}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	if requestID := carrier.Get(logging.RequestIDKey); logging.ValidID(requestID) {
+		ctx = logging.WithRequestID(ctx, requestID)
+	}
+	if userID := carrier.Get(logging.UserIDKey); logging.ValidID(userID) {
+		ctx = logging.WithUserID(ctx, userID)
+	}
+	return otel.Tracer("kafka").Start(ctx, topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingKafkaConsumerGroup(groupID),
+			semconv.MessagingDestinationPartitionID(fmt.Sprint(msg.TopicPartition.Partition)),
+			semconv.MessagingKafkaMessageOffset(int(msg.TopicPartition.Offset)),
+		),
+	)
+}
@@ -0,0 +1,62 @@
+package logging
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Setup configures the global logger to write JSON lines at the given level.
+// Every line carries the service name; lines logged with a context also carry
+// the request ID, user ID and trace ID found in it.
+func Setup(service, level string) {
+	logrus.SetFormatter(&logrus.JSONFormatter{
+		TimestampFormat: time.RFC3339Nano,
+	})
+	SetLevel(level)
+
+	logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+	logrus.AddHook(&contextHook{service: service})
+}
+
+// SetLevel sets the level of the global logger, info when the level is unknown
+func SetLevel(level string) {
+	parsed, err := logrus.ParseLevel(level)
+	if err != nil {
+		parsed = logrus.InfoLevel
+	}
+	logrus.SetLevel(parsed)
+}
+
+// contextHook adds the service name and the request scoped fields to log entries
+type contextHook struct {
+	service string
+}
+
+// Levels implements logrus.Hook
+func (h *contextHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements logrus.Hook
+func (h *contextHook) Fire(entry *logrus.Entry) error {
+	entry.Data["service"] = h.service
+
+	ctx := entry.Context
+	if ctx == nil {
+		return nil
+	}
+
+	if requestID := RequestID(ctx); requestID != "" {
+		entry.Data["request_id"] = requestID
+	}
+	if userID := UserID(ctx); userID != "" {
+		entry.Data["user_id"] = userID
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		entry.Data["trace_id"] = spanContext.TraceID().String()
+		entry.Data["span_id"] = spanContext.SpanID().String()
+	}
+	return nil
+}
@@ -3,9 +3,11 @@ package postgre
 import (
 	"context"
 	"fmt"
-	"log"
 
+	"github.com/exaring/otelpgx"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
 )
 
 // Options represents PostgreSQL connection options
@@ -37,6 +39,9 @@ func New(ctx context.Context, opts Options) (*pgxpool.Pool, error) {
 		return nil, fmt.Errorf("failed to parse pgx config: %w", err)
 	}
 
+	// Record a span for every query, without the query arguments
+	config.ConnConfig.Tracer = otelpgx.NewTracer()
+
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pgx pool: %w", err)
@@ -47,6 +52,11 @@ func New(ctx context.Context, opts Options) (*pgxpool.Pool, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	log.Println("Successfully connected to PostgreSQL database")
+	// Export connection pool stats next to the service metrics
+	if err := prometheus.Register(newPoolCollector(pool, opts.Database)); err != nil {
+		logrus.Warnf("Failed to register database pool metrics: %v", err)
+	}
+
+	logrus.Info("Successfully connected to PostgreSQL database")
 	return pool, nil
 }